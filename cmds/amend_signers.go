@@ -0,0 +1,129 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type AmendSignersCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	DocumentId string                      `arg:"" name:"documentid" help:"document id" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Add        []DocSignFlag               `name:"add" help:"signers to add (ex: \"<address>,<signcode>\")" sep:"@"`
+	Remove     []AddressFlag               `name:"remove" help:"signer addresses to remove" sep:"@"`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+	add        []document.DocSign
+	remove     []base.Address
+}
+
+func NewAmendSignersCommand() AmendSignersCommand {
+	return AmendSignersCommand{
+		BaseCommand: NewBaseCommand("amend-signers-operation"),
+	}
+}
+
+func (cmd *AmendSignersCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *AmendSignersCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	add := make([]document.DocSign, len(cmd.Add))
+	for i := range cmd.Add {
+		a, err := cmd.Add[i].AD.Encode(jenc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid signer format, %q", cmd.Add[i].String())
+		}
+		add[i] = document.NewDocSign(a, cmd.Add[i].SC, false)
+	}
+	cmd.add = add
+
+	remove := make([]base.Address, len(cmd.Remove))
+	for i := range cmd.Remove {
+		a, err := cmd.Remove[i].Encode(jenc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid signer format, %q", cmd.Remove[i].String())
+		}
+		remove[i] = a
+	}
+	cmd.remove = remove
+
+	return nil
+}
+
+func (cmd *AmendSignersCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.AmendSignersItem
+	for j := range i {
+		if t, ok := i[j].(document.AmendSigners); ok {
+			items = t.Fact().(document.AmendSignersFact).Items()
+		}
+	}
+
+	item := document.NewAmendSignersItemImpl(cmd.DocumentId, cmd.add, cmd.remove, cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewAmendSignersFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewAmendSigners(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create amend-signers operation: %q", err)
+	}
+	return op, nil
+}
@@ -161,6 +161,42 @@ func AttachProposalProcessor(
 		return nil, err
 	} else if _, err := opr.SetProcessor(document.UpdateDocumentsHinter, document.NewUpdateDocumentsProcessor(cp)); err != nil {
 		return nil, err
+	} else if _, err := opr.SetProcessor(document.RemoveDocumentsHinter, document.NewRemoveDocumentsProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.TransferDocumentsHinter, document.NewTransferDocumentsProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.AmendSignersHinter, document.NewAmendSignersProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.DelegateEditorsHinter, document.NewDelegateEditorsProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.CastVoteHinter, document.NewCastVoteProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.CloseVotingHinter, document.NewCloseVotingProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.CommitVoteHinter, document.NewCommitVoteProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.RevealVoteHinter, document.NewRevealVoteProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.RentLandHinter, document.NewRentLandProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.RenewLeaseHinter, document.NewRenewLeaseProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.EndLeaseHinter, document.NewEndLeaseProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.DepositGoldHinter, document.NewDepositGoldProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.WithdrawGoldHinter, document.NewWithdrawGoldProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.TransferGoldHinter, document.NewTransferGoldProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.UpdateGoldRateHinter, document.NewUpdateGoldRateProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.SwapGoldHinter, document.NewSwapGoldProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.UpdateStatPolicyHinter, document.NewUpdateStatPolicyProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(document.ChangeStatsHinter, document.NewChangeStatsProcessor(cp)); err != nil {
+		return nil, err
 	}
 
 	threshold, err := base.NewThreshold(uint(len(suffrage.Nodes())), policy.ThresholdRatio())
@@ -196,6 +232,24 @@ func AttachProposalProcessor(
 		return nil, err
 	}
 
+	if _, err := opr.SetProcessor(document.RegisterDocumentTypeHinter,
+		document.NewRegisterDocumentTypeProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(document.GrantPermissionHinter,
+		document.NewGrantPermissionProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(document.RevokePermissionHinter,
+		document.NewRevokePermissionProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
 	return opr, nil
 }
 
@@ -223,6 +277,27 @@ func InitializeProposalProcessor(ctx context.Context, opr *document.OperationPro
 		document.SignDocumentsHinter,
 		document.CreateDocumentsHinter,
 		document.UpdateDocumentsHinter,
+		document.RemoveDocumentsHinter,
+		document.TransferDocumentsHinter,
+		document.AmendSignersHinter,
+		document.RegisterDocumentTypeHinter,
+		document.GrantPermissionHinter,
+		document.RevokePermissionHinter,
+		document.DelegateEditorsHinter,
+		document.CastVoteHinter,
+		document.CloseVotingHinter,
+		document.CommitVoteHinter,
+		document.RevealVoteHinter,
+		document.RentLandHinter,
+		document.RenewLeaseHinter,
+		document.EndLeaseHinter,
+		document.DepositGoldHinter,
+		document.WithdrawGoldHinter,
+		document.TransferGoldHinter,
+		document.UpdateGoldRateHinter,
+		document.SwapGoldHinter,
+		document.UpdateStatPolicyHinter,
+		document.ChangeStatsHinter,
 	} {
 		if err := oprs.Add(hinter, opr); err != nil {
 			return ctx, err
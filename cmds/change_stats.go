@@ -0,0 +1,115 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type ChangeStatsCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"blockcity admin address" required:""`
+	DocumentId   string                      `arg:"" name:"documentid" help:"user document id" required:""`
+	Currency     currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Hp           int64                       `name:"hp" help:"delta of hp"`
+	Strength     int64                       `name:"strength" help:"delta of strength"`
+	Agility      int64                       `name:"agility" help:"delta of agility"`
+	Dexterity    int64                       `name:"dexterity" help:"delta of dexterity"`
+	Charisma     int64                       `name:"charisma" help:"delta of charisma"`
+	Intelligence int64                       `name:"intelligence" help:"delta of intelligence"`
+	Vital        int64                       `name:"vital" help:"delta of vital"`
+	Seal         mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender       base.Address
+}
+
+func NewChangeStatsCommand() ChangeStatsCommand {
+	return ChangeStatsCommand{
+		BaseCommand: NewBaseCommand("change-stats-operation"),
+	}
+}
+
+func (cmd *ChangeStatsCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *ChangeStatsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *ChangeStatsCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.ChangeStatsItem
+	for j := range i {
+		if t, ok := i[j].(document.ChangeStats); ok {
+			items = t.Fact().(document.ChangeStatsFact).Items()
+		}
+	}
+
+	delta := document.NewStatDelta(
+		cmd.Hp, cmd.Strength, cmd.Agility, cmd.Dexterity, cmd.Charisma, cmd.Intelligence, cmd.Vital)
+
+	item := document.NewChangeStatsItemImpl(cmd.DocumentId, delta, cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewChangeStatsFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewChangeStats(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create change-stats operation: %q", err)
+	}
+	return op, nil
+}
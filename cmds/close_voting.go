@@ -0,0 +1,110 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type CloseVotingCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"owner address" required:""`
+	DocumentId   string                      `arg:"" name:"documentid" help:"voting document id" required:""`
+	Currency     currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	NextDeadline int64                       `name:"next-deadline-height" help:"open next round with the deadline block height" optional:""`
+	Seal         mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender       base.Address
+}
+
+func NewCloseVotingCommand() CloseVotingCommand {
+	return CloseVotingCommand{
+		BaseCommand: NewBaseCommand("close-voting-operation"),
+	}
+}
+
+func (cmd *CloseVotingCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *CloseVotingCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	if cmd.NextDeadline < 0 {
+		return errors.Errorf("invalid next deadline height, %d", cmd.NextDeadline)
+	}
+
+	return nil
+}
+
+func (cmd *CloseVotingCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.CloseVotingItem
+	for j := range i {
+		if t, ok := i[j].(document.CloseVoting); ok {
+			items = t.Fact().(document.CloseVotingFact).Items()
+		}
+	}
+
+	item := document.NewCloseVotingItemImpl(cmd.DocumentId, base.Height(cmd.NextDeadline), cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewCloseVotingFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewCloseVoting(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create close-voting operation: %q", err)
+	}
+	return op, nil
+}
@@ -0,0 +1,119 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type CommitVoteCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"voter address" required:""`
+	DocumentId string                      `arg:"" name:"documentid" help:"voting document id" required:""`
+	Candidate  currencycmds.AddressFlag    `arg:"" name:"candidate" help:"candidate address" required:""`
+	Salt       string                      `arg:"" name:"salt" help:"secret salt, which is given again to reveal vote" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+	candidate  base.Address
+}
+
+func NewCommitVoteCommand() CommitVoteCommand {
+	return CommitVoteCommand{
+		BaseCommand: NewBaseCommand("commit-vote-operation"),
+	}
+}
+
+func (cmd *CommitVoteCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *CommitVoteCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Candidate.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid candidate format, %q", cmd.Candidate.String())
+	}
+	cmd.candidate = a
+
+	return nil
+}
+
+func (cmd *CommitVoteCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.CommitVoteItem
+	for j := range i {
+		if t, ok := i[j].(document.CommitVote); ok {
+			items = t.Fact().(document.CommitVoteFact).Items()
+		}
+	}
+
+	// NOTE only the commitment is sent; candidate and salt are kept secret
+	item := document.NewCommitVoteItemImpl(
+		cmd.DocumentId,
+		document.NewVoteCommitment(cmd.DocumentId, cmd.sender, cmd.candidate, cmd.Salt),
+		cmd.Currency.CID,
+	)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewCommitVoteFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewCommitVote(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create commit-vote operation: %q", err)
+	}
+	return op, nil
+}
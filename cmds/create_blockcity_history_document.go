@@ -18,7 +18,7 @@ type CreateBlockcityHistoryDocumentCommand struct {
 	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
 	Name        string                      `arg:"" name:"name" help:"name" required:""`
 	Account     currencycmds.AddressFlag    `arg:"" name:"renteraccount" help:"renter account address" required:""`
-	Date        string                      `arg:"" name:"date" help:"sdate" required:""`
+	Date        string                      `arg:"" name:"date" help:"date; RFC3339 or yyyy-mm-dd" required:""`
 	Usage       string                      `arg:"" name:"usage" help:"usage" required:""`
 	Application string                      `arg:"" name:"application" help:"application" required:""`
 	DocumentId  string                      `arg:"" name:"documentid" help:"document id" required:""`
@@ -26,6 +26,7 @@ type CreateBlockcityHistoryDocumentCommand struct {
 	Seal        mitumcmds.FileLoad          `help:"seal" optional:""`
 	sender      base.Address
 	account     base.Address
+	date        document.DocumentTime
 }
 
 func NewCreateBlockcityHistoryDocumentCommand() CreateBlockcityHistoryDocumentCommand {
@@ -79,6 +80,12 @@ func (cmd *CreateBlockcityHistoryDocumentCommand) parseFlags() error {
 	}
 	cmd.account = ba
 
+	dt, err := document.ParseDocumentTime(cmd.Date)
+	if err != nil {
+		return errors.Wrap(err, "invalid date")
+	}
+	cmd.date = dt
+
 	return nil
 }
 
@@ -95,7 +102,7 @@ func (cmd *CreateBlockcityHistoryDocumentCommand) createOperation() (operation.O
 	}
 
 	info := document.NewDocInfo(cmd.DocumentId, document.BCHistoryDataType)
-	doc := document.NewBCHistoryData(info, cmd.sender, cmd.Name, cmd.account, cmd.Date, cmd.Usage, cmd.Application)
+	doc := document.NewBCHistoryData(info, cmd.sender, cmd.Name, cmd.account, cmd.date, cmd.Usage, cmd.Application)
 
 	item := document.NewCreateDocumentsItemImpl(
 		doc,
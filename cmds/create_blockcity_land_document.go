@@ -15,18 +15,20 @@ import (
 type CreateBlockcityLandDocumentCommand struct {
 	*BaseCommand
 	currencycmds.OperationFlags
+	GeometryFlags
 	Sender        currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
 	Address       string                      `arg:"" name:"landaddress" help:"land address" required:""`
 	Area          string                      `arg:"" name:"landarea" help:"land area" required:""`
 	Renter        string                      `arg:"" name:"renter" help:"renter nickname" required:""`
 	Account       currencycmds.AddressFlag    `arg:"" name:"renteraccount" help:"renter account address" required:""`
-	Rentdate      string                      `arg:"" name:"rentdate" help:"rent date" required:""`
+	Rentdate      string                      `arg:"" name:"rentdate" help:"rent date; RFC3339 or yyyy-mm-dd" required:""`
 	Periodday     uint                        `arg:"" name:"periodday" help:"periodday" required:""`
 	DocumentId    string                      `arg:"" name:"documentid" help:"document id" required:""`
 	Currency      currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
 	Seal          mitumcmds.FileLoad          `help:"seal" optional:""`
 	sender        base.Address
 	renterAccount base.Address
+	rentdate      document.DocumentTime
 }
 
 func NewCreateBlockcityLandDocumentCommand() CreateBlockcityLandDocumentCommand {
@@ -79,6 +81,12 @@ func (cmd *CreateBlockcityLandDocumentCommand) parseFlags() error {
 	}
 	cmd.renterAccount = ra
 
+	rd, err := document.ParseDocumentTime(cmd.Rentdate)
+	if err != nil {
+		return errors.Wrap(err, "invalid rentdate")
+	}
+	cmd.rentdate = rd
+
 	return nil
 }
 
@@ -95,7 +103,12 @@ func (cmd *CreateBlockcityLandDocumentCommand) createOperation() (operation.Oper
 	}
 
 	info := document.NewDocInfo(cmd.DocumentId, document.BCLandDataType)
-	doc := document.NewBCLandData(info, cmd.sender, cmd.Address, cmd.Area, cmd.Renter, cmd.renterAccount, cmd.Rentdate, cmd.Periodday)
+	doc, err := cmd.GeometryFlags.apply(
+		document.NewBCLandData(info, cmd.sender, cmd.Address, cmd.Area, cmd.Renter, cmd.renterAccount, cmd.rentdate, cmd.Periodday),
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	item := document.NewCreateDocumentsItemImpl(
 		doc,
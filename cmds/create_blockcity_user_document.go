@@ -16,8 +16,8 @@ type CreateBlockcityUserDocumentCommand struct {
 	*BaseCommand
 	currencycmds.OperationFlags
 	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
-	Gold         uint                        `arg:"" name:"gold" help:"gold" required:""`
-	Bankgold     uint                        `arg:"" name:"bankgold" help:"bankgold" required:""`
+	Gold         uint                        `arg:"" name:"gold" help:"gold; 0 for new user document" required:""`
+	Bankgold     uint                        `arg:"" name:"bankgold" help:"bankgold; 0 for new user document" required:""`
 	Hp           uint                        `arg:"" name:"hp" help:"hp" required:""`
 	Strength     uint                        `arg:"" name:"strength" help:"strength" required:""`
 	Agility      uint                        `arg:"" name:"agility" help:"agility" required:""`
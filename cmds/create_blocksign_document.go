@@ -28,6 +28,8 @@ type CreateBlockSignDocumentCommand struct {
 	Size       currencycmds.BigFlag        `arg:"" name:"size" help:"size" required:""`
 	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
 	Signers    []DocSignFlag               `name:"signers" help:"signers for document (ex: \"<address>,<signcode>\")" sep:"@"`
+	Deadline   int64                       `name:"deadline-height" help:"block height, after which document can not be signed" optional:""`
+	Sequential bool                        `name:"sequential" help:"signers sign in the given order" optional:""`
 	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
 	sender     base.Address
 	signers    []base.Address
@@ -78,6 +80,10 @@ func (cmd *CreateBlockSignDocumentCommand) parseFlags() error {
 	}
 	cmd.sender = a
 
+	if cmd.Deadline < 0 {
+		return errors.Errorf("invalid deadline height, %d", cmd.Deadline)
+	}
+
 	{
 		signers := make([]base.Address, len(cmd.Signers))
 		signcodes := make([]string, len(cmd.Signers))
@@ -116,7 +122,11 @@ func (cmd *CreateBlockSignDocumentCommand) createOperation() (operation.Operatio
 		docsign := document.NewDocSign(cmd.signers[i], cmd.signcodes[i], false)
 		signers = append(signers, docsign)
 	}
-	doc := document.NewBSDocData(info, cmd.sender, document.FileHash(cmd.FileHash), docsign, cmd.Title, cmd.Size.Big, signers)
+	doc := document.NewBSDocData(info, cmd.sender, document.FileHash(cmd.FileHash), docsign, cmd.Title, cmd.Size.Big, signers).
+		WithDeadline(base.Height(cmd.Deadline))
+	if cmd.Sequential {
+		doc = doc.WithSigningPolicy(document.SigningPolicySequential)
+	}
 	item := document.NewCreateDocumentsItemImpl(
 		doc,
 		cmd.Currency.CID,
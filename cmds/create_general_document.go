@@ -0,0 +1,123 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type CreateGeneralDocumentCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	DocType    string                      `arg:"" name:"doctype" help:"registered document type" required:""`
+	DocumentId string                      `arg:"" name:"documentid" help:"document id" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Fields     []DocPayloadFlag            `name:"field" help:"document field value (ex: \"<name>=<value>\")" sep:"@"`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+	payload    map[string]string
+}
+
+func NewCreateGeneralDocumentCommand() CreateGeneralDocumentCommand {
+	return CreateGeneralDocumentCommand{
+		BaseCommand: NewBaseCommand("create-general-document-operation"),
+	}
+}
+
+func (cmd *CreateGeneralDocumentCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *CreateGeneralDocumentCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sa, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sa
+
+	payload, err := docPayloadFromFlags(cmd.Fields)
+	if err != nil {
+		return err
+	}
+	cmd.payload = payload
+
+	return nil
+}
+
+func (cmd *CreateGeneralDocumentCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.CreateDocumentsItem
+	for j := range i {
+		if t, ok := i[j].(document.CreateDocuments); ok {
+			items = t.Fact().(document.CreateDocumentsFact).Items()
+		}
+	}
+
+	info := document.NewGeneralDocInfo(cmd.DocumentId, hint.Type(cmd.DocType))
+	doc := document.NewGeneralDocData(info, cmd.sender, cmd.payload)
+
+	item := document.NewCreateDocumentsItemImpl(
+		doc,
+		cmd.Currency.CID,
+	)
+
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewCreateDocumentsFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewCreateDocuments(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create create-general-document operation: %q", err)
+	}
+	return op, nil
+}
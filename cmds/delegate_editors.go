@@ -0,0 +1,129 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type DelegateEditorsCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"owner address" required:""`
+	Delegate   currencycmds.AddressFlag    `arg:"" name:"delegate" help:"delegate address" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	DocumentId string                      `name:"documentid" help:"document id to delegate"`
+	DocType    string                      `name:"doctype" help:"document type to delegate"`
+	Expiry     uint64                      `name:"expiry" help:"block height, after which the delegation expires"`
+	Cancel     bool                        `name:"cancel" help:"cancel delegation"`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+	delegate   base.Address
+}
+
+func NewDelegateEditorsCommand() DelegateEditorsCommand {
+	return DelegateEditorsCommand{
+		BaseCommand: NewBaseCommand("delegate-editors-operation"),
+	}
+}
+
+func (cmd *DelegateEditorsCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *DelegateEditorsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Delegate.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid delegate format, %q", cmd.Delegate.String())
+	}
+	cmd.delegate = a
+
+	return nil
+}
+
+func (cmd *DelegateEditorsCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.DelegateEditorsItem
+	for j := range i {
+		if t, ok := i[j].(document.DelegateEditors); ok {
+			items = t.Fact().(document.DelegateEditorsFact).Items()
+		}
+	}
+
+	mode := document.DelegateAllow
+	if cmd.Cancel {
+		mode = document.DelegateCancel
+	}
+
+	item := document.NewDelegateEditorsItemImpl(
+		cmd.delegate,
+		cmd.DocumentId,
+		hint.Type(cmd.DocType),
+		base.Height(cmd.Expiry),
+		mode,
+		cmd.Currency.CID,
+	)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewDelegateEditorsFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewDelegateEditors(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create delegate-editors operation: %q", err)
+	}
+	return op, nil
+}
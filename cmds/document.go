@@ -10,6 +10,27 @@ type DocumentCommand struct {
 	UpdateBlockcityLandDocument    UpdateBlockcityLandDocumentCommand    `cmd:"" name:"update-blockcity-land-document" help:"update blockcity land document"`
 	UpdateBlockcityVotingDocument  UpdateBlockcityVotingDocumentCommand  `cmd:"" name:"update-blockcity-voting-document" help:"update blockcity voting document"`
 	UpdateBlockcityHistoryDocument UpdateBlockcityHistoryDocumentCommand `cmd:"" name:"update-blockcity-history-document" help:"update blockcity history document"`
+	RemoveDocument                 RemoveDocumentCommand                 `cmd:"" name:"remove-document" help:"remove document"`
+	TransferDocument               TransferDocumentCommand               `cmd:"" name:"transfer-document" help:"transfer document to receiver"`
+	AmendSigners                   AmendSignersCommand                   `cmd:"" name:"amend-signers" help:"add or remove signers of blocksign document"`
+	CreateGeneralDocument          CreateGeneralDocumentCommand          `cmd:"" name:"create-general-document" help:"create new document of registered document type"`
+	UpdateGeneralDocument          UpdateGeneralDocumentCommand          `cmd:"" name:"update-general-document" help:"update document of registered document type"`
+	PatchDocument                  PatchDocumentCommand                  `cmd:"" name:"patch-document" help:"update fields of document"`
+	DelegateEditors                DelegateEditorsCommand                `cmd:"" name:"delegate-editors" help:"allow or cancel delegates to update documents"`
+	CastVote                       CastVoteCommand                       `cmd:"" name:"cast-vote" help:"vote for candidate of blockcity voting document"`
+	CloseVoting                    CloseVotingCommand                    `cmd:"" name:"close-voting" help:"close voting round of blockcity voting document"`
+	CommitVote                     CommitVoteCommand                     `cmd:"" name:"commit-vote" help:"commit secret vote for candidate of blockcity voting document"`
+	RevealVote                     RevealVoteCommand                     `cmd:"" name:"reveal-vote" help:"reveal committed vote of blockcity voting document"`
+	RentLand                       RentLandCommand                       `cmd:"" name:"rent-land" help:"lease blockcity land document to renter"`
+	RenewLease                     RenewLeaseCommand                     `cmd:"" name:"renew-lease" help:"extend lease of blockcity land document"`
+	EndLease                       EndLeaseCommand                       `cmd:"" name:"end-lease" help:"end lease of blockcity land document"`
+	DepositGold                    DepositGoldCommand                    `cmd:"" name:"deposit-gold" help:"move gold of blockcity user document into bank"`
+	WithdrawGold                   WithdrawGoldCommand                   `cmd:"" name:"withdraw-gold" help:"move gold of blockcity user document out of bank"`
+	TransferGold                   TransferGoldCommand                   `cmd:"" name:"transfer-gold" help:"transfer gold between blockcity user documents"`
+	UpdateGoldRate                 UpdateGoldRateCommand                 `cmd:"" name:"update-gold-rate" help:"update rate of swap between gold and currency"`
+	SwapGold                       SwapGoldCommand                       `cmd:"" name:"swap-gold" help:"swap gold of blockcity user document with currency"`
+	UpdateStatPolicy               UpdateStatPolicyCommand               `cmd:"" name:"update-stat-policy" help:"update bounds of statistics of blockcity user document"`
+	ChangeStats                    ChangeStatsCommand                    `cmd:"" name:"change-stats" help:"change statistics of blockcity user document by delta"`
 }
 
 func NewDocumentCommand() DocumentCommand {
@@ -23,5 +44,26 @@ func NewDocumentCommand() DocumentCommand {
 		UpdateBlockcityLandDocument:    NewUpdateBlockcityLandDocumentCommand(),
 		UpdateBlockcityVotingDocument:  NewUpdateBlockcityVotingDocumentCommand(),
 		UpdateBlockcityHistoryDocument: NewUpdateBlockcityHistoryDocumentCommand(),
+		RemoveDocument:                 NewRemoveDocumentCommand(),
+		TransferDocument:               NewTransferDocumentCommand(),
+		AmendSigners:                   NewAmendSignersCommand(),
+		CreateGeneralDocument:          NewCreateGeneralDocumentCommand(),
+		UpdateGeneralDocument:          NewUpdateGeneralDocumentCommand(),
+		PatchDocument:                  NewPatchDocumentCommand(),
+		DelegateEditors:                NewDelegateEditorsCommand(),
+		CastVote:                       NewCastVoteCommand(),
+		CloseVoting:                    NewCloseVotingCommand(),
+		CommitVote:                     NewCommitVoteCommand(),
+		RevealVote:                     NewRevealVoteCommand(),
+		RentLand:                       NewRentLandCommand(),
+		RenewLease:                     NewRenewLeaseCommand(),
+		EndLease:                       NewEndLeaseCommand(),
+		DepositGold:                    NewDepositGoldCommand(),
+		WithdrawGold:                   NewWithdrawGoldCommand(),
+		TransferGold:                   NewTransferGoldCommand(),
+		UpdateGoldRate:                 NewUpdateGoldRateCommand(),
+		SwapGold:                       NewSwapGoldCommand(),
+		UpdateStatPolicy:               NewUpdateStatPolicyCommand(),
+		ChangeStats:                    NewChangeStatsCommand(),
 	}
 }
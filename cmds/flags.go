@@ -1,12 +1,14 @@
 package cmds
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/protoconNet/mitum-document/document"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 type AddressFlag struct {
@@ -69,3 +71,199 @@ func (v *DocSignFlag) UnmarshalText(b []byte) error {
 func (v *DocSignFlag) String() string {
 	return v.AD.String()
 }
+
+// ExpectedFlags sets the expected document hash or state height of
+// UpdateDocumentsItem.
+type ExpectedFlags struct {
+	ExpectedHash   string `name:"expected-hash" help:"expected hash of current document" optional:""`
+	ExpectedHeight int64  `name:"expected-height" help:"expected height of current document state" default:"-1"`
+}
+
+func (v *ExpectedFlags) apply(item document.UpdateDocumentsItemImpl) document.UpdateDocumentsItemImpl {
+	if len(v.ExpectedHash) > 0 {
+		item = item.WithExpectedHash(valuehash.NewBytesFromString(v.ExpectedHash))
+	}
+
+	return item.WithExpectedHeight(base.Height(v.ExpectedHeight))
+}
+
+func (v *ExpectedFlags) applyPatch(item document.PatchDocumentsItemImpl) document.PatchDocumentsItemImpl {
+	if len(v.ExpectedHash) > 0 {
+		item = item.WithExpectedHash(valuehash.NewBytesFromString(v.ExpectedHash))
+	}
+
+	return item.WithExpectedHeight(base.Height(v.ExpectedHeight))
+}
+
+type GeometryFlags struct {
+	Point   string `name:"point" help:"land location; \"<longitude>,<latitude>\"" optional:""`
+	Polygon string `name:"polygon" help:"land boundary; \"<longitude>,<latitude>;...\", first and last are same" optional:""`
+}
+
+func (v *GeometryFlags) apply(doc document.BCLandData) (document.BCLandData, error) {
+	switch {
+	case len(v.Point) > 0 && len(v.Polygon) > 0:
+		return doc, errors.Errorf("point and polygon can not be set together")
+	case len(v.Point) > 0:
+		c, err := parseCoordinate(v.Point)
+		if err != nil {
+			return doc, err
+		}
+
+		return doc.WithGeometry(document.NewPointLandGeometry(c[0], c[1])), nil
+	case len(v.Polygon) > 0:
+		l := strings.Split(v.Polygon, ";")
+		ring := make([][2]float64, len(l))
+		for i := range l {
+			c, err := parseCoordinate(l[i])
+			if err != nil {
+				return doc, err
+			}
+			ring[i] = c
+		}
+
+		return doc.WithGeometry(document.NewPolygonLandGeometry(ring)), nil
+	default:
+		return doc, nil
+	}
+}
+
+func parseCoordinate(s string) ([2]float64, error) {
+	l := strings.SplitN(strings.TrimSpace(s), ",", 2)
+	if len(l) != 2 {
+		return [2]float64{}, errors.Errorf(`wrong formatted coordinate; "<longitude>,<latitude>"`)
+	}
+
+	lon, err := strconv.ParseFloat(strings.TrimSpace(l[0]), 64)
+	if err != nil {
+		return [2]float64{}, errors.Wrapf(err, "invalid longitude, %q", l[0])
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(l[1]), 64)
+	if err != nil {
+		return [2]float64{}, errors.Wrapf(err, "invalid latitude, %q", l[1])
+	}
+
+	return [2]float64{lon, lat}, nil
+}
+
+type DocFieldFlag struct {
+	field document.DocField
+}
+
+func (v *DocFieldFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 4)
+	if len(l) != 4 {
+		return errors.Errorf(`wrong formatted; "<name>,<kind>,<required>,<max length>"`)
+	}
+
+	required, err := strconv.ParseBool(l[2])
+	if err != nil {
+		return errors.Wrapf(err, "invalid required, %q", l[2])
+	}
+
+	ml, err := strconv.ParseUint(l[3], 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid max length, %q", l[3])
+	}
+
+	v.field = document.NewDocField(l[0], document.DocFieldKind(l[1]), required, uint(ml))
+
+	return v.field.IsValid(nil)
+}
+
+func (v *DocFieldFlag) String() string {
+	return v.field.Name()
+}
+
+type DocPayloadFlag struct {
+	name  string
+	value string
+}
+
+func (v *DocPayloadFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), "=", 2)
+	if len(l) != 2 {
+		return errors.Errorf(`wrong formatted; "<name>=<value>"`)
+	}
+
+	if len(l[0]) < 1 {
+		return errors.Errorf("empty field name")
+	}
+
+	v.name, v.value = l[0], l[1]
+
+	return nil
+}
+
+func (v *DocPayloadFlag) String() string {
+	return v.name + "=" + v.value
+}
+
+func docPayloadFromFlags(fs []DocPayloadFlag) (map[string]string, error) {
+	payload := map[string]string{}
+	for i := range fs {
+		if _, found := payload[fs[i].name]; found {
+			return nil, errors.Errorf("duplicated field, %q", fs[i].name)
+		}
+
+		payload[fs[i].name] = fs[i].value
+	}
+
+	return payload, nil
+}
+
+type CandidateFlag struct {
+	AD       AddressFlag
+	nickname string
+}
+
+func (v *CandidateFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) != 2 {
+		return errors.Errorf(`wrong formatted; "<string address>,<string nickname>"`)
+	}
+
+	v.AD = AddressFlag{
+		s: l[0],
+	}
+	v.nickname = l[1]
+
+	return nil
+}
+
+func (v *CandidateFlag) String() string {
+	return v.AD.String()
+}
+
+// StatisticsFlag parses the fields of UserStatistics in order, "<hp>,<strength>,
+// <agility>,<dexterity>,<charisma>,<intelligence>,<vital>".
+type StatisticsFlag struct {
+	s          string
+	statistics document.UserStatistics
+}
+
+func (v *StatisticsFlag) UnmarshalText(b []byte) error {
+	l := strings.Split(string(b), ",")
+	if len(l) != 7 {
+		return errors.Errorf(`wrong formatted; "<hp>,<strength>,<agility>,<dexterity>,<charisma>,<intelligence>,<vital>"`)
+	}
+
+	var u [7]uint
+	for i := range l {
+		n, err := strconv.ParseUint(strings.TrimSpace(l[i]), 10, strconv.IntSize)
+		if err != nil {
+			return errors.Wrapf(err, "invalid statistics, %q", l[i])
+		}
+		u[i] = uint(n)
+	}
+
+	v.s = string(b)
+	v.statistics = document.NewUserStatistics(u[0], u[1], u[2], u[3], u[4], u[5], u[6])
+
+	return nil
+}
+
+func (v *StatisticsFlag) String() string {
+	return v.s
+}
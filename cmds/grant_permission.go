@@ -0,0 +1,95 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type GrantPermissionCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Target     currencycmds.AddressFlag `arg:"" name:"target" help:"target address" required:""`
+	Permission string                   `arg:"" name:"permission" help:"permission (ex: \"blockcityAdmin\")" required:""`
+	Seal       mitumcmds.FileLoad       `help:"seal" optional:""`
+	target     base.Address
+	permission extension.AccountPermission
+}
+
+func NewGrantPermissionCommand() GrantPermissionCommand {
+	return GrantPermissionCommand{
+		BaseCommand: NewBaseCommand("grant-permission-operation"),
+	}
+}
+
+func (cmd *GrantPermissionCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *GrantPermissionCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Target.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid target format, %q", cmd.Target.String())
+	}
+	cmd.target = a
+
+	ap, err := extension.ParseAccountPermission(cmd.Permission)
+	if err != nil {
+		return err
+	}
+	cmd.permission = ap
+
+	return nil
+}
+
+func (cmd *GrantPermissionCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	fact := document.NewGrantPermissionFact([]byte(cmd.Token), cmd.target, cmd.permission)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewGrantPermission(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create grant-permission operation: %q", err)
+	}
+	return op, nil
+}
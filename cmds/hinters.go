@@ -6,6 +6,7 @@ import (
 
 	"github.com/protoconNet/mitum-document/digest"
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/spikeekips/mitum-currency/currency"
 )
 
@@ -16,6 +17,7 @@ var (
 
 var types = []hint.Type{
 	currency.AccountType,
+	extension.AccountType,
 	currency.AddressType,
 	currency.AmountType,
 	currency.CreateAccountsFactType,
@@ -46,6 +48,7 @@ var types = []hint.Type{
 	currency.TransfersItemSingleAmountType,
 	currency.TransfersType,
 	document.SignItemSingleDocumentType,
+	document.SignItemSingleDocumentStatusType,
 	document.SignDocumentsFactType,
 	document.SignDocumentsType,
 	document.DocSignType,
@@ -53,20 +56,96 @@ var types = []hint.Type{
 	document.CreateDocumentsFactType,
 	document.CreateDocumentsType,
 	document.UpdateDocumentsItemImplType,
+	document.PatchDocumentsItemImplType,
 	document.UpdateDocumentsFactType,
 	document.UpdateDocumentsType,
+	document.RemoveDocumentsItemImplType,
+	document.RemoveDocumentsFactType,
+	document.RemoveDocumentsType,
+	document.TransferDocumentsItemImplType,
+	document.TransferDocumentsFactType,
+	document.TransferDocumentsType,
+	document.AmendSignersItemImplType,
+	document.AmendSignersFactType,
+	document.AmendSignersType,
+	document.RegisterDocumentTypeFactType,
+	document.RegisterDocumentTypeType,
+	document.GrantPermissionFactType,
+	document.GrantPermissionType,
+	document.RevokePermissionFactType,
+	document.RevokePermissionType,
+	document.DelegateEditorsItemImplType,
+	document.DelegateEditorsFactType,
+	document.DelegateEditorsType,
+	document.CastVoteItemImplType,
+	document.CastVoteFactType,
+	document.CastVoteType,
+	document.CloseVotingItemImplType,
+	document.CloseVotingFactType,
+	document.CloseVotingType,
+	document.CommitVoteItemImplType,
+	document.CommitVoteFactType,
+	document.CommitVoteType,
+	document.RevealVoteItemImplType,
+	document.RevealVoteFactType,
+	document.RevealVoteType,
+	document.RentLandItemImplType,
+	document.RentLandFactType,
+	document.RentLandType,
+	document.RenewLeaseItemImplType,
+	document.RenewLeaseFactType,
+	document.RenewLeaseType,
+	document.EndLeaseItemImplType,
+	document.EndLeaseFactType,
+	document.EndLeaseType,
+	document.DepositGoldItemImplType,
+	document.DepositGoldFactType,
+	document.DepositGoldType,
+	document.WithdrawGoldItemImplType,
+	document.WithdrawGoldFactType,
+	document.WithdrawGoldType,
+	document.TransferGoldItemImplType,
+	document.TransferGoldFactType,
+	document.TransferGoldType,
+	document.UpdateGoldRateFactType,
+	document.UpdateGoldRateType,
+	document.SwapGoldItemImplType,
+	document.SwapGoldFactType,
+	document.SwapGoldType,
+	document.UpdateStatPolicyFactType,
+	document.UpdateStatPolicyType,
+	document.ChangeStatsItemImplType,
+	document.ChangeStatsFactType,
+	document.ChangeStatsType,
 	document.DocumentType,
 	document.BSDocDataType,
 	document.BCUserDataType,
 	document.BCLandDataType,
 	document.BCVotingDataType,
 	document.BCHistoryDataType,
+	document.GeneralDocDataType,
+	document.ArchivedDocumentDataType,
 	document.UserStatisticsType,
 	document.BSDocIdType,
 	document.UserDocIdType,
 	document.LandDocIdType,
+	document.LandGeometryType,
+	document.DocumentPatchType,
+	document.GoldRateType,
+	document.StatPolicyType,
+	document.StatDeltaType,
 	document.VotingDocIdType,
 	document.HistoryDocIdType,
+	document.GeneralDocIdType,
+	document.DocFieldType,
+	document.DocTypeDesignType,
+	document.DocTypeRegistryType,
+	document.DelegationType,
+	document.DocumentDelegatesType,
+	document.VotingBoxType,
+	document.VoteCommitType,
+	document.VotingResultType,
+	document.VotingSnapshotsType,
 	document.DocInfoType,
 	document.VotingCandidateType,
 	document.DocumentInventoryType,
@@ -75,11 +154,13 @@ var types = []hint.Type{
 	digest.BaseHalType,
 	digest.AccountValueType,
 	digest.DocumentValueType,
+	digest.StatChangeValueType,
 	digest.OperationValueType,
 }
 
 var hinters = []hint.Hinter{
 	currency.AccountHinter,
+	extension.AccountHinter,
 	currency.AddressHinter,
 	currency.AmountHinter,
 	currency.CreateAccountsFactHinter,
@@ -112,6 +193,7 @@ var hinters = []hint.Hinter{
 	document.SignDocumentsFactHinter,
 	document.SignDocumentsHinter,
 	document.SignItemSingleDocumentHinter,
+	document.SignItemSingleDocumentStatusHinter,
 	document.DocSignHinter,
 	document.CreateDocumentsFactHinter,
 	document.CreateDocumentsHinter,
@@ -119,23 +201,103 @@ var hinters = []hint.Hinter{
 	document.UpdateDocumentsFactHinter,
 	document.UpdateDocumentsHinter,
 	document.UpdateDocumentsItemImplHinter,
+	document.PatchDocumentsItemImplHinter,
+	document.RemoveDocumentsFactHinter,
+	document.RemoveDocumentsHinter,
+	document.RemoveDocumentsItemImplHinter,
+	document.TransferDocumentsFactHinter,
+	document.TransferDocumentsHinter,
+	document.TransferDocumentsItemImplHinter,
+	document.AmendSignersFactHinter,
+	document.AmendSignersHinter,
+	document.AmendSignersItemImplHinter,
+	document.RegisterDocumentTypeFactHinter,
+	document.RegisterDocumentTypeHinter,
+	document.GrantPermissionFactHinter,
+	document.GrantPermissionHinter,
+	document.RevokePermissionFactHinter,
+	document.RevokePermissionHinter,
+	document.DelegateEditorsFactHinter,
+	document.DelegateEditorsHinter,
+	document.DelegateEditorsItemImplHinter,
+	document.CastVoteFactHinter,
+	document.CastVoteHinter,
+	document.CastVoteItemImplHinter,
+	document.CloseVotingFactHinter,
+	document.CloseVotingHinter,
+	document.CloseVotingItemImplHinter,
+	document.CommitVoteFactHinter,
+	document.CommitVoteHinter,
+	document.CommitVoteItemImplHinter,
+	document.RevealVoteFactHinter,
+	document.RevealVoteHinter,
+	document.RevealVoteItemImplHinter,
+	document.RentLandFactHinter,
+	document.RentLandHinter,
+	document.RentLandItemImplHinter,
+	document.RenewLeaseFactHinter,
+	document.RenewLeaseHinter,
+	document.RenewLeaseItemImplHinter,
+	document.EndLeaseFactHinter,
+	document.EndLeaseHinter,
+	document.EndLeaseItemImplHinter,
+	document.DepositGoldFactHinter,
+	document.DepositGoldHinter,
+	document.DepositGoldItemImplHinter,
+	document.WithdrawGoldFactHinter,
+	document.WithdrawGoldHinter,
+	document.WithdrawGoldItemImplHinter,
+	document.TransferGoldFactHinter,
+	document.TransferGoldHinter,
+	document.TransferGoldItemImplHinter,
+	document.UpdateGoldRateFactHinter,
+	document.UpdateGoldRateHinter,
+	document.SwapGoldFactHinter,
+	document.SwapGoldHinter,
+	document.SwapGoldItemImplHinter,
+	document.UpdateStatPolicyFactHinter,
+	document.UpdateStatPolicyHinter,
+	document.ChangeStatsFactHinter,
+	document.ChangeStatsHinter,
+	document.ChangeStatsItemImplHinter,
 	document.DocumentHinter,
 	document.BSDocDataHinter,
 	document.BCUserDataHinter,
 	document.BCLandDataHinter,
 	document.BCVotingDataHinter,
 	document.BCHistoryDataHinter,
+	document.BCLandDataLegacyHinter,
+	document.BCVotingDataLegacyHinter,
+	document.BCHistoryDataLegacyHinter,
+	document.GeneralDocDataHinter,
+	document.ArchivedDocumentDataHinter,
 	document.UserStatisticsHinter,
 	document.DocInfoHinter,
 	document.VotingCandidateHinter,
 	document.BSDocIdHinter,
 	document.UserDocIdHinter,
 	document.LandDocIdHinter,
+	document.LandGeometryHinter,
+	document.DocumentPatchHinter,
+	document.GoldRateHinter,
+	document.StatPolicyHinter,
+	document.StatDeltaHinter,
 	document.VotingDocIdHinter,
 	document.HistoryDocIdHinter,
+	document.GeneralDocIdHinter,
+	document.DocFieldHinter,
+	document.DocTypeDesignHinter,
+	document.DocTypeRegistryHinter,
+	document.DelegationHinter,
+	document.DocumentDelegatesHinter,
+	document.VotingBoxHinter,
+	document.VoteCommitHinter,
+	document.VotingResultHinter,
+	document.VotingSnapshotsHinter,
 	document.DocumentInventoryHinter,
 	digest.AccountValue{},
 	digest.DocumentValue{},
+	digest.StatChangeValue{},
 	digest.BaseHal{},
 	digest.NodeInfo{},
 	digest.OperationValue{},
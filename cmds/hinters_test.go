@@ -0,0 +1,144 @@
+package cmds
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/protoconNet/mitum-document/document"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/encoder"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+type testHinters struct {
+	suite.Suite
+	enc   *jsonenc.Encoder
+	owner currency.Address
+}
+
+func (t *testHinters) SetupSuite() {
+	encs := encoder.NewEncoders()
+
+	t.enc = jsonenc.NewEncoder()
+	t.NoError(encs.AddEncoder(t.enc))
+
+	for i := range Types {
+		t.NoError(encs.AddType(Types[i]))
+	}
+
+	for i := range Hinters {
+		t.NoError(encs.AddHinter(Hinters[i]))
+	}
+
+	t.NoError(encs.Initialize())
+
+	t.owner = currency.NewAddress("owner")
+}
+
+// legacy returns the JSON of doc as stored by the v0.0.1 node; the hint is
+// replaced by ht and the given fields by the free-form strings.
+func (t *testHinters) legacy(doc document.DocumentData, ht hint.Hint, fields map[string]string) []byte {
+	b, err := t.enc.Marshal(doc)
+	t.NoError(err)
+
+	var m map[string]interface{}
+	t.NoError(json.Unmarshal(b, &m))
+
+	m["_hint"] = ht.String()
+	for k := range fields {
+		m[k] = fields[k]
+	}
+
+	b, err = json.Marshal(m)
+	t.NoError(err)
+
+	return b
+}
+
+func (t *testHinters) decode(b []byte) document.DocumentData {
+	hinter, err := t.enc.Decode(b)
+	t.NoError(err)
+
+	doc, ok := hinter.(document.DocumentData)
+	t.True(ok)
+	t.NoError(doc.IsValid(nil))
+
+	return doc
+}
+
+func (t *testHinters) TestDecodeLegacyBCLandData() {
+	doc := document.MustNewBCLandData(
+		document.MustNewDocInfo("1cli", document.BCLandDataType),
+		t.owner,
+		"address",
+		"area",
+		"renter",
+		t.owner,
+		document.NewDocumentTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		30,
+	)
+
+	b := t.legacy(doc, document.BCLandDataLegacyHint, map[string]string{"rentdate": "tomorrow"})
+
+	land, ok := t.decode(b).(document.BCLandData)
+	t.True(ok)
+	t.True(land.IsLegacy())
+	t.True(land.RentDate().IsRaw())
+	t.Equal("tomorrow", land.RentDate().String())
+
+	// the free-form date is not accepted by the new hint
+	_, err := t.enc.Decode(t.legacy(doc, document.BCLandDataHint, map[string]string{"rentdate": "tomorrow"}))
+	t.Error(err)
+}
+
+func (t *testHinters) TestDecodeLegacyBCVotingData() {
+	doc := document.MustNewBCVotingData(
+		document.MustNewDocInfo("1cvi", document.BCVotingDataType),
+		t.owner,
+		1,
+		document.NewDocumentTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		[]document.VotingCandidate{document.NewVotingCandidate(t.owner, "nickname", "manifest", 0)},
+		"",
+		t.owner,
+		document.NewDocumentTerm(time.Hour*720),
+	)
+
+	b := t.legacy(doc, document.BCVotingDataLegacyHint, map[string]string{
+		"endvotetime":  "next friday",
+		"termofoffice": "4 years",
+	})
+
+	voting, ok := t.decode(b).(document.BCVotingData)
+	t.True(ok)
+	t.True(voting.IsLegacy())
+	t.Equal("next friday", voting.EndVoteTime().String())
+	t.True(voting.TermOfOffice().IsRaw())
+	t.Equal("4 years", voting.TermOfOffice().String())
+}
+
+func (t *testHinters) TestDecodeLegacyBCHistoryData() {
+	doc := document.MustNewBCHistoryData(
+		document.MustNewDocInfo("1chi", document.BCHistoryDataType),
+		t.owner,
+		"name",
+		t.owner,
+		document.NewDocumentTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		"usage",
+		"application",
+	)
+
+	b := t.legacy(doc, document.BCHistoryDataLegacyHint, map[string]string{"date": "2021-13-45"})
+
+	history, ok := t.decode(b).(document.BCHistoryData)
+	t.True(ok)
+	t.True(history.IsLegacy())
+	t.Equal("2021-13-45", history.Date().String())
+}
+
+func TestHinters(t *testing.T) {
+	suite.Run(t, new(testHinters))
+}
@@ -0,0 +1,159 @@
+package cmds
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type PatchDocumentCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	ExpectedFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
+	DocumentId string                      `arg:"" name:"documentid" help:"document id" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Sets       []DocPayloadFlag            `name:"set" help:"set field (ex: \"<path>=<value>\")" sep:"@"`
+	Increments []DocPayloadFlag            `name:"increment" help:"increment number field (ex: \"<path>=<delta>\")" sep:"@"`
+	Signers    []DocSignFlag               `name:"add-signer" help:"append signer (ex: \"<address>,<signcode>\")" sep:"@"`
+	Candidates []CandidateFlag             `name:"add-candidate" help:"append candidate (ex: \"<address>,<nickname>\")" sep:"@"`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+	patches    []document.DocumentPatch
+}
+
+func NewPatchDocumentCommand() PatchDocumentCommand {
+	return PatchDocumentCommand{
+		BaseCommand: NewBaseCommand("patch-document-operation"),
+	}
+}
+
+func (cmd *PatchDocumentCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+// parseFlags collects the patches; they are applied in the order of set,
+// increment, signer and candidate.
+func (cmd *PatchDocumentCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sa, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sa
+
+	var patches []document.DocumentPatch
+	for i := range cmd.Sets {
+		patches = append(patches, document.NewSetPatch(cmd.Sets[i].name, cmd.Sets[i].value))
+	}
+
+	for i := range cmd.Increments {
+		d, err := strconv.ParseInt(cmd.Increments[i].value, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid increment, %q", cmd.Increments[i].String())
+		}
+
+		patches = append(patches, document.NewIncrementPatch(cmd.Increments[i].name, d))
+	}
+
+	for i := range cmd.Signers {
+		a, err := cmd.Signers[i].AD.Encode(jenc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid signer format, %q", cmd.Signers[i].String())
+		}
+
+		patches = append(patches, document.NewAppendPatch("signers", document.NewDocSign(a, cmd.Signers[i].SC, false)))
+	}
+
+	for i := range cmd.Candidates {
+		a, err := cmd.Candidates[i].AD.Encode(jenc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid candidate format, %q", cmd.Candidates[i].String())
+		}
+
+		patches = append(patches, document.NewAppendPatch(
+			"candidates", document.NewVotingCandidate(a, cmd.Candidates[i].nickname, "", 0)))
+	}
+
+	if len(patches) < 1 {
+		return errors.Errorf("empty patches, must be given at least one")
+	}
+	cmd.patches = patches
+
+	return nil
+}
+
+func (cmd *PatchDocumentCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.UpdateDocumentsItem
+	for j := range i {
+		if t, ok := i[j].(document.UpdateDocuments); ok {
+			items = t.Fact().(document.UpdateDocumentsFact).Items()
+		}
+	}
+
+	item := cmd.ExpectedFlags.applyPatch(document.NewPatchDocumentsItemImpl(
+		cmd.DocumentId,
+		cmd.patches,
+		cmd.Currency.CID,
+	))
+
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewUpdateDocumentsFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewUpdateDocuments(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create patch-document operation: %q", err)
+	}
+	return op, nil
+}
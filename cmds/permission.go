@@ -0,0 +1,13 @@
+package cmds
+
+type PermissionCommand struct {
+	Grant  GrantPermissionCommand  `cmd:"" name:"grant" help:"grant permission to account"`
+	Revoke RevokePermissionCommand `cmd:"" name:"revoke" help:"revoke permission from account"`
+}
+
+func NewPermissionCommand() PermissionCommand {
+	return PermissionCommand{
+		Grant:  NewGrantPermissionCommand(),
+		Revoke: NewRevokePermissionCommand(),
+	}
+}
@@ -0,0 +1,94 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type RegisterDocumentTypeCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	ShortType string             `arg:"" name:"shorttype" help:"short type of document id" required:""`
+	DocType   string             `arg:"" name:"doctype" help:"document type" required:""`
+	Fields    []DocFieldFlag     `name:"field" help:"document field (ex: \"<name>,<kind>,<required>,<max length>\")" sep:"@"`
+	Seal      mitumcmds.FileLoad `help:"seal" optional:""`
+	design    document.DocTypeDesign
+}
+
+func NewRegisterDocumentTypeCommand() RegisterDocumentTypeCommand {
+	return RegisterDocumentTypeCommand{
+		BaseCommand: NewBaseCommand("register-document-type-operation"),
+	}
+}
+
+func (cmd *RegisterDocumentTypeCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *RegisterDocumentTypeCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	fields := make([]document.DocField, len(cmd.Fields))
+	for i := range cmd.Fields {
+		fields[i] = cmd.Fields[i].field
+	}
+
+	design := document.NewDocTypeDesign(cmd.ShortType, hint.Type(cmd.DocType), fields)
+	if err := design.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.design = design
+
+	return nil
+}
+
+func (cmd *RegisterDocumentTypeCommand) createOperation() (operation.Operation, error) {
+	fact := document.NewRegisterDocumentTypeFact([]byte(cmd.Token), cmd.design)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewRegisterDocumentType(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create register-document-type operation: %q", err)
+	}
+	return op, nil
+}
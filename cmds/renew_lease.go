@@ -0,0 +1,112 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type RenewLeaseCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"renter address" required:""`
+	DocumentId string                      `arg:"" name:"documentid" help:"land document id" required:""`
+	Period     int64                       `arg:"" name:"period" help:"extended lease period in blocks" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Rent       currencycmds.BigFlag        `name:"rent" help:"rent paid to owner in currency" default:"0"`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+}
+
+func NewRenewLeaseCommand() RenewLeaseCommand {
+	return RenewLeaseCommand{
+		BaseCommand: NewBaseCommand("renew-lease-operation"),
+	}
+}
+
+func (cmd *RenewLeaseCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *RenewLeaseCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	if cmd.Period < 1 {
+		return errors.Errorf("invalid lease period, %d", cmd.Period)
+	}
+
+	return nil
+}
+
+func (cmd *RenewLeaseCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.RenewLeaseItem
+	for j := range i {
+		if t, ok := i[j].(document.RenewLease); ok {
+			items = t.Fact().(document.RenewLeaseFact).Items()
+		}
+	}
+
+	// NOTE the land owner also signs the fact by seal sign-fact
+	item := document.NewRenewLeaseItemImpl(cmd.DocumentId, base.Height(cmd.Period), cmd.Rent.Big, cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewRenewLeaseFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewRenewLease(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create renew-lease operation: %q", err)
+	}
+	return op, nil
+}
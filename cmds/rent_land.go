@@ -0,0 +1,120 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type RentLandCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"renter address" required:""`
+	DocumentId string                      `arg:"" name:"documentid" help:"land document id" required:""`
+	Period     int64                       `arg:"" name:"period" help:"lease period in blocks" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Name       string                      `name:"name" help:"renter name" optional:""`
+	Rent       currencycmds.BigFlag        `name:"rent" help:"rent paid to owner in currency" default:"0"`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+}
+
+func NewRentLandCommand() RentLandCommand {
+	return RentLandCommand{
+		BaseCommand: NewBaseCommand("rent-land-operation"),
+	}
+}
+
+func (cmd *RentLandCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *RentLandCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	if cmd.Period < 1 {
+		return errors.Errorf("invalid lease period, %d", cmd.Period)
+	}
+
+	return nil
+}
+
+func (cmd *RentLandCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.RentLandItem
+	for j := range i {
+		if t, ok := i[j].(document.RentLand); ok {
+			items = t.Fact().(document.RentLandFact).Items()
+		}
+	}
+
+	// NOTE the land owner also signs the fact by seal sign-fact
+	item := document.NewRentLandItemImpl(
+		cmd.DocumentId,
+		cmd.sender,
+		cmd.Name,
+		base.Height(cmd.Period),
+		cmd.Rent.Big,
+		cmd.Currency.CID,
+	)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewRentLandFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewRentLand(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create rent-land operation: %q", err)
+	}
+	return op, nil
+}
@@ -0,0 +1,114 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type RevealVoteCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"voter address" required:""`
+	DocumentId string                      `arg:"" name:"documentid" help:"voting document id" required:""`
+	Candidate  currencycmds.AddressFlag    `arg:"" name:"candidate" help:"candidate address" required:""`
+	Salt       string                      `arg:"" name:"salt" help:"secret salt of commitment" required:""`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Seal       mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender     base.Address
+	candidate  base.Address
+}
+
+func NewRevealVoteCommand() RevealVoteCommand {
+	return RevealVoteCommand{
+		BaseCommand: NewBaseCommand("reveal-vote-operation"),
+	}
+}
+
+func (cmd *RevealVoteCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *RevealVoteCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Candidate.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid candidate format, %q", cmd.Candidate.String())
+	}
+	cmd.candidate = a
+
+	return nil
+}
+
+func (cmd *RevealVoteCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.RevealVoteItem
+	for j := range i {
+		if t, ok := i[j].(document.RevealVote); ok {
+			items = t.Fact().(document.RevealVoteFact).Items()
+		}
+	}
+
+	item := document.NewRevealVoteItemImpl(cmd.DocumentId, cmd.candidate, cmd.Salt, cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewRevealVoteFact([]byte(cmd.Token), cmd.sender, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewRevealVote(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create reveal-vote operation: %q", err)
+	}
+	return op, nil
+}
@@ -12,6 +12,8 @@ type SealCommand struct {
 	CurrencyRegister      currencycmds.CurrencyRegisterCommand      `cmd:"" name:"currency-register" help:"register new currency"`
 	CurrencyPolicyUpdater currencycmds.CurrencyPolicyUpdaterCommand `cmd:"" name:"currency-policy-updater" help:"update currency policy"`  // revive:disable-line:line-length-limit
 	SuffrageInflation     currencycmds.SuffrageInflationCommand     `cmd:"" name:"suffrage-inflation" help:"suffrage inflation operation"` // revive:disable-line:line-length-limit
+	RegisterDocumentType  RegisterDocumentTypeCommand               `cmd:"" name:"register-document-type" help:"register new document type"`
+	Permission            PermissionCommand                         `cmd:"" name:"permission" help:"grant or revoke account permission"`
 	Sign                  currencycmds.SignSealCommand              `cmd:"" name:"sign" help:"sign seal"`
 	SignFact              currencycmds.SignFactCommand              `cmd:"" name:"sign-fact" help:"sign facts of operation seal"`
 }
@@ -27,6 +29,8 @@ func NewSealCommand() SealCommand {
 		CurrencyRegister:      currencycmds.NewCurrencyRegisterCommand(),
 		CurrencyPolicyUpdater: currencycmds.NewCurrencyPolicyUpdaterCommand(),
 		SuffrageInflation:     currencycmds.NewSuffrageInflationCommand(),
+		RegisterDocumentType:  NewRegisterDocumentTypeCommand(),
+		Permission:            NewPermissionCommand(),
 		Sign:                  currencycmds.NewSignSealCommand(),
 		SignFact:              currencycmds.NewSignFactCommand(),
 	}
@@ -19,6 +19,7 @@ type SignDocumentCommand struct {
 	DocId    string                      `arg:"" name:"documentid" help:"document id" required:""`
 	Owner    currencycmds.AddressFlag    `arg:"" name:"owner" help:"owner address" required:""`
 	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Status   string                      `name:"status" help:"sign status; signed, declined or revoked" default:"signed"`
 	Seal     mitumcmds.FileLoad          `help:"seal" optional:""`
 	sender   base.Address
 	owner    base.Address
@@ -91,7 +92,12 @@ func (cmd *SignDocumentCommand) createOperation() (operation.Operation, error) {
 		}
 	}
 
-	item := document.NewSignDocumentsItemSingleFile(cmd.DocId, cmd.owner, cmd.Currency.CID)
+	var item document.SignDocumentItem
+	if status := document.DocSignStatus(cmd.Status); status == document.DocSignStatusSigned {
+		item = document.NewSignDocumentsItemSingleFile(cmd.DocId, cmd.owner, cmd.Currency.CID)
+	} else {
+		item = document.NewSignDocumentsItemSingleFileStatus(cmd.DocId, cmd.owner, status, cmd.Currency.CID)
+	}
 
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
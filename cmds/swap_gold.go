@@ -0,0 +1,109 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type SwapGoldCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"owner address" required:""`
+	DocumentId   string                      `arg:"" name:"documentid" help:"user document id of sender" required:""`
+	Mode         string                      `arg:"" name:"mode" help:"swap mode; buy or sell" required:""`
+	Amount       uint                        `arg:"" name:"amount" help:"amount of gold" required:""`
+	SwapCurrency currencycmds.CurrencyIDFlag `arg:"" name:"swap-currency" help:"currency id of gold rate" required:""`
+	Price        currencycmds.BigFlag        `arg:"" name:"price" help:"price of one gold in gold rate" required:""`
+	Currency     currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Seal         mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender       base.Address
+}
+
+func NewSwapGoldCommand() SwapGoldCommand {
+	return SwapGoldCommand{
+		BaseCommand: NewBaseCommand("swap-gold-operation"),
+	}
+}
+
+func (cmd *SwapGoldCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *SwapGoldCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *SwapGoldCommand) createOperation() (operation.Operation, error) { // nolint:dupl
+	i, err := loadOperations(cmd.Seal.Bytes(), cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	var items []document.SwapGoldItem
+	for j := range i {
+		if t, ok := i[j].(document.SwapGold); ok {
+			items = t.Fact().(document.SwapGoldFact).Items()
+		}
+	}
+
+	item := document.NewSwapGoldItemImpl(cmd.DocumentId, document.SwapMode(cmd.Mode), cmd.Amount, cmd.Currency.CID)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := document.NewSwapGoldFact([]byte(cmd.Token), cmd.sender, cmd.SwapCurrency.CID, cmd.Price.Big, items)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewSwapGold(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create swap-gold operation: %q", err)
+	}
+	return op, nil
+}
@@ -15,9 +15,10 @@ import (
 type UpdateBlockcityUserDocumentCommand struct {
 	*BaseCommand
 	currencycmds.OperationFlags
+	ExpectedFlags
 	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
-	Gold         uint                        `arg:"" name:"gold" help:"gold" required:""`
-	Bankgold     uint                        `arg:"" name:"bankgold" help:"bankgold" required:""`
+	Gold         uint                        `arg:"" name:"gold" help:"current gold; changed only by gold operations" required:""`
+	Bankgold     uint                        `arg:"" name:"bankgold" help:"current bankgold; changed only by gold operations" required:""`
 	Hp           uint                        `arg:"" name:"hp" help:"hp" required:""`
 	Strength     uint                        `arg:"" name:"strength" help:"strength" required:""`
 	Agility      uint                        `arg:"" name:"agility" help:"agility" required:""`
@@ -93,10 +94,10 @@ func (cmd *UpdateBlockcityUserDocumentCommand) createOperation() (operation.Oper
 	statistics := document.NewUserStatistics(cmd.Hp, cmd.Strength, cmd.Agility, cmd.Dexterity, cmd.Charisma, cmd.Intelligence, cmd.Vital)
 	userDoc := document.NewBCUserData(info, cmd.sender, cmd.Gold, cmd.Bankgold, statistics)
 
-	item := document.NewUpdateDocumentsItemImpl(
+	item := cmd.ExpectedFlags.apply(document.NewUpdateDocumentsItemImpl(
 		userDoc,
 		cmd.Currency.CID,
-	)
+	))
 
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
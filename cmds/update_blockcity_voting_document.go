@@ -9,27 +9,35 @@ import (
 	"github.com/spikeekips/mitum/util"
 
 	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	"github.com/spikeekips/mitum-currency/currency"
 	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
 )
 
 type UpdateBlockcityVotingDocumentCommand struct {
 	*BaseCommand
 	currencycmds.OperationFlags
+	ExpectedFlags
 	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:""`
 	Round       uint                        `arg:"" name:"round" help:"voting round" required:""`
-	EndVoteTime string                      `arg:"" name:"endvotetime" help:"end vote time" required:""`
+	EndVoteTime string                      `arg:"" name:"endvotetime" help:"end vote time; RFC3339 or yyyy-mm-dd" required:""`
 	Candidates  []currencycmds.AddressFlag  `name:"candidates" help:"candidates address" required:""`
 	Nicknames   []string                    `name:"nicknames" help:"candidates nicknames" required:""`
 	Count       []uint                      `name:"count" help:"candidates count" required:""`
 	BossName    string                      `arg:"" name:"bossname" help:"boss name" required:""`
 	Account     currencycmds.AddressFlag    `arg:"" name:"bossaccount" help:"boss account address" required:""`
-	Term        string                      `arg:"" name:"termofoffice" help:"term of office" required:""`
+	Term        string                      `arg:"" name:"termofoffice" help:"term of office; duration like 720h" required:""`
 	DocumentId  string                      `arg:"" name:"documentid" help:"document id" required:""`
 	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Deadline    int64                       `name:"deadline-height" help:"block height, after which votes are not accepted" optional:""`
+	Reveal      int64                       `name:"reveal-deadline-height" help:"block height, until which committed votes are revealed; secret ballot" optional:""`
+	Weight      string                      `name:"weight" help:"weight of votes; balance or gold" optional:""`
+	WeightCID   string                      `name:"weight-currency" help:"currency id of balance weight" optional:""`
 	Seal        mitumcmds.FileLoad          `help:"seal" optional:""`
 	sender      base.Address
 	candidates  []document.VotingCandidate
 	account     base.Address
+	endVoteTime document.DocumentTime
+	term        document.DocumentTerm
 }
 
 func NewUpdateBlockcityVotingDocumentCommand() UpdateBlockcityVotingDocumentCommand {
@@ -82,6 +90,18 @@ func (cmd *UpdateBlockcityVotingDocumentCommand) parseFlags() error {
 	}
 	cmd.account = ba
 
+	if cmd.Deadline < 0 {
+		return errors.Errorf("invalid deadline height, %d", cmd.Deadline)
+	}
+
+	if cmd.Reveal < 0 {
+		return errors.Errorf("invalid reveal deadline height, %d", cmd.Reveal)
+	}
+
+	if err := document.VotingWeight(cmd.Weight).IsValid(nil); err != nil {
+		return err
+	}
+
 	if len(cmd.Candidates) < 1 {
 		return errors.Errorf("empty candidates, must be given at least one")
 	}
@@ -98,6 +118,18 @@ func (cmd *UpdateBlockcityVotingDocumentCommand) parseFlags() error {
 		cmd.candidates = candidates
 	}
 
+	vt, err := document.ParseDocumentTime(cmd.EndVoteTime)
+	if err != nil {
+		return errors.Wrap(err, "invalid end vote time")
+	}
+	cmd.endVoteTime = vt
+
+	tm, err := document.ParseDocumentTerm(cmd.Term)
+	if err != nil {
+		return errors.Wrap(err, "invalid term of office")
+	}
+	cmd.term = tm
+
 	return nil
 }
 
@@ -114,12 +146,15 @@ func (cmd *UpdateBlockcityVotingDocumentCommand) createOperation() (operation.Op
 	}
 
 	info := document.NewDocInfo(cmd.DocumentId, document.BCVotingDataType)
-	doc := document.NewBCVotingData(info, cmd.sender, cmd.Round, cmd.EndVoteTime, cmd.candidates, cmd.BossName, cmd.account, cmd.Term)
+	doc := document.NewBCVotingData(info, cmd.sender, cmd.Round, cmd.endVoteTime, cmd.candidates, cmd.BossName, cmd.account, cmd.term).
+		WithDeadline(base.Height(cmd.Deadline)).
+		WithRevealDeadline(base.Height(cmd.Reveal)).
+		WithWeight(document.VotingWeight(cmd.Weight), currency.CurrencyID(cmd.WeightCID))
 
-	item := document.NewUpdateDocumentsItemImpl(
+	item := cmd.ExpectedFlags.apply(document.NewUpdateDocumentsItemImpl(
 		doc,
 		cmd.Currency.CID,
-	)
+	))
 
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
@@ -0,0 +1,102 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type UpdateGoldRateCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"blockcity admin address" required:""`
+	Treasury     currencycmds.AddressFlag    `arg:"" name:"treasury" help:"treasury address, which pays and receives currency of swap" required:""`
+	RateCurrency currencycmds.CurrencyIDFlag `arg:"" name:"rate-currency" help:"currency id of swap" required:""`
+	Price        currencycmds.BigFlag        `arg:"" name:"price" help:"amount of currency for one gold" required:""`
+	Currency     currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Seal         mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender       base.Address
+	treasury     base.Address
+}
+
+func NewUpdateGoldRateCommand() UpdateGoldRateCommand {
+	return UpdateGoldRateCommand{
+		BaseCommand: NewBaseCommand("update-gold-rate-operation"),
+	}
+}
+
+func (cmd *UpdateGoldRateCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *UpdateGoldRateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	a, err = cmd.Treasury.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid treasury format, %q", cmd.Treasury.String())
+	}
+	cmd.treasury = a
+
+	return nil
+}
+
+func (cmd *UpdateGoldRateCommand) createOperation() (operation.Operation, error) {
+	rate := document.NewGoldRate(cmd.RateCurrency.CID, cmd.Price.Big, cmd.treasury)
+	if err := rate.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := document.NewUpdateGoldRateFact([]byte(cmd.Token), cmd.sender, rate, cmd.Currency.CID)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewUpdateGoldRate(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create update-gold-rate operation: %q", err)
+	}
+	return op, nil
+}
@@ -0,0 +1,95 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	currencycmds "github.com/spikeekips/mitum-currency/cmds"
+	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
+)
+
+type UpdateStatPolicyCommand struct {
+	*BaseCommand
+	currencycmds.OperationFlags
+	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"blockcity admin address" required:""`
+	Min      StatisticsFlag              `arg:"" name:"min" help:"min of statistics (ex: \"<hp>,<strength>,...,<vital>\")" required:""`
+	Max      StatisticsFlag              `arg:"" name:"max" help:"max of statistics (ex: \"<hp>,<strength>,...,<vital>\")" required:""`
+	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:""`
+	Budget   uint                        `name:"budget" help:"max of sum of statistics; no budget when 0"`
+	Seal     mitumcmds.FileLoad          `help:"seal" optional:""`
+	sender   base.Address
+}
+
+func NewUpdateStatPolicyCommand() UpdateStatPolicyCommand {
+	return UpdateStatPolicyCommand{
+		BaseCommand: NewBaseCommand("update-stat-policy-operation"),
+	}
+}
+
+func (cmd *UpdateStatPolicyCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Errorf("failed to initialize command: %q", err)
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	sl, err := LoadSealAndAddOperation(
+		cmd.Seal.Bytes(),
+		cmd.Privatekey,
+		cmd.NetworkID.NetworkID(),
+		op,
+	)
+	if err != nil {
+		return err
+	}
+	currencycmds.PrettyPrint(cmd.Out, cmd.Pretty, sl)
+	return nil
+}
+
+func (cmd *UpdateStatPolicyCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *UpdateStatPolicyCommand) createOperation() (operation.Operation, error) {
+	policy := document.NewStatPolicy(cmd.Min.statistics, cmd.Max.statistics, cmd.Budget)
+	if err := policy.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := document.NewUpdateStatPolicyFact([]byte(cmd.Token), cmd.sender, policy, cmd.Currency.CID)
+
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs := []base.FactSign{
+		base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig),
+	}
+
+	op, err := document.NewUpdateStatPolicy(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Errorf("failed to create update-stat-policy operation: %q", err)
+	}
+	return op, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spikeekips/mitum/util/hint"
 
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/spikeekips/mitum-currency/currency"
 	currencydigest "github.com/spikeekips/mitum-currency/digest"
 )
@@ -20,6 +21,7 @@ type AccountValue struct {
 	ac             currency.Account
 	balance        []currency.Amount
 	document       document.DocumentInventory
+	permissions    []extension.AccountPermission
 	height         base.Height
 	previousHeight base.Height
 }
@@ -58,6 +60,10 @@ func (va AccountValue) Document() document.DocumentInventory {
 	return va.document
 }
 
+func (va AccountValue) Permissions() []extension.AccountPermission {
+	return va.permissions
+}
+
 func (va AccountValue) Height() base.Height {
 	return va.height
 }
@@ -93,3 +99,9 @@ func (va AccountValue) SetDocument(document document.DocumentInventory) AccountV
 
 	return va
 }
+
+func (va AccountValue) SetPermissions(permissions []extension.AccountPermission) AccountValue {
+	va.permissions = permissions
+
+	return va
+}
@@ -13,6 +13,7 @@ func (va AccountValue) MarshalBSON() ([]byte, error) {
 			"ac":              va.ac,
 			"balance":         va.balance,
 			"documents":       va.document,
+			"permissions":     va.permissions,
 			"height":          va.height,
 			"previous_height": va.previousHeight,
 		},
@@ -23,6 +24,7 @@ type AccountValueBSONUnpacker struct {
 	AC bson.Raw    `bson:"ac"`
 	BL bson.Raw    `bson:"balance"`
 	CD bson.Raw    `bson:"documents"`
+	PM []uint      `bson:"permissions"`
 	HT base.Height `bson:"height"`
 	PT base.Height `bson:"previous_height"`
 }
@@ -33,5 +35,5 @@ func (va *AccountValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return va.unpack(enc, uva.AC, uva.BL, uva.CD, uva.HT, uva.PT)
+	return va.unpack(enc, uva.AC, uva.BL, uva.CD, uva.PM, uva.HT, uva.PT)
 }
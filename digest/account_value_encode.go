@@ -3,13 +3,21 @@ package digest
 import (
 	"github.com/pkg/errors"
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 )
 
-func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bl []byte, cd []byte, height, previousHeight base.Height) error {
+func (va *AccountValue) unpack(
+	enc encoder.Encoder,
+	bac []byte,
+	bl []byte,
+	cd []byte,
+	pm []uint,
+	height, previousHeight base.Height,
+) error {
 	if err := encoder.Decode(bac, enc, &va.ac); err != nil {
 		return err
 	}
@@ -40,6 +48,13 @@ func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bl []byte, cd []
 		}
 	}
 
+	if len(pm) > 0 {
+		va.permissions = make([]extension.AccountPermission, len(pm))
+		for i := range pm {
+			va.permissions[i] = extension.AccountPermission(pm[i])
+		}
+	}
+
 	va.height = height
 	va.previousHeight = previousHeight
 
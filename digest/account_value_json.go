@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
@@ -12,10 +13,11 @@ import (
 type AccountValueJSONPacker struct {
 	jsonenc.HintedHead
 	currency.AccountPackerJSON
-	BL []currency.Amount          `json:"balance,omitempty"`
-	CD document.DocumentInventory `json:"documents"`
-	HT base.Height                `json:"height"`
-	PT base.Height                `json:"previous_height"`
+	BL []currency.Amount             `json:"balance,omitempty"`
+	CD document.DocumentInventory    `json:"documents"`
+	PM []extension.AccountPermission `json:"permissions,omitempty"`
+	HT base.Height                   `json:"height"`
+	PT base.Height                   `json:"previous_height"`
 }
 
 func (va AccountValue) MarshalJSON() ([]byte, error) {
@@ -24,6 +26,7 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 		AccountPackerJSON: va.ac.PackerJSON(),
 		BL:                va.balance,
 		CD:                va.document,
+		PM:                va.permissions,
 		HT:                va.height,
 		PT:                va.previousHeight,
 	})
@@ -32,6 +35,7 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 type AccountValueJSONUnpacker struct {
 	BL json.RawMessage `json:"balance"`
 	CD json.RawMessage `json:"documents"`
+	PM []uint          `json:"permissions"`
 	HT base.Height     `json:"height"`
 	PT base.Height     `json:"previous_height"`
 }
@@ -43,7 +47,7 @@ func (va *AccountValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 	}
 
 	ac := new(currency.Account)
-	if err := va.unpack(enc, nil, uva.BL, uva.CD, uva.HT, uva.PT); err != nil {
+	if err := va.unpack(enc, nil, uva.BL, uva.CD, uva.PM, uva.HT, uva.PT); err != nil {
 		return err
 	} else if err := ac.UnpackJSON(b, enc); err != nil {
 		return err
@@ -11,6 +11,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base/block"
 	"github.com/spikeekips/mitum/base/operation"
@@ -25,16 +26,21 @@ var bulkWriteLimit = 500
 
 type BlockSession struct {
 	sync.RWMutex
-	block           block.Block
-	st              *Database
-	opsTreeNodes    map[string]operation.FixedTreeNode
-	operationModels []mongo.WriteModel
-	accountModels   []mongo.WriteModel
-	documentModels  []mongo.WriteModel
-	documentsModels []mongo.WriteModel
-	balanceModels   []mongo.WriteModel
-	statesValue     *sync.Map
-	documentList    []string
+	block              block.Block
+	st                 *Database
+	opsTreeNodes       map[string]operation.FixedTreeNode
+	operationModels    []mongo.WriteModel
+	accountModels      []mongo.WriteModel
+	documentModels     []mongo.WriteModel
+	documentsModels    []mongo.WriteModel
+	balanceModels      []mongo.WriteModel
+	permissionModels   []mongo.WriteModel
+	delegatesModels    []mongo.WriteModel
+	votingBoxModels    []mongo.WriteModel
+	votingResultModels []mongo.WriteModel
+	statChangeModels   []mongo.WriteModel
+	statesValue        *sync.Map
+	documentList       []string
 }
 
 func NewBlockSession(st *Database, blk block.Block) (*BlockSession, error) {
@@ -111,6 +117,36 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 		}
 	}
 
+	if len(bs.permissionModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNamePermission, bs.permissionModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.delegatesModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameDelegates, bs.delegatesModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.votingBoxModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameVotingBox, bs.votingBoxModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.votingResultModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameVotingResult, bs.votingResultModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.statChangeModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameStatChange, bs.statChangeModels); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -189,6 +225,11 @@ func (bs *BlockSession) prepareAccounts() error {
 	var balanceModels []mongo.WriteModel
 	var documentModels []mongo.WriteModel
 	var documentsModels []mongo.WriteModel
+	var permissionModels []mongo.WriteModel
+	var delegatesModels []mongo.WriteModel
+	var votingBoxModels []mongo.WriteModel
+	var votingResultModels []mongo.WriteModel
+	var statChangeModels []mongo.WriteModel
 	for i := range bs.block.States() {
 		st := bs.block.States()[i]
 		switch {
@@ -210,12 +251,42 @@ func (bs *BlockSession) prepareAccounts() error {
 			} else {
 				documentModels = append(documentModels, j...)
 			}
+
+			j, err := bs.handleStatChangeState(st)
+			if err != nil {
+				return err
+			}
+			statChangeModels = append(statChangeModels, j...)
 		case document.IsStateDocumentsKey(st.Key()):
 			if j, err := bs.handleDocumentsState(st); err != nil {
 				return err
 			} else {
 				documentsModels = append(documentsModels, j...)
 			}
+		case extension.IsStateAccountKey(st.Key()):
+			j, err := bs.handlePermissionState(st)
+			if err != nil {
+				return err
+			}
+			permissionModels = append(permissionModels, j...)
+		case document.IsStateDelegatesKey(st.Key()):
+			j, err := bs.handleDelegatesState(st)
+			if err != nil {
+				return err
+			}
+			delegatesModels = append(delegatesModels, j...)
+		case document.IsStateVotingBoxKey(st.Key()):
+			j, err := bs.handleVotingBoxState(st)
+			if err != nil {
+				return err
+			}
+			votingBoxModels = append(votingBoxModels, j...)
+		case document.IsStateVotingResultKey(st.Key()):
+			j, err := bs.handleVotingResultState(st)
+			if err != nil {
+				return err
+			}
+			votingResultModels = append(votingResultModels, j...)
 		default:
 			continue
 		}
@@ -232,6 +303,26 @@ func (bs *BlockSession) prepareAccounts() error {
 		bs.documentsModels = documentsModels
 	}
 
+	if len(permissionModels) > 0 {
+		bs.permissionModels = permissionModels
+	}
+
+	if len(delegatesModels) > 0 {
+		bs.delegatesModels = delegatesModels
+	}
+
+	if len(votingBoxModels) > 0 {
+		bs.votingBoxModels = votingBoxModels
+	}
+
+	if len(votingResultModels) > 0 {
+		bs.votingResultModels = votingResultModels
+	}
+
+	if len(statChangeModels) > 0 {
+		bs.statChangeModels = statChangeModels
+	}
+
 	return nil
 }
 
@@ -266,6 +357,49 @@ func (bs *BlockSession) handleDocumentDataState(st state.State) ([]mongo.WriteMo
 	}
 }
 
+// handleStatChangeState compares the statistics of blockcity user document
+// with the previous version in digest and logs the change. It must be done
+// before the previous version is cleaned in Commit.
+func (bs *BlockSession) handleStatChangeState(st state.State) ([]mongo.WriteModel, error) {
+	doc, err := document.StateDocumentDataValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	ud, ok := doc.(document.BCUserData)
+	if !ok {
+		return nil, nil
+	}
+
+	var previous document.UserStatistics
+	switch va, found, err := bs.st.Document(ud.DocumentId()); {
+	case err != nil:
+		return nil, err
+	case found:
+		if va.Height() >= bs.block.Height() {
+			return nil, nil
+		}
+
+		if i, ok := va.Document().(document.BCUserData); ok {
+			previous = i.Statistics()
+		}
+	}
+
+	if previous.Equal(ud.Statistics()) {
+		return nil, nil
+	}
+
+	sdoc, err := NewStatChangeDoc(
+		NewStatChangeValue(ud.DocumentId(), previous, ud.Statistics(), bs.block.Height()),
+		bs.st.database.Encoder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(sdoc)}, nil
+}
+
 func (bs *BlockSession) handleDocumentsState(st state.State) ([]mongo.WriteModel, error) {
 	if doc, err := NewDocumentsDoc(st, bs.st.database.Encoder()); err != nil {
 		return nil, err
@@ -274,6 +408,38 @@ func (bs *BlockSession) handleDocumentsState(st state.State) ([]mongo.WriteModel
 	}
 }
 
+func (bs *BlockSession) handlePermissionState(st state.State) ([]mongo.WriteModel, error) {
+	doc, err := NewPermissionDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleDelegatesState(st state.State) ([]mongo.WriteModel, error) {
+	doc, err := NewDelegatesDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleVotingBoxState(st state.State) ([]mongo.WriteModel, error) {
+	doc, err := NewVotingBoxDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleVotingResultState(st state.State) ([]mongo.WriteModel, error) {
+	doc, err := NewVotingResultDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
 func (bs *BlockSession) writeModels(ctx context.Context, col string, models []mongo.WriteModel) error {
 	started := time.Now()
 	defer func() {
@@ -325,6 +491,10 @@ func (bs *BlockSession) close() error {
 	bs.balanceModels = nil
 	bs.documentModels = nil
 	bs.documentsModels = nil
+	bs.permissionModels = nil
+	bs.delegatesModels = nil
+	bs.votingBoxModels = nil
+	bs.votingResultModels = nil
 
 	return bs.st.Close()
 }
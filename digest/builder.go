@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/key"
@@ -13,6 +14,7 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 var (
@@ -34,6 +36,14 @@ var (
 	templateSigner           = currency.NewAddress("aunt")
 	templateSignerSigncode   = "rabbits"
 	templateId               = "5cvi"
+	templateReceiverId       = "6dwj"
+	templateGold             uint
+	templateStatistics       = document.NewUserStatistics(1, 1, 1, 1, 1, 1, 1)
+	templateStatDelta        = document.NewStatDelta(1, 0, 0, 0, 0, 0, 0)
+	templateShortType        = "xxx"
+	templateDocType          = hint.Type("my-document-type")
+	templateSalt             = "sheep"
+	templateCommitment       = valuehash.NewSHA256([]byte(templateSalt))
 )
 
 func init() {
@@ -49,12 +59,22 @@ func init() {
 type Builder struct {
 	enc       encoder.Encoder
 	networkID base.NetworkID
+	database  *Database
 }
 
 func NewBuilder(enc encoder.Encoder, networkID base.NetworkID) Builder {
 	return Builder{enc: enc, networkID: networkID}
 }
 
+// WithDatabase sets database; with database, the empty expected hash of
+// UpdateDocumentsItem is filled with the hash of current document and the
+// registered document types are loaded for GeneralDocumentTemplate.
+func (bl Builder) WithDatabase(st *Database) Builder {
+	bl.database = st
+
+	return bl
+}
+
 func (bl Builder) FactTemplate(ht hint.Hint) (Hal, error) {
 	switch ht.Type() {
 	case currency.CreateAccountsType:
@@ -73,6 +93,48 @@ func (bl Builder) FactTemplate(ht hint.Hint) (Hal, error) {
 		return bl.templateCreateDocumentsFact(), nil
 	case document.SignDocumentsType:
 		return bl.templateSignDocumentsFact(), nil
+	case document.RemoveDocumentsType:
+		return bl.templateRemoveDocumentsFact(), nil
+	case document.TransferDocumentsType:
+		return bl.templateTransferDocumentsFact(), nil
+	case document.AmendSignersType:
+		return bl.templateAmendSignersFact(), nil
+	case document.RegisterDocumentTypeType:
+		return bl.templateRegisterDocumentTypeFact(), nil
+	case document.GrantPermissionType:
+		return bl.templateGrantPermissionFact(), nil
+	case document.RevokePermissionType:
+		return bl.templateRevokePermissionFact(), nil
+	case document.DelegateEditorsType:
+		return bl.templateDelegateEditorsFact(), nil
+	case document.CastVoteType:
+		return bl.templateCastVoteFact(), nil
+	case document.CommitVoteType:
+		return bl.templateCommitVoteFact(), nil
+	case document.RevealVoteType:
+		return bl.templateRevealVoteFact(), nil
+	case document.CloseVotingType:
+		return bl.templateCloseVotingFact(), nil
+	case document.RentLandType:
+		return bl.templateRentLandFact(), nil
+	case document.RenewLeaseType:
+		return bl.templateRenewLeaseFact(), nil
+	case document.EndLeaseType:
+		return bl.templateEndLeaseFact(), nil
+	case document.DepositGoldType:
+		return bl.templateDepositGoldFact(), nil
+	case document.WithdrawGoldType:
+		return bl.templateWithdrawGoldFact(), nil
+	case document.TransferGoldType:
+		return bl.templateTransferGoldFact(), nil
+	case document.UpdateGoldRateType:
+		return bl.templateUpdateGoldRateFact(), nil
+	case document.SwapGoldType:
+		return bl.templateSwapGoldFact(), nil
+	case document.UpdateStatPolicyType:
+		return bl.templateUpdateStatPolicyFact(), nil
+	case document.ChangeStatsType:
+		return bl.templateChangeStatsFact(), nil
 	default:
 		return nil, errors.Errorf("unknown operation, %q", ht)
 	}
@@ -195,6 +257,63 @@ func (Builder) templateCreateDocumentsFact() Hal {
 	})
 }
 
+// GeneralDocumentTemplate returns the template of CreateDocuments for the
+// registered document type; the payload has the zero value of every field. The
+// document type is looked up in the registry state of database.
+func (bl Builder) GeneralDocumentTemplate(docType hint.Type) (Hal, error) {
+	if bl.database == nil {
+		return nil, errors.Errorf("database not set for document type, %q", docType)
+	}
+
+	rg, err := bl.database.DocTypeRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	de, found := rg.GetByType(docType)
+	if !found {
+		return nil, errors.Errorf("unknown document type, %q", docType)
+	}
+
+	payload := map[string]string{}
+	for i := range de.Fields() {
+		f := de.Fields()[i]
+
+		switch f.Kind() {
+		case document.DocFieldKindInt, document.DocFieldKindBig:
+			payload[f.Name()] = "0"
+		case document.DocFieldKindBool:
+			payload[f.Name()] = "false"
+		case document.DocFieldKindAddress:
+			payload[f.Name()] = templateOwner.String()
+		default:
+			payload[f.Name()] = ""
+		}
+	}
+
+	doc := document.NewGeneralDocData(
+		document.NewGeneralDocInfo(templateId[:len(templateId)-document.DocIdShortTypeSize]+de.ShortType(), docType),
+		templateOwner,
+		payload,
+	)
+
+	fact := document.NewCreateDocumentsFact(
+		templateToken,
+		templateSender,
+		[]document.CreateDocumentsItem{document.NewCreateDocumentsItemImpl(
+			doc,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":    templateToken,
+		"sender":   templateSender,
+		"currency": templateCurrencyID,
+	}), nil
+}
+
 func (Builder) templateSignDocumentsFact() Hal {
 
 	fact := document.NewSignDocumentsFact(
@@ -216,314 +335,2117 @@ func (Builder) templateSignDocumentsFact() Hal {
 	})
 }
 
-func (bl Builder) BuildFact(b []byte) (Hal, error) {
-	var fact base.Fact
-	if hinter, err := bl.enc.Decode(b); err != nil {
-		return nil, err
-	} else if f, ok := hinter.(base.Fact); !ok {
-		return nil, errors.Errorf("not base.Fact, %T", hinter)
-	} else {
-		fact = f
-	}
-
-	switch t := fact.(type) {
-	case currency.CreateAccountsFact:
-		return bl.buildFactCreateAccounts(t)
-	case currency.KeyUpdaterFact:
-		return bl.buildFactKeyUpdater(t)
-	case currency.TransfersFact:
-		return bl.buildFactTransfers(t)
-	case currency.CurrencyRegisterFact:
-		return bl.buildFactCurrencyRegister(t)
-	case currency.CurrencyPolicyUpdaterFact:
-		return bl.buildFactCurrencyPolicyUpdater(t)
-	case document.CreateDocumentsFact:
-		return bl.buildFactCreateDocuments(t)
-	case document.SignDocumentsFact:
-		return bl.buildFactSignDocuments(t)
-	default:
-		return nil, errors.Errorf("unknown fact, %T", fact)
-	}
-}
-
-func (bl Builder) buildFactCreateAccounts(fact currency.CreateAccountsFact) (Hal, error) {
-	token, err := bl.checkToken(fact.Token())
-	if err != nil {
-		return nil, err
-	}
+func (Builder) templateRemoveDocumentsFact() Hal {
 
-	items := make([]currency.CreateAccountsItem, len(fact.Items()))
-	for i := range fact.Items() {
-		item := fact.Items()[i]
-		if len(item.Amounts()) < 1 {
-			return nil, errors.Errorf("empty Amounts")
-		}
+	fact := document.NewRemoveDocumentsFact(
+		templateToken,
+		templateSender,
+		[]document.RemoveDocumentsItem{document.NewRemoveDocumentsItemImpl(
+			templateId,
+			templateCurrencyID,
+		)},
+	)
 
-		ks, e := currency.NewBaseAccountKeys(item.Keys().Keys(), item.Keys().Threshold())
-		if e != nil {
-			return nil, e
-		}
-		items[i] = currency.NewCreateAccountsItemSingleAmount(ks, item.Amounts()[0])
-	}
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"currency":         templateCurrencyID,
+	})
+}
 
-	nfact := currency.NewCreateAccountsFact(token, fact.Sender(), items)
-	nfact = nfact.Rebuild()
-	if err = bl.isValidFactCreateAccounts(nfact); err != nil {
-		return nil, err
-	}
+func (Builder) templateTransferDocumentsFact() Hal {
 
-	var hal Hal
-	hal = NewBaseHal(nil, HalLink{})
-	op, err := currency.NewCreateAccounts(
-		nfact,
-		[]base.FactSign{
-			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
-		},
-		"",
+	fact := document.NewTransferDocumentsFact(
+		templateToken,
+		templateSender,
+		[]document.TransferDocumentsItem{document.NewTransferDocumentsItemImpl(
+			templateId,
+			templateReceiver,
+			templateCurrencyID,
+		)},
 	)
-	if err != nil {
-		return nil, err
-	}
-	hal = hal.SetInterface(op)
 
-	return hal.
-		AddExtras("default", map[string]interface{}{
-			"fact_signs.signer":    templatePublickey,
-			"fact_signs.signature": templateSignature,
-		}).
-		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.receiver":   templateReceiver,
+		"currency":         templateCurrencyID,
+	})
 }
 
-func (bl Builder) buildFactCreateDocuments(fact document.CreateDocumentsFact) (Hal, error) {
-	token, err := bl.checkToken(fact.Token())
-	if err != nil {
-		return nil, err
-	}
-
-	items := make([]document.CreateDocumentsItem, len(fact.Items()))
-	for i := range fact.Items() {
-		item := fact.Items()[i]
-		items[i] = document.NewCreateDocumentsItemImpl(
-			item.Doc(),
-			item.Currency(),
-		)
-	}
-
-	nfact := document.NewCreateDocumentsFact(token, fact.Sender(), items)
-	nfact = nfact.Rebuild()
-	if err = bl.isValidFactCreateDocuments(nfact); err != nil {
-		return nil, err
-	}
+func (Builder) templateAmendSignersFact() Hal {
 
-	var hal Hal
-	hal = NewBaseHal(nil, HalLink{})
-	op, err := document.NewCreateDocuments(
-		nfact,
-		[]base.FactSign{
-			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
-		},
-		"",
+	fact := document.NewAmendSignersFact(
+		templateToken,
+		templateSender,
+		[]document.AmendSignersItem{document.NewAmendSignersItemImpl(
+			templateId,
+			[]document.DocSign{document.NewDocSign(templateSigner, templateSignerSigncode, false)},
+			nil,
+			templateCurrencyID,
+		)},
 	)
-	if err != nil {
-		return nil, err
-	}
-	hal = hal.SetInterface(op)
 
-	return hal.
-		AddExtras("default", map[string]interface{}{
-			"fact_signs.signer":    templatePublickey,
-			"fact_signs.signature": templateSignature,
-		}).
-		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":              templateToken,
+		"sender":             templateSender,
+		"items.documentid":   templateId,
+		"items.add.address":  templateSigner,
+		"items.add.signcode": templateSignerSigncode,
+		"currency":           templateCurrencyID,
+	})
 }
 
-func (bl Builder) buildFactSignDocuments(fact document.SignDocumentsFact) (Hal, error) {
-	token, err := bl.checkToken(fact.Token())
-	if err != nil {
-		return nil, err
-	}
+func (Builder) templateRegisterDocumentTypeFact() Hal {
+	de := document.NewDocTypeDesign(
+		templateShortType,
+		templateDocType,
+		[]document.DocField{document.NewDocField(templateTitle, document.DocFieldKindString, true, 0)},
+	)
+	fact := document.NewRegisterDocumentTypeFact(templateToken, de)
 
-	items := make([]document.SignDocumentItem, len(fact.Items()))
-	for i := range fact.Items() {
-		item := fact.Items()[i]
-		if item.DocumentId() == "" {
-			return nil, errors.Errorf("empty documentid")
-		}
+	hal := NewBaseHal(fact, HalLink{})
 
-		items[i] = document.NewSignDocumentsItemSingleFile(
-			item.DocumentId(),
-			item.Owner(),
-			item.Currency(),
-		)
-	}
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":                  templateToken,
+		"design.short_type":      templateShortType,
+		"design.doctype":         templateDocType,
+		"design.fields.name":     templateTitle,
+		"design.fields.kind":     document.DocFieldKindString,
+		"design.fields.required": true,
+	})
+}
 
-	nfact := document.NewSignDocumentsFact(token, fact.Sender(), items)
-	nfact = nfact.Rebuild()
-	if err = bl.isValidFactSignDocuments(nfact); err != nil {
-		return nil, err
-	}
+func (Builder) templateGrantPermissionFact() Hal {
+	fact := document.NewGrantPermissionFact(templateToken, templateReceiver, extension.BlockcityAdmin)
 
-	var hal Hal
-	hal = NewBaseHal(nil, HalLink{})
-	op, err := document.NewSignDocuments(
-		nfact,
-		[]base.FactSign{
-			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
-		},
-		"",
-	)
-	if err != nil {
-		return nil, err
-	}
-	hal = hal.SetInterface(op)
+	hal := NewBaseHal(fact, HalLink{})
 
-	return hal.
-		AddExtras("default", map[string]interface{}{
-			"fact_signs.signer":    templatePublickey,
-			"fact_signs.signature": templateSignature,
-		}).
-		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":      templateToken,
+		"target":     templateReceiver,
+		"permission": extension.BlockcityAdmin,
+	})
 }
 
-func (bl Builder) buildFactKeyUpdater(fact currency.KeyUpdaterFact) (Hal, error) {
-	token, err := bl.checkToken(fact.Token())
-	if err != nil {
-		return nil, err
-	}
+func (Builder) templateRevokePermissionFact() Hal {
+	fact := document.NewRevokePermissionFact(templateToken, templateReceiver, extension.BlockcityAdmin)
 
-	ks, err := currency.NewBaseAccountKeys(fact.Keys().Keys(), fact.Keys().Threshold())
-	if err != nil {
-		return nil, err
-	}
+	hal := NewBaseHal(fact, HalLink{})
 
-	nfact := currency.NewKeyUpdaterFact(token, fact.Target(), ks, fact.Currency())
-	if err = bl.isValidFactKeyUpdater(nfact); err != nil {
-		return nil, err
-	}
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":      templateToken,
+		"target":     templateReceiver,
+		"permission": extension.BlockcityAdmin,
+	})
+}
 
-	var hal Hal
-	hal = NewBaseHal(nil, HalLink{})
-	op, err := currency.NewKeyUpdater(
-		nfact,
-		[]base.FactSign{
-			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
-		},
-		"",
+func (Builder) templateDelegateEditorsFact() Hal {
+	fact := document.NewDelegateEditorsFact(
+		templateToken,
+		templateSender,
+		[]document.DelegateEditorsItem{document.NewDelegateEditorsItemImpl(
+			templateReceiver,
+			templateId,
+			"",
+			base.NilHeight,
+			document.DelegateAllow,
+			templateCurrencyID,
+		)},
 	)
-	if err != nil {
-		return nil, err
-	}
-	hal = hal.SetInterface(op)
 
-	return hal.
-		AddExtras("default", map[string]interface{}{
-			"fact_signs.signer":    templatePublickey,
-			"fact_signs.signature": templateSignature,
-		}).
-		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.delegate":   templateReceiver,
+		"items.documentid": templateId,
+		"items.mode":       document.DelegateAllow,
+		"currency":         templateCurrencyID,
+	})
 }
 
-func (bl Builder) buildFactTransfers(fact currency.TransfersFact) (Hal, error) {
+func (Builder) templateCastVoteFact() Hal {
+	fact := document.NewCastVoteFact(
+		templateToken,
+		templateSender,
+		[]document.CastVoteItem{document.NewCastVoteItemImpl(
+			templateId,
+			templateReceiver,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.candidate":  templateReceiver,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateCommitVoteFact() Hal {
+	fact := document.NewCommitVoteFact(
+		templateToken,
+		templateSender,
+		[]document.CommitVoteItem{document.NewCommitVoteItemImpl(
+			templateId,
+			templateCommitment,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.commitment": templateCommitment,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateRevealVoteFact() Hal {
+	fact := document.NewRevealVoteFact(
+		templateToken,
+		templateSender,
+		[]document.RevealVoteItem{document.NewRevealVoteItemImpl(
+			templateId,
+			templateReceiver,
+			templateSalt,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.candidate":  templateReceiver,
+		"items.salt":       templateSalt,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateCloseVotingFact() Hal {
+	fact := document.NewCloseVotingFact(
+		templateToken,
+		templateSender,
+		[]document.CloseVotingItem{document.NewCloseVotingItemImpl(
+			templateId,
+			base.NilHeight,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateRentLandFact() Hal {
+	fact := document.NewRentLandFact(
+		templateToken,
+		templateSender,
+		[]document.RentLandItem{document.NewRentLandItemImpl(
+			templateId,
+			templateSender,
+			"",
+			base.Height(1),
+			templateBig,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.renter":     templateSender,
+		"items.rent":       templateBig,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateRenewLeaseFact() Hal {
+	fact := document.NewRenewLeaseFact(
+		templateToken,
+		templateSender,
+		[]document.RenewLeaseItem{document.NewRenewLeaseItemImpl(
+			templateId,
+			base.Height(1),
+			templateBig,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.rent":       templateBig,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateEndLeaseFact() Hal {
+	fact := document.NewEndLeaseFact(
+		templateToken,
+		templateSender,
+		[]document.EndLeaseItem{document.NewEndLeaseItemImpl(
+			templateId,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateDepositGoldFact() Hal {
+	fact := document.NewDepositGoldFact(
+		templateToken,
+		templateSender,
+		[]document.DepositGoldItem{document.NewDepositGoldItemImpl(
+			templateId,
+			templateGold,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.amount":     templateGold,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateWithdrawGoldFact() Hal {
+	fact := document.NewWithdrawGoldFact(
+		templateToken,
+		templateSender,
+		[]document.WithdrawGoldItem{document.NewWithdrawGoldItemImpl(
+			templateId,
+			templateGold,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.amount":     templateGold,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateTransferGoldFact() Hal {
+	fact := document.NewTransferGoldFact(
+		templateToken,
+		templateSender,
+		[]document.TransferGoldItem{document.NewTransferGoldItemImpl(
+			templateId,
+			templateReceiverId,
+			templateGold,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.receiver":   templateReceiverId,
+		"items.amount":     templateGold,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (Builder) templateUpdateGoldRateFact() Hal {
+	fact := document.NewUpdateGoldRateFact(
+		templateToken,
+		templateSender,
+		document.NewGoldRate(templateCurrencyID, templateBig, templateReceiver),
+		templateCurrencyID,
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":         templateToken,
+		"sender":        templateSender,
+		"rate.currency": templateCurrencyID,
+		"rate.price":    templateBig,
+		"rate.treasury": templateReceiver,
+		"currency":      templateCurrencyID,
+	})
+}
+
+func (Builder) templateSwapGoldFact() Hal {
+	fact := document.NewSwapGoldFact(
+		templateToken,
+		templateSender,
+		templateCurrencyID,
+		templateBig,
+		[]document.SwapGoldItem{document.NewSwapGoldItemImpl(
+			templateId,
+			document.SwapBuy,
+			templateGold,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"currency":         templateCurrencyID,
+		"price":            templateBig,
+		"items.documentid": templateId,
+		"items.mode":       document.SwapBuy,
+		"items.amount":     templateGold,
+		"items.currency":   templateCurrencyID,
+	})
+}
+
+func (Builder) templateUpdateStatPolicyFact() Hal {
+	fact := document.NewUpdateStatPolicyFact(
+		templateToken,
+		templateSender,
+		document.NewStatPolicy(templateStatistics, templateStatistics, templateGold),
+		templateCurrencyID,
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":         templateToken,
+		"sender":        templateSender,
+		"policy.min":    templateStatistics,
+		"policy.max":    templateStatistics,
+		"policy.budget": templateGold,
+		"currency":      templateCurrencyID,
+	})
+}
+
+func (Builder) templateChangeStatsFact() Hal {
+	fact := document.NewChangeStatsFact(
+		templateToken,
+		templateSender,
+		[]document.ChangeStatsItem{document.NewChangeStatsItemImpl(
+			templateId,
+			templateStatDelta,
+			templateCurrencyID,
+		)},
+	)
+
+	hal := NewBaseHal(fact, HalLink{})
+	return hal.AddExtras("default", map[string]interface{}{
+		"token":            templateToken,
+		"sender":           templateSender,
+		"items.documentid": templateId,
+		"items.delta":      templateStatDelta,
+		"currency":         templateCurrencyID,
+	})
+}
+
+func (bl Builder) BuildFact(b []byte) (Hal, error) {
+	var fact base.Fact
+	if hinter, err := bl.enc.Decode(b); err != nil {
+		return nil, err
+	} else if f, ok := hinter.(base.Fact); !ok {
+		return nil, errors.Errorf("not base.Fact, %T", hinter)
+	} else {
+		fact = f
+	}
+
+	switch t := fact.(type) {
+	case currency.CreateAccountsFact:
+		return bl.buildFactCreateAccounts(t)
+	case currency.KeyUpdaterFact:
+		return bl.buildFactKeyUpdater(t)
+	case currency.TransfersFact:
+		return bl.buildFactTransfers(t)
+	case currency.CurrencyRegisterFact:
+		return bl.buildFactCurrencyRegister(t)
+	case currency.CurrencyPolicyUpdaterFact:
+		return bl.buildFactCurrencyPolicyUpdater(t)
+	case document.CreateDocumentsFact:
+		return bl.buildFactCreateDocuments(t)
+	case document.UpdateDocumentsFact:
+		return bl.buildFactUpdateDocuments(t)
+	case document.SignDocumentsFact:
+		return bl.buildFactSignDocuments(t)
+	case document.RemoveDocumentsFact:
+		return bl.buildFactRemoveDocuments(t)
+	case document.TransferDocumentsFact:
+		return bl.buildFactTransferDocuments(t)
+	case document.AmendSignersFact:
+		return bl.buildFactAmendSigners(t)
+	case document.RegisterDocumentTypeFact:
+		return bl.buildFactRegisterDocumentType(t)
+	case document.GrantPermissionFact:
+		return bl.buildFactGrantPermission(t)
+	case document.RevokePermissionFact:
+		return bl.buildFactRevokePermission(t)
+	case document.DelegateEditorsFact:
+		return bl.buildFactDelegateEditors(t)
+	case document.CastVoteFact:
+		return bl.buildFactCastVote(t)
+	case document.CommitVoteFact:
+		return bl.buildFactCommitVote(t)
+	case document.RevealVoteFact:
+		return bl.buildFactRevealVote(t)
+	case document.CloseVotingFact:
+		return bl.buildFactCloseVoting(t)
+	case document.RentLandFact:
+		return bl.buildFactRentLand(t)
+	case document.RenewLeaseFact:
+		return bl.buildFactRenewLease(t)
+	case document.EndLeaseFact:
+		return bl.buildFactEndLease(t)
+	case document.DepositGoldFact:
+		return bl.buildFactDepositGold(t)
+	case document.WithdrawGoldFact:
+		return bl.buildFactWithdrawGold(t)
+	case document.TransferGoldFact:
+		return bl.buildFactTransferGold(t)
+	case document.UpdateGoldRateFact:
+		return bl.buildFactUpdateGoldRate(t)
+	case document.SwapGoldFact:
+		return bl.buildFactSwapGold(t)
+	case document.UpdateStatPolicyFact:
+		return bl.buildFactUpdateStatPolicy(t)
+	case document.ChangeStatsFact:
+		return bl.buildFactChangeStats(t)
+	default:
+		return nil, errors.Errorf("unknown fact, %T", fact)
+	}
+}
+
+func (bl Builder) buildFactCreateAccounts(fact currency.CreateAccountsFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]currency.CreateAccountsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if len(item.Amounts()) < 1 {
+			return nil, errors.Errorf("empty Amounts")
+		}
+
+		ks, e := currency.NewBaseAccountKeys(item.Keys().Keys(), item.Keys().Threshold())
+		if e != nil {
+			return nil, e
+		}
+		items[i] = currency.NewCreateAccountsItemSingleAmount(ks, item.Amounts()[0])
+	}
+
+	nfact := currency.NewCreateAccountsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactCreateAccounts(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := currency.NewCreateAccounts(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactCreateDocuments(fact document.CreateDocumentsFact) (Hal, error) {
 	token, err := bl.checkToken(fact.Token())
 	if err != nil {
 		return nil, err
 	}
 
-	nfact := currency.NewTransfersFact(token, fact.Sender(), fact.Items())
-	nfact = nfact.Rebuild()
-	if err = bl.isValidFactTransfers(nfact); err != nil {
-		return nil, err
+	items := make([]document.CreateDocumentsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		items[i] = document.NewCreateDocumentsItemImpl(
+			item.Doc(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewCreateDocumentsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactCreateDocuments(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewCreateDocuments(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactUpdateDocuments(fact document.UpdateDocumentsFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.UpdateDocumentsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		eh := item.ExpectedHash()
+		if eh == nil && bl.database != nil {
+			if eh, err = bl.currentDocumentHash(item.DocumentId()); err != nil {
+				return nil, err
+			}
+		}
+
+		switch t := item.(type) {
+		case document.UpdateDocumentsItemImpl:
+			items[i] = document.NewUpdateDocumentsItemImpl(
+				t.Doc(),
+				t.Currency(),
+			).WithExpectedHash(eh).WithExpectedHeight(t.ExpectedHeight())
+		case document.PatchDocumentsItemImpl:
+			items[i] = document.NewPatchDocumentsItemImpl(
+				t.DocumentId(),
+				t.Patches(),
+				t.Currency(),
+			).WithExpectedHash(eh).WithExpectedHeight(t.ExpectedHeight())
+		default:
+			return nil, errors.Errorf("unknown UpdateDocumentsItem, %T", item)
+		}
+	}
+
+	nfact := document.NewUpdateDocumentsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactUpdateDocuments(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewUpdateDocuments(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+// currentDocumentHash returns the hash of the last document data in database.
+func (bl Builder) currentDocumentHash(documentid string) (valuehash.Hash, error) {
+	switch va, found, err := bl.database.Document(documentid); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, errors.Errorf("document not found, %q", documentid)
+	default:
+		return va.Document().Hash(), nil
+	}
+}
+
+func (bl Builder) buildFactSignDocuments(fact document.SignDocumentsFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.SignDocumentItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if item.DocumentId() == "" {
+			return nil, errors.Errorf("empty documentid")
+		}
+
+		if item.Status() == document.DocSignStatusSigned {
+			items[i] = document.NewSignDocumentsItemSingleFile(
+				item.DocumentId(),
+				item.Owner(),
+				item.Currency(),
+			)
+		} else {
+			items[i] = document.NewSignDocumentsItemSingleFileStatus(
+				item.DocumentId(),
+				item.Owner(),
+				item.Status(),
+				item.Currency(),
+			)
+		}
+	}
+
+	nfact := document.NewSignDocumentsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactSignDocuments(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewSignDocuments(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactRemoveDocuments(fact document.RemoveDocumentsFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.RemoveDocumentsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if item.DocumentId() == "" {
+			return nil, errors.Errorf("empty documentid")
+		}
+
+		items[i] = document.NewRemoveDocumentsItemImpl(
+			item.DocumentId(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewRemoveDocumentsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactRemoveDocuments(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewRemoveDocuments(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactTransferDocuments(fact document.TransferDocumentsFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.TransferDocumentsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if item.DocumentId() == "" {
+			return nil, errors.Errorf("empty documentid")
+		}
+
+		items[i] = document.NewTransferDocumentsItemImpl(
+			item.DocumentId(),
+			item.Receiver(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewTransferDocumentsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactTransferDocuments(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewTransferDocuments(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactAmendSigners(fact document.AmendSignersFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.AmendSignersItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+		if item.DocumentId() == "" {
+			return nil, errors.Errorf("empty documentid")
+		}
+
+		items[i] = document.NewAmendSignersItemImpl(
+			item.DocumentId(),
+			item.Add(),
+			item.Remove(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewAmendSignersFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactAmendSigners(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewAmendSigners(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactKeyUpdater(fact currency.KeyUpdaterFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	ks, err := currency.NewBaseAccountKeys(fact.Keys().Keys(), fact.Keys().Threshold())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := currency.NewKeyUpdaterFact(token, fact.Target(), ks, fact.Currency())
+	if err = bl.isValidFactKeyUpdater(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := currency.NewKeyUpdater(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactTransfers(fact currency.TransfersFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := currency.NewTransfersFact(token, fact.Sender(), fact.Items())
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactTransfers(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := currency.NewTransfers(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactCurrencyRegister(fact currency.CurrencyRegisterFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := currency.NewCurrencyRegisterFact(token, fact.Currency())
+	if err = bl.isValidFactCurrencyRegister(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := currency.NewCurrencyRegister(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactRegisterDocumentType(fact document.RegisterDocumentTypeFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := document.NewRegisterDocumentTypeFact(token, fact.Design())
+	if err = bl.isValidFactRegisterDocumentType(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewRegisterDocumentType(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactGrantPermission(fact document.GrantPermissionFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := document.NewGrantPermissionFact(token, fact.Target(), fact.Permission())
+	if err = bl.isValidFactGrantPermission(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewGrantPermission(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactRevokePermission(fact document.RevokePermissionFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := document.NewRevokePermissionFact(token, fact.Target(), fact.Permission())
+	if err = bl.isValidFactRevokePermission(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewRevokePermission(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactDelegateEditors(fact document.DelegateEditorsFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.DelegateEditorsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewDelegateEditorsItemImpl(
+			item.Delegate(),
+			item.DocumentId(),
+			item.DocumentType(),
+			item.Expiry(),
+			item.Mode(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewDelegateEditorsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactDelegateEditors(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewDelegateEditors(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactCastVote(fact document.CastVoteFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.CastVoteItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewCastVoteItemImpl(
+			item.DocumentId(),
+			item.Candidate(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewCastVoteFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactCastVote(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewCastVote(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactCommitVote(fact document.CommitVoteFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.CommitVoteItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewCommitVoteItemImpl(
+			item.DocumentId(),
+			item.Commitment(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewCommitVoteFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactCommitVote(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewCommitVote(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactRevealVote(fact document.RevealVoteFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.RevealVoteItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewRevealVoteItemImpl(
+			item.DocumentId(),
+			item.Candidate(),
+			item.Salt(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewRevealVoteFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactRevealVote(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewRevealVote(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactCloseVoting(fact document.CloseVotingFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.CloseVotingItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewCloseVotingItemImpl(
+			item.DocumentId(),
+			item.NextDeadline(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewCloseVotingFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactCloseVoting(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewCloseVoting(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactRentLand(fact document.RentLandFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.RentLandItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewRentLandItemImpl(
+			item.DocumentId(),
+			item.Renter(),
+			item.Name(),
+			item.Period(),
+			item.Rent(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewRentLandFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactRentLand(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewRentLand(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactRenewLease(fact document.RenewLeaseFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.RenewLeaseItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewRenewLeaseItemImpl(
+			item.DocumentId(),
+			item.Period(),
+			item.Rent(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewRenewLeaseFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactRenewLease(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewRenewLease(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactEndLease(fact document.EndLeaseFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.EndLeaseItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewEndLeaseItemImpl(
+			item.DocumentId(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewEndLeaseFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactEndLease(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewEndLease(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactDepositGold(fact document.DepositGoldFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.DepositGoldItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewDepositGoldItemImpl(
+			item.DocumentId(),
+			item.Amount(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewDepositGoldFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactDepositGold(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewDepositGold(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactWithdrawGold(fact document.WithdrawGoldFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.WithdrawGoldItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewWithdrawGoldItemImpl(
+			item.DocumentId(),
+			item.Amount(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewWithdrawGoldFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactWithdrawGold(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewWithdrawGold(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactTransferGold(fact document.TransferGoldFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.TransferGoldItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewTransferGoldItemImpl(
+			item.DocumentId(),
+			item.Receiver(),
+			item.Amount(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewTransferGoldFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactTransferGold(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewTransferGold(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactUpdateGoldRate(fact document.UpdateGoldRateFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := document.NewUpdateGoldRateFact(token, fact.Sender(), fact.Rate(), fact.Currency())
+	if err = bl.isValidFactUpdateGoldRate(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewUpdateGoldRate(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactSwapGold(fact document.SwapGoldFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.SwapGoldItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewSwapGoldItemImpl(
+			item.DocumentId(),
+			item.Mode(),
+			item.Amount(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewSwapGoldFact(token, fact.Sender(), fact.Currency(), fact.Price(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactSwapGold(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewSwapGold(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactUpdateStatPolicy(fact document.UpdateStatPolicyFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := document.NewUpdateStatPolicyFact(token, fact.Sender(), fact.Policy(), fact.Currency())
+	if err = bl.isValidFactUpdateStatPolicy(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewUpdateStatPolicy(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactChangeStats(fact document.ChangeStatsFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]document.ChangeStatsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		item := fact.Items()[i]
+
+		items[i] = document.NewChangeStatsItemImpl(
+			item.DocumentId(),
+			item.Delta(),
+			item.Currency(),
+		)
+	}
+
+	nfact := document.NewChangeStatsFact(token, fact.Sender(), items)
+	nfact = nfact.Rebuild()
+	if err = bl.isValidFactChangeStats(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := document.NewChangeStats(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (bl Builder) buildFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyUpdaterFact) (Hal, error) {
+	token, err := bl.checkToken(fact.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	nfact := currency.NewCurrencyPolicyUpdaterFact(token, fact.Currency(), fact.Policy())
+	if err = bl.isValidFactCurrencyPolicyUpdater(nfact); err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(nil, HalLink{})
+	op, err := currency.NewCurrencyPolicyUpdater(
+		nfact,
+		[]base.FactSign{
+			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
+		},
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.SetInterface(op)
+
+	return hal.
+		AddExtras("default", map[string]interface{}{
+			"fact_signs.signer":    templatePublickey,
+			"fact_signs.signature": templateSignature,
+		}).
+		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+}
+
+func (Builder) isValidFactCreateAccounts(fact currency.CreateAccountsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if _, same := fact.Items()[i].Keys().Key(templatePublickey); same {
+			return errors.Errorf("Please set key; key is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactKeyUpdater(fact currency.KeyUpdaterFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Target().Equal(templateSender) {
+		return errors.Errorf("Please set target; target is same with template default")
+	}
+
+	if _, same := fact.Keys().Key(templatePublickey); same {
+		return errors.Errorf("Please set key; key is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactTransfers(fact currency.TransfersFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].Receiver().Equal(templateReceiver) {
+			return errors.Errorf("Please set receiver; receiver is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactCurrencyRegister(fact currency.CurrencyRegisterFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Currency().GenesisAccount().Equal(templateReceiver) {
+		return errors.Errorf("Please set genesis_account; genesis_account is same with template default")
+	}
+
+	if fact.Currency().Policy().NewAccountMinBalance().Equal(templateBig) {
+		return errors.Errorf("Please set new_account_min_balance; new_account_min_balance is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactRegisterDocumentType(fact document.RegisterDocumentTypeFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Design().ShortType() == templateShortType {
+		return errors.Errorf("Please set short_type; short_type is same with template default")
+	}
+
+	if fact.Design().DocType() == templateDocType {
+		return errors.Errorf("Please set doctype; doctype is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactGrantPermission(fact document.GrantPermissionFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Target().Equal(templateReceiver) {
+		return errors.Errorf("Please set target; target is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactRevokePermission(fact document.RevokePermissionFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Target().Equal(templateReceiver) {
+		return errors.Errorf("Please set target; target is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactDelegateEditors(fact document.DelegateEditorsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].Delegate().Equal(templateReceiver) {
+			return errors.Errorf("Please set delegate; delegate is same with template default")
+		}
+
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactCastVote(fact document.CastVoteFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+
+		if fact.Items()[i].Candidate().Equal(templateReceiver) {
+			return errors.Errorf("Please set candidate; candidate is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactCommitVote(fact document.CommitVoteFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+
+		if fact.Items()[i].Commitment().Equal(templateCommitment) {
+			return errors.Errorf("Please set commitment; commitment is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactRevealVote(fact document.RevealVoteFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+
+		if fact.Items()[i].Candidate().Equal(templateReceiver) {
+			return errors.Errorf("Please set candidate; candidate is same with template default")
+		}
+
+		if fact.Items()[i].Salt() == templateSalt {
+			return errors.Errorf("Please set salt; salt is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactCloseVoting(fact document.CloseVotingFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactRentLand(fact document.RentLandFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
 	}
 
-	var hal Hal
-	hal = NewBaseHal(nil, HalLink{})
-	op, err := currency.NewTransfers(
-		nfact,
-		[]base.FactSign{
-			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
-		},
-		"",
-	)
-	if err != nil {
-		return nil, err
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
 	}
-	hal = hal.SetInterface(op)
 
-	return hal.
-		AddExtras("default", map[string]interface{}{
-			"fact_signs.signer":    templatePublickey,
-			"fact_signs.signature": templateSignature,
-		}).
-		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+
+		if fact.Items()[i].Renter().Equal(templateReceiver) {
+			return errors.Errorf("Please set renter; renter is same with template default")
+		}
+	}
+
+	return nil
 }
 
-func (bl Builder) buildFactCurrencyRegister(fact currency.CurrencyRegisterFact) (Hal, error) {
-	token, err := bl.checkToken(fact.Token())
-	if err != nil {
-		return nil, err
+func (Builder) isValidFactRenewLease(fact document.RenewLeaseFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
 	}
 
-	nfact := currency.NewCurrencyRegisterFact(token, fact.Currency())
-	if err = bl.isValidFactCurrencyRegister(nfact); err != nil {
-		return nil, err
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
 	}
 
-	var hal Hal
-	hal = NewBaseHal(nil, HalLink{})
-	op, err := currency.NewCurrencyRegister(
-		nfact,
-		[]base.FactSign{
-			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
-		},
-		"",
-	)
-	if err != nil {
-		return nil, err
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
 	}
-	hal = hal.SetInterface(op)
 
-	return hal.
-		AddExtras("default", map[string]interface{}{
-			"fact_signs.signer":    templatePublickey,
-			"fact_signs.signature": templateSignature,
-		}).
-		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+	}
+
+	return nil
 }
 
-func (bl Builder) buildFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyUpdaterFact) (Hal, error) {
-	token, err := bl.checkToken(fact.Token())
-	if err != nil {
-		return nil, err
+func (Builder) isValidFactEndLease(fact document.EndLeaseFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
 	}
 
-	nfact := currency.NewCurrencyPolicyUpdaterFact(token, fact.Currency(), fact.Policy())
-	if err = bl.isValidFactCurrencyPolicyUpdater(nfact); err != nil {
-		return nil, err
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
 	}
 
-	var hal Hal
-	hal = NewBaseHal(nil, HalLink{})
-	op, err := currency.NewCurrencyPolicyUpdater(
-		nfact,
-		[]base.FactSign{
-			base.RawBaseFactSign(templatePublickey, templateSignature, templateSignedAt),
-		},
-		"",
-	)
-	if err != nil {
-		return nil, err
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
 	}
-	hal = hal.SetInterface(op)
 
-	return hal.
-		AddExtras("default", map[string]interface{}{
-			"fact_signs.signer":    templatePublickey,
-			"fact_signs.signature": templateSignature,
-		}).
-		AddExtras("signature_base", base.NewBytesForFactSignature(nfact, bl.networkID)), nil
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+	}
+
+	return nil
 }
 
-func (Builder) isValidFactCreateAccounts(fact currency.CreateAccountsFact) error {
+func (Builder) isValidFactDepositGold(fact document.DepositGoldFact) error {
 	if err := fact.IsValid(nil); err != nil {
 		return err
 	}
@@ -537,15 +2459,15 @@ func (Builder) isValidFactCreateAccounts(fact currency.CreateAccountsFact) error
 	}
 
 	for i := range fact.Items() {
-		if _, same := fact.Items()[i].Keys().Key(templatePublickey); same {
-			return errors.Errorf("Please set key; key is same with template default")
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
 		}
 	}
 
 	return nil
 }
 
-func (Builder) isValidFactKeyUpdater(fact currency.KeyUpdaterFact) error {
+func (Builder) isValidFactWithdrawGold(fact document.WithdrawGoldFact) error {
 	if err := fact.IsValid(nil); err != nil {
 		return err
 	}
@@ -554,18 +2476,20 @@ func (Builder) isValidFactKeyUpdater(fact currency.KeyUpdaterFact) error {
 		return errors.Errorf("Please set token; token same with template default")
 	}
 
-	if fact.Target().Equal(templateSender) {
-		return errors.Errorf("Please set target; target is same with template default")
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
 	}
 
-	if _, same := fact.Keys().Key(templatePublickey); same {
-		return errors.Errorf("Please set key; key is same with template default")
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
 	}
 
 	return nil
 }
 
-func (Builder) isValidFactTransfers(fact currency.TransfersFact) error {
+func (Builder) isValidFactTransferGold(fact document.TransferGoldFact) error {
 	if err := fact.IsValid(nil); err != nil {
 		return err
 	}
@@ -579,7 +2503,11 @@ func (Builder) isValidFactTransfers(fact currency.TransfersFact) error {
 	}
 
 	for i := range fact.Items() {
-		if fact.Items()[i].Receiver().Equal(templateReceiver) {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+
+		if fact.Items()[i].Receiver() == templateReceiverId {
 			return errors.Errorf("Please set receiver; receiver is same with template default")
 		}
 	}
@@ -587,7 +2515,7 @@ func (Builder) isValidFactTransfers(fact currency.TransfersFact) error {
 	return nil
 }
 
-func (Builder) isValidFactCurrencyRegister(fact currency.CurrencyRegisterFact) error {
+func (Builder) isValidFactUpdateGoldRate(fact document.UpdateGoldRateFact) error {
 	if err := fact.IsValid(nil); err != nil {
 		return err
 	}
@@ -596,12 +2524,72 @@ func (Builder) isValidFactCurrencyRegister(fact currency.CurrencyRegisterFact) e
 		return errors.Errorf("Please set token; token same with template default")
 	}
 
-	if fact.Currency().GenesisAccount().Equal(templateReceiver) {
-		return errors.Errorf("Please set genesis_account; genesis_account is same with template default")
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
 	}
 
-	if fact.Currency().Policy().NewAccountMinBalance().Equal(templateBig) {
-		return errors.Errorf("Please set new_account_min_balance; new_account_min_balance is same with template default")
+	if fact.Rate().Treasury().Equal(templateReceiver) {
+		return errors.Errorf("Please set treasury; treasury is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactSwapGold(fact document.SwapGoldFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactUpdateStatPolicy(fact document.UpdateStatPolicyFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactChangeStats(fact document.ChangeStatsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
 	}
 
 	return nil
@@ -619,7 +2607,83 @@ func (Builder) isValidFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyUpda
 	return nil
 }
 
-func (Builder) isValidFactCreateDocuments(fact document.CreateDocumentsFact) error {
+func (Builder) isValidFactCreateDocuments(fact document.CreateDocumentsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactUpdateDocuments(fact document.UpdateDocumentsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactSignDocuments(fact document.SignDocumentsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if same := fact.Items()[i].Owner().Equal(templateOwner); same {
+			return errors.Errorf("Please set owner; owner is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactRemoveDocuments(fact document.RemoveDocumentsFact) error {
+	if err := fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if bytes.Equal(fact.Token(), templateToken) {
+		return errors.Errorf("Please set token; token same with template default")
+	}
+
+	if fact.Sender().Equal(templateSender) {
+		return errors.Errorf("Please set sender; sender is same with template default")
+	}
+
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+	}
+
+	return nil
+}
+
+func (Builder) isValidFactTransferDocuments(fact document.TransferDocumentsFact) error {
 	if err := fact.IsValid(nil); err != nil {
 		return err
 	}
@@ -632,10 +2696,20 @@ func (Builder) isValidFactCreateDocuments(fact document.CreateDocumentsFact) err
 		return errors.Errorf("Please set sender; sender is same with template default")
 	}
 
+	for i := range fact.Items() {
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+
+		if fact.Items()[i].Receiver().Equal(templateReceiver) {
+			return errors.Errorf("Please set receiver; receiver is same with template default")
+		}
+	}
+
 	return nil
 }
 
-func (Builder) isValidFactSignDocuments(fact document.SignDocumentsFact) error {
+func (Builder) isValidFactAmendSigners(fact document.AmendSignersFact) error {
 	if err := fact.IsValid(nil); err != nil {
 		return err
 	}
@@ -649,8 +2723,14 @@ func (Builder) isValidFactSignDocuments(fact document.SignDocumentsFact) error {
 	}
 
 	for i := range fact.Items() {
-		if same := fact.Items()[i].Owner().Equal(templateOwner); same {
-			return errors.Errorf("Please set owner; owner is same with template default")
+		if fact.Items()[i].DocumentId() == templateId {
+			return errors.Errorf("Please set documentid; documentid is same with template default")
+		}
+
+		for j := range fact.Items()[i].Add() {
+			if fact.Items()[i].Add()[j].Address().Equal(templateSigner) {
+				return errors.Errorf("Please set signer; signer is same with template default")
+			}
 		}
 	}
 
@@ -683,8 +2763,52 @@ func (bl Builder) BuildOperation(b []byte) (Hal, error) {
 			hal, err = bl.buildCurrencyPolicyUpdater(t)
 		case document.CreateDocuments:
 			hal, err = bl.buildCreateDocumets(t)
+		case document.UpdateDocuments:
+			hal, err = bl.buildUpdateDocuments(t)
 		case document.SignDocuments:
 			hal, err = bl.buildSignDocumets(t)
+		case document.RemoveDocuments:
+			hal, err = bl.buildRemoveDocuments(t)
+		case document.TransferDocuments:
+			hal, err = bl.buildTransferDocuments(t)
+		case document.AmendSigners:
+			hal, err = bl.buildAmendSigners(t)
+		case document.RegisterDocumentType:
+			hal, err = bl.buildRegisterDocumentType(t)
+		case document.GrantPermission:
+			hal, err = bl.buildGrantPermission(t)
+		case document.RevokePermission:
+			hal, err = bl.buildRevokePermission(t)
+		case document.DelegateEditors:
+			hal, err = bl.buildDelegateEditors(t)
+		case document.CastVote:
+			hal, err = bl.buildCastVote(t)
+		case document.CommitVote:
+			hal, err = bl.buildCommitVote(t)
+		case document.RevealVote:
+			hal, err = bl.buildRevealVote(t)
+		case document.CloseVoting:
+			hal, err = bl.buildCloseVoting(t)
+		case document.RentLand:
+			hal, err = bl.buildRentLand(t)
+		case document.RenewLease:
+			hal, err = bl.buildRenewLease(t)
+		case document.EndLease:
+			hal, err = bl.buildEndLease(t)
+		case document.DepositGold:
+			hal, err = bl.buildDepositGold(t)
+		case document.WithdrawGold:
+			hal, err = bl.buildWithdrawGold(t)
+		case document.TransferGold:
+			hal, err = bl.buildTransferGold(t)
+		case document.UpdateGoldRate:
+			hal, err = bl.buildUpdateGoldRate(t)
+		case document.SwapGold:
+			hal, err = bl.buildSwapGold(t)
+		case document.UpdateStatPolicy:
+			hal, err = bl.buildUpdateStatPolicy(t)
+		case document.ChangeStats:
+			hal, err = bl.buildChangeStats(t)
 		default:
 			return errors.Errorf("unknown operation.Operation, %T", t)
 		}
@@ -765,6 +2889,258 @@ func (bl Builder) buildCurrencyRegister(op currency.CurrencyRegister) (Hal, erro
 	}
 }
 
+func (bl Builder) buildRegisterDocumentType(op document.RegisterDocumentType) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewRegisterDocumentType(op.Fact().(document.RegisterDocumentTypeFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactRegisterDocumentType(nop.Fact().(document.RegisterDocumentTypeFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildGrantPermission(op document.GrantPermission) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewGrantPermission(op.Fact().(document.GrantPermissionFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactGrantPermission(nop.Fact().(document.GrantPermissionFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildRevokePermission(op document.RevokePermission) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewRevokePermission(op.Fact().(document.RevokePermissionFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactRevokePermission(nop.Fact().(document.RevokePermissionFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildDelegateEditors(op document.DelegateEditors) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewDelegateEditors(op.Fact().(document.DelegateEditorsFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactDelegateEditors(nop.Fact().(document.DelegateEditorsFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildCastVote(op document.CastVote) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewCastVote(op.Fact().(document.CastVoteFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCastVote(nop.Fact().(document.CastVoteFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildCommitVote(op document.CommitVote) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewCommitVote(op.Fact().(document.CommitVoteFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCommitVote(nop.Fact().(document.CommitVoteFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildRevealVote(op document.RevealVote) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewRevealVote(op.Fact().(document.RevealVoteFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactRevealVote(nop.Fact().(document.RevealVoteFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildCloseVoting(op document.CloseVoting) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewCloseVoting(op.Fact().(document.CloseVotingFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactCloseVoting(nop.Fact().(document.CloseVotingFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildRentLand(op document.RentLand) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewRentLand(op.Fact().(document.RentLandFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactRentLand(nop.Fact().(document.RentLandFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildRenewLease(op document.RenewLease) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewRenewLease(op.Fact().(document.RenewLeaseFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactRenewLease(nop.Fact().(document.RenewLeaseFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildEndLease(op document.EndLease) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewEndLease(op.Fact().(document.EndLeaseFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactEndLease(nop.Fact().(document.EndLeaseFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildDepositGold(op document.DepositGold) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewDepositGold(op.Fact().(document.DepositGoldFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactDepositGold(nop.Fact().(document.DepositGoldFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildWithdrawGold(op document.WithdrawGold) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewWithdrawGold(op.Fact().(document.WithdrawGoldFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactWithdrawGold(nop.Fact().(document.WithdrawGoldFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildTransferGold(op document.TransferGold) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewTransferGold(op.Fact().(document.TransferGoldFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactTransferGold(nop.Fact().(document.TransferGoldFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildUpdateGoldRate(op document.UpdateGoldRate) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewUpdateGoldRate(op.Fact().(document.UpdateGoldRateFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactUpdateGoldRate(nop.Fact().(document.UpdateGoldRateFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildSwapGold(op document.SwapGold) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewSwapGold(op.Fact().(document.SwapGoldFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactSwapGold(nop.Fact().(document.SwapGoldFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildUpdateStatPolicy(op document.UpdateStatPolicy) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewUpdateStatPolicy(op.Fact().(document.UpdateStatPolicyFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactUpdateStatPolicy(nop.Fact().(document.UpdateStatPolicyFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildChangeStats(op document.ChangeStats) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewChangeStats(op.Fact().(document.ChangeStatsFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactChangeStats(nop.Fact().(document.ChangeStatsFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
 func (bl Builder) buildCurrencyPolicyUpdater(op currency.CurrencyPolicyUpdater) (Hal, error) {
 	fs := bl.updateFactSigns(op.Signs())
 
@@ -797,6 +3173,20 @@ func (bl Builder) buildCreateDocumets(op document.CreateDocuments) (Hal, error)
 	}
 }
 
+func (bl Builder) buildUpdateDocuments(op document.UpdateDocuments) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewUpdateDocuments(op.Fact().(document.UpdateDocumentsFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactUpdateDocuments(nop.Fact().(document.UpdateDocumentsFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
 func (bl Builder) buildSignDocumets(op document.SignDocuments) (Hal, error) {
 	fs := bl.updateFactSigns(op.Signs())
 
@@ -811,6 +3201,48 @@ func (bl Builder) buildSignDocumets(op document.SignDocuments) (Hal, error) {
 	}
 }
 
+func (bl Builder) buildRemoveDocuments(op document.RemoveDocuments) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewRemoveDocuments(op.Fact().(document.RemoveDocumentsFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactRemoveDocuments(nop.Fact().(document.RemoveDocumentsFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildTransferDocuments(op document.TransferDocuments) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewTransferDocuments(op.Fact().(document.TransferDocumentsFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactTransferDocuments(nop.Fact().(document.TransferDocumentsFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
+func (bl Builder) buildAmendSigners(op document.AmendSigners) (Hal, error) {
+	fs := bl.updateFactSigns(op.Signs())
+
+	if nop, err := document.NewAmendSigners(op.Fact().(document.AmendSignersFact), fs, op.Memo); err != nil {
+		return nil, err
+	} else if err := nop.IsValid(bl.networkID); err != nil {
+		return nil, err
+	} else if err := bl.isValidFactAmendSigners(nop.Fact().(document.AmendSignersFact)); err != nil {
+		return nil, err
+	} else {
+		return NewBaseHal(nop, HalLink{}), nil
+	}
+}
+
 // checkToken checks token is valid; empty token will be updated with current
 // time.
 func (Builder) checkToken(token []byte) ([]byte, error) {
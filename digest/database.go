@@ -11,6 +11,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/rs/zerolog"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
@@ -31,11 +32,16 @@ import (
 var maxLimit int64 = 50
 
 var (
-	defaultColNameAccount   = "digest_ac"
-	defaultColNameDocument  = "digest_dm"
-	defaultColNameDocuments = "digest_dv"
-	defaultColNameBalance   = "digest_bl"
-	defaultColNameOperation = "digest_op"
+	defaultColNameAccount      = "digest_ac"
+	defaultColNameDocument     = "digest_dm"
+	defaultColNameDocuments    = "digest_dv"
+	defaultColNameBalance      = "digest_bl"
+	defaultColNameOperation    = "digest_op"
+	defaultColNamePermission   = "digest_pm"
+	defaultColNameDelegates    = "digest_dg"
+	defaultColNameVotingBox    = "digest_vb"
+	defaultColNameVotingResult = "digest_vr"
+	defaultColNameStatChange   = "digest_sc"
 )
 
 var AllCollections = []string{
@@ -44,6 +50,11 @@ var AllCollections = []string{
 	defaultColNameOperation,
 	defaultColNameDocument,
 	defaultColNameDocuments,
+	defaultColNamePermission,
+	defaultColNameDelegates,
+	defaultColNameVotingBox,
+	defaultColNameVotingResult,
+	defaultColNameStatChange,
 }
 
 var DigestStorageLastBlockKey = "digest_last_block"
@@ -294,6 +305,34 @@ func (st *Database) OperationsByAddress(
 		return err
 	}
 
+	return st.operationsByFilter(filter, load, reverse, limit, callback)
+}
+
+// GoldSwapsByAddress returns the swap gold operations of address; the failed
+// swaps are also included.
+func (st *Database) GoldSwapsByAddress(
+	address base.Address,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(valuehash.Hash /* fact hash */, OperationValue) (bool, error),
+) error {
+	filter, err := buildOperationsFilterByAddress(address, offset, reverse)
+	if err != nil {
+		return err
+	}
+	filter["fact_type"] = document.SwapGoldFactType.String()
+
+	return st.operationsByFilter(filter, true, reverse, limit, callback)
+}
+
+func (st *Database) operationsByFilter(
+	filter bson.M,
+	load,
+	reverse bool,
+	limit int64,
+	callback func(valuehash.Hash /* fact hash */, OperationValue) (bool, error),
+) error {
 	sr := 1
 	if reverse {
 		sr = -1
@@ -472,6 +511,16 @@ func (st *Database) Account(a base.Address) (AccountValue, bool /* exists */, er
 			SetPreviousHeight(previousHeight)
 	}
 
+	// NOTE load permissions
+	switch aps, lastHeight, previousHeight, err := st.permissions(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetPermissions(aps).
+			SetHeight(lastHeight).
+			SetPreviousHeight(previousHeight)
+	}
+
 	return rs, true, nil
 }
 
@@ -909,9 +958,10 @@ func (st *Database) DocumentsByAddress(
 	offset string,
 	limit int64,
 	doctype string,
+	dates bson.D,
 	callback func(string /* document id */, DocumentValue) (bool, error),
 ) error {
-	filter, err := buildDocumentsFilterByAddress(address, offset, reverse, doctype)
+	filter, err := buildDocumentsFilterByAddress(address, offset, reverse, doctype, dates)
 	if err != nil {
 		return err
 	}
@@ -1059,6 +1109,181 @@ func (st *Database) DocumentList(a base.Address) (document.DocumentInventory, ba
 	return doc, lastHeight, previousHeight, nil
 }
 
+// permissions returns the permissions of extended account.
+func (st *Database) permissions(a base.Address) ([]extension.AccountPermission, base.Height, base.Height, error) {
+	var sta state.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNamePermission,
+		util.NewBSONFilter("address", a.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadPermission(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			sta = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return nil, base.NilHeight, base.NilHeight, nil
+		}
+
+		return nil, base.NilHeight, base.NilHeight, err
+	}
+
+	ac, err := extension.StateAccountValue(sta)
+	if err != nil {
+		return nil, base.NilHeight, base.NilHeight, err
+	}
+
+	return ac.Permissions(), sta.Height(), sta.PreviousHeight(), nil
+}
+
+// Delegates returns the document delegates of owner.
+func (st *Database) Delegates(a base.Address) (document.DocumentDelegates, base.Height, base.Height, error) {
+	var sta state.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNameDelegates,
+		util.NewBSONFilter("address", a.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadDelegates(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			sta = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return document.DocumentDelegates{}, base.NilHeight, base.NilHeight, err
+	}
+
+	dgs, err := document.StateDelegatesValue(sta)
+	if err != nil {
+		return document.DocumentDelegates{}, base.NilHeight, base.NilHeight, err
+	}
+
+	return dgs, sta.Height(), sta.PreviousHeight(), nil
+}
+
+// DocTypeRegistry returns the document types registered in the last stored
+// block of mitum database.
+func (st *Database) DocTypeRegistry() (document.DocTypeRegistry, error) {
+	switch sta, found, err := st.mitum.State(document.StateKeyDocTypeRegistry); {
+	case err != nil:
+		return document.DocTypeRegistry{}, err
+	case !found:
+		return document.NewDocTypeRegistry(nil), nil
+	default:
+		return document.StateDocTypeRegistryValue(sta)
+	}
+}
+
+// VotingBox returns the latest voting box of voting document.
+func (st *Database) VotingBox(documentid string) (document.VotingBox, base.Height, error) {
+	var sta state.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNameVotingBox,
+		util.NewBSONFilter("documentid", documentid).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadVotingBox(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			sta = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return document.VotingBox{}, base.NilHeight, err
+	}
+
+	box, err := document.StateVotingBoxValue(sta)
+	if err != nil {
+		return document.VotingBox{}, base.NilHeight, err
+	}
+
+	return box, sta.Height(), nil
+}
+
+// VotingResults returns the results of the closed rounds of voting document
+// in the order of round.
+func (st *Database) VotingResults(
+	documentid string,
+	callback func(document.VotingResult, base.Height) (bool, error),
+) error {
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameVotingResult,
+		util.NewBSONFilter("documentid", documentid).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := LoadVotingResult(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			vr, err := document.StateVotingResultValue(sta)
+			if err != nil {
+				return false, err
+			}
+
+			return callback(vr, sta.Height())
+		},
+		options.Find().SetSort(util.NewBSONFilter("round", 1).D()),
+	)
+}
+
+// StatChanges returns the stat change log of blockcity user document in the
+// order of height.
+func (st *Database) StatChanges(
+	documentid string,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(StatChangeValue) (bool, error),
+) error {
+	filter, err := buildStatChangesFilter(documentid, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("height", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameStatChange,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadStatChange(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			return callback(va)
+		},
+		opt,
+	)
+}
+
 func (st *Database) cleanByHeightColNameDocumentId(ctx context.Context, height base.Height, colName string, documentid string) error {
 
 	if height <= base.PreGenesisHeight+1 {
@@ -1082,13 +1307,27 @@ func (st *Database) cleanByHeightColNameDocumentId(ctx context.Context, height b
 	return st.setLastBlock(height - 1)
 }
 
-func buildDocumentsFilterByAddress(address base.Address, offset string, reverse bool, doctype string) (bson.D, error) {
+func buildDocumentsFilterByAddress(
+	address base.Address,
+	offset string,
+	reverse bool,
+	doctype string,
+	dates bson.D,
+) (bson.D, error) {
 	filterA := bson.A{}
 
 	// filter fot matching address
 	filterAddress := bson.D{{"addresses", bson.D{{"$in", []string{address.String()}}}}}
 	filterA = append(filterA, filterAddress)
 
+	// removed documents are not listed by address
+	filterArchived := bson.D{{"archived", bson.D{{"$ne", true}}}}
+	filterA = append(filterA, filterArchived)
+
+	if len(dates) > 0 {
+		filterA = append(filterA, dates)
+	}
+
 	// if doctype query exist, find by doctype first
 	if len(doctype) > 0 {
 		filterDoctype := bson.D{
@@ -1127,9 +1366,13 @@ func buildDocumentsFilterByAddress(address base.Address, offset string, reverse
 	return filter, nil
 }
 
-func buildDocumentsFilterByOffset(offset string, reverse bool, doctype string) (bson.D, error) {
+func buildDocumentsFilterByOffset(offset string, reverse bool, doctype string, dates bson.D) (bson.D, error) {
 	filterA := bson.A{}
 
+	if len(dates) > 0 {
+		filterA = append(filterA, dates)
+	}
+
 	// if doctype query exist, find by doctype first
 	if len(doctype) > 0 {
 		filterDoctype := bson.D{
@@ -1168,6 +1411,76 @@ func buildDocumentsFilterByOffset(offset string, reverse bool, doctype string) (
 	return filter, nil
 }
 
+// buildDateRangeFilter finds the documents, whose date is in between since and
+// until; the zero DocumentTime is not bounded.
+func buildDateRangeFilter(since, until document.DocumentTime) bson.D {
+	var cond bson.D
+	if !since.IsZero() {
+		cond = append(cond, bson.E{Key: "$gte", Value: since.Time()})
+	}
+	if !until.IsZero() {
+		cond = append(cond, bson.E{Key: "$lte", Value: until.Time()})
+	}
+
+	if len(cond) < 1 {
+		return nil
+	}
+
+	return bson.D{{"date", cond}}
+}
+
+// earthRadius is the radius of earth in meters, which converts distance to
+// radians for $centerSphere.
+const earthRadius = 6378100
+
+// buildBBoxFilter finds the land documents, whose geometry intersects the
+// bounding box of "<min longitude>,<min latitude>,<max longitude>,<max latitude>".
+func buildBBoxFilter(bbox []float64) bson.D {
+	ring := [][2]float64{
+		{bbox[0], bbox[1]},
+		{bbox[2], bbox[1]},
+		{bbox[2], bbox[3]},
+		{bbox[0], bbox[3]},
+		{bbox[0], bbox[1]},
+	}
+
+	return bson.D{{"geometry", bson.D{{"$geoIntersects", bson.D{{"$geometry", bson.D{
+		{"type", "Polygon"},
+		{"coordinates", [][][2]float64{ring}},
+	}}}}}}}
+}
+
+// buildNearFilter finds the land documents, whose geometry is within the
+// distance in meters from the point.
+func buildNearFilter(point []float64, distance float64) bson.D {
+	return bson.D{{"geometry", bson.D{{"$geoWithin", bson.D{
+		{"$centerSphere", bson.A{point, distance / earthRadius}},
+	}}}}}
+}
+
+func buildLandDocumentsFilter(geo bson.D, offset string, reverse bool) (bson.D, error) {
+	filterA := bson.A{geo}
+
+	// removed documents are not listed
+	filterArchived := bson.D{{"archived", bson.D{{"$ne", true}}}}
+	filterA = append(filterA, filterArchived)
+
+	// if offset exist, apply offset
+	if len(offset) > 0 {
+		height, err := parseOffsetHeight(offset)
+		if err != nil {
+			return nil, err
+		}
+		if !reverse {
+			filterA = append(filterA, bson.D{{"height", bson.D{{"$gt", height}}}})
+		} else {
+			filterA = append(filterA, bson.D{{"height", bson.D{{"$lt", height}}}})
+		}
+	}
+
+	return bson.D{{"$and", filterA}}, nil
+}
+
 func buildDocumentsByHeightFilter(height base.Height, reverse bool, doctype string) (bson.D, error) {
 	var filterA bson.A
 
@@ -1199,3 +1512,23 @@ func parseOffsetHeight(s string) (base.Height, error) {
 		return h, nil
 	}
 }
+
+func buildStatChangesFilter(documentid string, offset string, reverse bool) (bson.D, error) {
+	filter := bson.D{{"documentid", documentid}}
+	if len(offset) < 1 {
+		return filter, nil
+	}
+
+	height, err := parseOffsetHeight(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if reverse {
+		filter = append(filter, bson.E{Key: "height", Value: bson.D{{"$lt", height}}})
+	} else {
+		filter = append(filter, bson.E{Key: "height", Value: bson.D{{"$gt", height}}})
+	}
+
+	return filter, nil
+}
@@ -84,6 +84,66 @@ func LoadDocument(decoder func(interface{}) error, encs *encoder.Encoders) (Docu
 	}
 }
 
+func LoadPermission(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, errors.Errorf("not extended account state : %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadDelegates(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, errors.Errorf("not document delegates state : %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadVotingBox(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, errors.Errorf("not voting box state : %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadVotingResult(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(state.State); !ok {
+		return nil, errors.Errorf("not voting result state : %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
 func LoadDocuments(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
 	var b bson.Raw
 	if err := decoder(&b); err != nil {
@@ -98,3 +158,18 @@ func LoadDocuments(decoder func(interface{}) error, encs *encoder.Encoders) (sta
 		return st, nil
 	}
 }
+
+func LoadStatChange(decoder func(interface{}) error, encs *encoder.Encoders) (StatChangeValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return StatChangeValue{}, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return StatChangeValue{}, err
+	} else if va, ok := hinter.(StatChangeValue); !ok {
+		return StatChangeValue{}, errors.Errorf("not StatChangeValue : %T", hinter)
+	} else {
+		return va, nil
+	}
+}
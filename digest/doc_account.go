@@ -3,6 +3,7 @@ package digest
 import (
 	"github.com/pkg/errors"
 	"github.com/protoconNet/mitum-document/document"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/state"
@@ -124,3 +125,61 @@ func (doc DocumentsDoc) MarshalBSON() ([]byte, error) {
 
 	return bsonenc.Marshal(m)
 }
+
+type PermissionDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+}
+
+// NewPermissionDoc gets the State of extension.Account
+func NewPermissionDoc(st state.State, enc encoder.Encoder) (PermissionDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return PermissionDoc{}, err
+	}
+	return PermissionDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc PermissionDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	address := doc.st.Key()[:len(doc.st.Key())-len(extension.StateKeyAccountSuffix)]
+	m["address"] = address
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type DelegatesDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+}
+
+// NewDelegatesDoc gets the State of DocumentDelegates
+func NewDelegatesDoc(st state.State, enc encoder.Encoder) (DelegatesDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DelegatesDoc{}, err
+	}
+	return DelegatesDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc DelegatesDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	address := doc.st.Key()[:len(doc.st.Key())-len(document.StateKeyDelegatesSuffix)]
+	m["address"] = address
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
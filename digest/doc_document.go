@@ -3,9 +3,11 @@ package digest
 import (
 	"github.com/protoconNet/mitum-document/document"
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
 	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type DocumentDoc struct {
@@ -55,7 +57,147 @@ func (doc DocumentDoc) MarshalBSON() ([]byte, error) {
 	m["docid"] = doc.va.Document().DocumentId()[:len(doc.va.Document().DocumentId())-3]
 	m["doctype"] = doc.va.Document().DocumentId()[len(doc.va.Document().DocumentId())-3:]
 	m["addresses"] = doc.addresses
+	m["archived"] = document.IsArchivedDocumentData(doc.va.Document())
+	if i, ok := doc.va.Document().(document.BSDocData); ok && i.HasDeadline() {
+		m["deadline"] = i.Deadline()
+	}
+	if i, ok := doc.va.Document().(document.BCLandData); ok && i.HasGeometry() {
+		m["geometry"] = geoJSONGeometry(i.Geometry())
+	}
+	if dt := documentDate(doc.va.Document()); !dt.IsZero() && !dt.IsRaw() {
+		m["date"] = dt.Time()
+	}
 	m["height"] = doc.height
 
 	return bsonenc.Marshal(m)
 }
+
+// documentDate returns the date of blockcity document, by which the documents
+// are filtered in the range of since and until; rentdate of land, end vote
+// time of voting and date of history.
+func documentDate(doc document.DocumentData) document.DocumentTime {
+	switch t := doc.(type) {
+	case document.BCLandData:
+		return t.RentDate()
+	case document.BCVotingData:
+		return t.EndVoteTime()
+	case document.BCHistoryData:
+		return t.Date()
+	default:
+		return document.DocumentTime{}
+	}
+}
+
+// geoJSONGeometry converts the land geometry to the GeoJSON object, which the
+// 2dsphere index of mongodb accepts.
+func geoJSONGeometry(g document.LandGeometry) bson.M {
+	var coordinates interface{}
+	switch g.GeometryType() {
+	case document.GeometryPoint:
+		coordinates = g.Coordinates()[0]
+	default:
+		coordinates = [][][2]float64{g.Coordinates()}
+	}
+
+	return bson.M{
+		"type":        string(g.GeometryType()),
+		"coordinates": coordinates,
+	}
+}
+
+type VotingBoxDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+}
+
+// NewVotingBoxDoc gets the State of VotingBox
+func NewVotingBoxDoc(st state.State, enc encoder.Encoder) (VotingBoxDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return VotingBoxDoc{}, err
+	}
+	return VotingBoxDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc VotingBoxDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+	documentid := doc.st.Key()[:len(doc.st.Key())-len(document.StateKeyVotingBoxSuffix)]
+	m["documentid"] = documentid
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type VotingResultDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	vr document.VotingResult
+}
+
+// NewVotingResultDoc gets the State of VotingResult
+func NewVotingResultDoc(st state.State, enc encoder.Encoder) (VotingResultDoc, error) {
+	vr, err := document.StateVotingResultValue(st)
+	if err != nil {
+		return VotingResultDoc{}, err
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return VotingResultDoc{}, err
+	}
+
+	return VotingResultDoc{
+		BaseDoc: b,
+		st:      st,
+		vr:      vr,
+	}, nil
+}
+
+func (doc VotingResultDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["documentid"] = doc.vr.DocumentId()
+	m["round"] = doc.vr.Round()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type StatChangeDoc struct {
+	mongodbstorage.BaseDoc
+	va StatChangeValue
+}
+
+// NewStatChangeDoc gets the StatChangeValue of blockcity user document
+func NewStatChangeDoc(va StatChangeValue, enc encoder.Encoder) (StatChangeDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
+	if err != nil {
+		return StatChangeDoc{}, err
+	}
+
+	return StatChangeDoc{
+		BaseDoc: b,
+		va:      va,
+	}, nil
+}
+
+func (doc StatChangeDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["documentid"] = doc.va.DocumentId()
+	m["height"] = doc.va.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -9,6 +9,7 @@ import (
 	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/hint"
 )
 
 type OperationDoc struct {
@@ -63,6 +64,9 @@ func (doc OperationDoc) MarshalBSON() ([]byte, error) {
 
 	m["addresses"] = doc.addresses
 	m["fact"] = doc.op.Fact().Hash()
+	if i, ok := doc.op.Fact().(hint.Hinter); ok {
+		m["fact_type"] = i.Hint().Type().String()
+	}
 	m["height"] = doc.height
 	m["index"] = doc.va.index
 
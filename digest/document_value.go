@@ -12,8 +12,9 @@ var (
 )
 
 type DocumentValue struct {
-	doc    document.DocumentData
-	height base.Height
+	doc     document.DocumentData
+	height  base.Height
+	expired bool
 }
 
 func NewDocumentValue(
@@ -38,3 +39,17 @@ func (dv DocumentValue) Document() document.DocumentData {
 func (dv DocumentValue) Height() base.Height {
 	return dv.height
 }
+
+func (dv DocumentValue) Expired() bool {
+	return dv.expired
+}
+
+// WithNextHeight marks the blocksign document, which deadline is passed at
+// the next block height; the document can not be signed anymore.
+func (dv DocumentValue) WithNextHeight(height base.Height) DocumentValue {
+	if i, ok := dv.doc.(document.BSDocData); ok {
+		dv.expired = i.IsExpired(height)
+	}
+
+	return dv
+}
@@ -12,6 +12,7 @@ type DocumentValueJSONPacker struct {
 	jsonenc.HintedHead
 	DM document.DocumentData `json:"document"`
 	HT base.Height           `json:"height"`
+	EX bool                  `json:"expired,omitempty"`
 }
 
 func (va DocumentValue) MarshalJSON() ([]byte, error) {
@@ -19,12 +20,14 @@ func (va DocumentValue) MarshalJSON() ([]byte, error) {
 		HintedHead: jsonenc.NewHintedHead(va.Hint()),
 		DM:         va.doc,
 		HT:         va.height,
+		EX:         va.expired,
 	})
 }
 
 type DocumentValueJSONUnpacker struct {
 	DM json.RawMessage `json:"document"`
 	HT base.Height     `json:"height"`
+	EX bool            `json:"expired,omitempty"`
 }
 
 func (dv *DocumentValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -36,5 +39,7 @@ func (dv *DocumentValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 	if err := dv.unpack(enc, uva.DM, uva.HT); err != nil {
 		return err
 	}
+	dv.expired = uva.EX
+
 	return nil
 }
@@ -33,12 +33,16 @@ var (
 	HandlerPathCurrency                   = `/currency/{currencyid:.*}`
 	HandlerPathDocuments                  = `/block/documents`
 	HandlerPathDocument                   = `/block/document/{documentid:[0-9a-z]+}`
+	HandlerPathDocumentResults            = `/block/document/{documentid:[0-9a-z]+}/results`
+	HandlerPathDocumentVoting             = `/block/document/{documentid:[0-9a-z]+}/voting`
+	HandlerPathDocumentStats              = `/block/document/{documentid:[0-9a-z]+}/stats`
 	HandlerPathManifests                  = `/block/manifests`
 	HandlerPathOperations                 = `/block/operations`
 	HandlerPathOperation                  = `/block/operation/{hash:(?i)[0-9a-z][0-9a-z]+}`
 	HandlerPathBlockByHeight              = `/block/{height:[0-9]+}`
 	HandlerPathBlockByHash                = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}`
 	HandlerPathDocumentsByHeight          = `/block/{height:[0-9]+}/documents`
+	HandlerPathLandDocuments              = `/block/documents/land`
 	HandlerPathOperationsByHeight         = `/block/{height:[0-9]+}/operations`
 	HandlerPathManifestByHeight           = `/block/{height:[0-9]+}/manifest`
 	HandlerPathManifestByHash             = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}/manifest`
@@ -46,7 +50,10 @@ var (
 	HandlerPathAccountOperations          = `/account/{address:(?i)` + base.REStringAddressString + `}/operations` // revive:disable-line:line-length-limit
 	HandlerPathAccounts                   = `/accounts`
 	HandlerPathAccountDocuments           = `/account/{address:(?i)` + base.REStringAddressString + `}/documents` // revive:disable-line:line-length-limit
+	HandlerPathAccountDelegates           = `/account/{address:(?i)` + base.REStringAddressString + `}/delegates` // revive:disable-line:line-length-limit
+	HandlerPathAccountSwaps               = `/account/{address:(?i)` + base.REStringAddressString + `}/swaps`     // revive:disable-line:line-length-limit
 	HandlerPathOperationBuildFactTemplate = `/builder/operation/fact/template/{fact:[\w][\w\-]*}`
+	HandlerPathOperationBuildDocTemplate  = `/builder/operation/fact/template/create-documents/{doctype:[\w][\w\-]*}`
 	HandlerPathOperationBuildFact         = `/builder/operation/fact`
 	HandlerPathOperationBuildSign         = `/builder/operation/sign`
 	HandlerPathOperationBuild             = `/builder/operation`
@@ -59,12 +66,16 @@ var RateLimitHandlerMap = map[string]string{
 	"currency":                        HandlerPathCurrency,
 	"documents":                       HandlerPathDocuments,
 	"document":                        HandlerPathDocument,
+	"document-results":                HandlerPathDocumentResults,
+	"document-voting":                 HandlerPathDocumentVoting,
+	"document-stats":                  HandlerPathDocumentStats,
 	"block-manifests":                 HandlerPathManifests,
 	"block-operations":                HandlerPathOperations,
 	"block-operation":                 HandlerPathOperation,
 	"block-by-height":                 HandlerPathBlockByHeight,
 	"block-by-hash":                   HandlerPathBlockByHash,
 	"block-documents-by-height":       HandlerPathDocumentsByHeight,
+	"land-documents":                  HandlerPathLandDocuments,
 	"block-operations-by-height":      HandlerPathOperationsByHeight,
 	"block-manifest-by-height":        HandlerPathManifestByHeight,
 	"block-manifest-by-hash":          HandlerPathManifestByHash,
@@ -72,7 +83,10 @@ var RateLimitHandlerMap = map[string]string{
 	"account-operations":              HandlerPathAccountOperations,
 	"accounts":                        HandlerPathAccounts,
 	"account-documents":               HandlerPathAccountDocuments,
+	"account-delegates":               HandlerPathAccountDelegates,
+	"account-swaps":                   HandlerPathAccountSwaps,
 	"builder-operation-fact-template": HandlerPathOperationBuildFactTemplate,
+	"builder-operation-doc-template":  HandlerPathOperationBuildDocTemplate,
 	"builder-operation-fact":          HandlerPathOperationBuildFact,
 	"builder-operation-sign":          HandlerPathOperationBuildSign,
 	"builder-operation":               HandlerPathOperationBuild,
@@ -181,6 +195,12 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathDocument, hd.handleDocument, true).
 		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathDocumentResults, hd.handleDocumentResults, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathDocumentVoting, hd.handleDocumentVoting, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathDocumentStats, hd.handleDocumentStats, true).
+		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathManifests, hd.handleManifests, true).
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathOperations, hd.handleOperations, true).
@@ -189,6 +209,8 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathDocumentsByHeight, hd.handleDocumentsByHeight, true).
 		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathLandDocuments, hd.handleLandDocuments, true).
+		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathOperationsByHeight, hd.handleOperationsByHeight, true).
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathManifestByHeight, hd.handleManifestByHeight, true).
@@ -207,8 +229,14 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathAccountDocuments, hd.handleAccountDocuments, true).
 		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathAccountDelegates, hd.handleAccountDelegates, true).
+		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathAccountSwaps, hd.handleAccountSwaps, true).
+		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathOperationBuildFactTemplate, hd.handleOperationBuildFactTemplate, true).
 		Methods(http.MethodOptions, "GET")
+	hd.setHandler(HandlerPathOperationBuildDocTemplate, hd.handleOperationBuildDocTemplate, true).
+		Methods(http.MethodOptions, "GET")
 	hd.setHandler(HandlerPathOperationBuildFact, hd.handleOperationBuildFact, false).
 		Methods(http.MethodOptions, http.MethodPost)
 	hd.setHandler(HandlerPathOperationBuildSign, hd.handleOperationBuildSign, false).
@@ -14,6 +14,7 @@ import (
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func (hd *Handlers) handleAccount(w http.ResponseWriter, r *http.Request) {
@@ -96,6 +97,22 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 		AddLink("documents:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated()).
 		AddLink("documents:{offset,reverse}", NewHalLink(h+"?offset={offset}&reverse=1", nil).SetTemplated())
 
+	h, err = hd.combineURL(HandlerPathAccountDelegates, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("delegates", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccountSwaps, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+
+	hal = hal.
+		AddLink("swaps", NewHalLink(h, nil)).
+		AddLink("swaps:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated()).
+		AddLink("swaps:{offset,reverse}", NewHalLink(h+"?offset={offset}&reverse=1", nil).SetTemplated())
+
 	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
 	if err != nil {
 		return nil, err
@@ -194,7 +211,99 @@ func (hd *Handlers) handleAccountOperationsInGroup(
 		return nil, false, util.NotFoundError.Errorf("operations not found")
 	}
 
-	i, err := hd.buildAccountOperationsHal(address, vas, offset, reverse)
+	i, err := hd.buildAccountOperationsHal(HandlerPathAccountOperations, address, vas, offset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := hd.enc.Marshal(i)
+	return b, int64(len(vas)) == limit, err
+}
+
+// handleAccountSwaps returns the swap gold operations of account; the amount
+// of currency is the price of fact multiplied by the amount of gold.
+func (hd *Handlers) handleAccountSwaps(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	limit := parseLimitQuery(r.URL.Query().Get("limit"))
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse))
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleAccountSwapsInGroup(address, offset, limit, reverse)
+
+		return []interface{}{i, filled}, err
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		var b []byte
+		var filled bool
+		{
+			l := v.([]interface{})
+			b = l[0].([]byte)
+			filled = l[1].(bool)
+		}
+
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+		if !shared {
+			expire := hd.expireNotFilled
+			if len(offset) > 0 && filled {
+				expire = time.Hour * 30
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountSwapsInGroup(
+	address base.Address,
+	offset string,
+	l int64,
+	reverse bool,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("account-swaps")
+	} else {
+		limit = l
+	}
+	var vas []Hal
+	if err := hd.database.GoldSwapsByAddress(
+		address, reverse, offset, limit,
+		func(_ valuehash.Hash, va OperationValue) (bool, error) {
+			hal, err := hd.buildOperationHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("swaps not found")
+	}
+
+	i, err := hd.buildAccountOperationsHal(HandlerPathAccountSwaps, address, vas, offset, reverse)
 	if err != nil {
 		return nil, false, err
 	}
@@ -204,12 +313,13 @@ func (hd *Handlers) handleAccountOperationsInGroup(
 }
 
 func (hd *Handlers) buildAccountOperationsHal(
+	path string,
 	address base.Address,
 	vas []Hal,
 	offset string,
 	reverse bool,
 ) (Hal, error) {
-	baseSelf, err := hd.combineURL(HandlerPathAccountOperations, "address", address.String())
+	baseSelf, err := hd.combineURL(path, "address", address.String())
 	if err != nil {
 		return nil, err
 	}
@@ -462,14 +572,24 @@ func (hd *Handlers) handleAccountDocuments(w http.ResponseWriter, r *http.Reques
 	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
 	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
 
-	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse), stringDoctypeQuery(doctype))
+	dates, dateQuery, err := parseDateRangeQuery(r.URL.Query().Get("since"), r.URL.Query().Get("until"))
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := CacheKey(
+		r.URL.Path, dateQuery, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse), stringDoctypeQuery(doctype),
+		stringLastHeightQuery(hd.database.LastBlock()),
+	)
 
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
 	}
 
 	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleAccountDocumentsInGroup(address, offset, reverse, limit, doctype)
+		i, filled, err := hd.handleAccountDocumentsInGroup(address, offset, reverse, limit, doctype, dates, dateQuery)
 
 		return []interface{}{i, filled}, err
 	})
@@ -507,6 +627,8 @@ func (hd *Handlers) handleAccountDocumentsInGroup(
 	reverse bool,
 	l int64,
 	doctype string,
+	dates bson.D,
+	dateQuery string,
 ) ([]byte, bool, error) {
 	var limit int64
 	if l < 0 {
@@ -517,7 +639,7 @@ func (hd *Handlers) handleAccountDocumentsInGroup(
 
 	var vas []Hal
 	if err := hd.database.DocumentsByAddress(
-		address, reverse, offset, limit, doctype,
+		address, reverse, offset, limit, doctype, dates,
 		func(_ string, va DocumentValue) (bool, error) {
 			hal, err := hd.buildDocumentHal(va)
 			if err != nil {
@@ -533,7 +655,7 @@ func (hd *Handlers) handleAccountDocumentsInGroup(
 		return nil, false, util.NotFoundError.Errorf("documents not found")
 	}
 
-	i, err := hd.buildAccountDocumentsHal(address, vas, offset, reverse, doctype)
+	i, err := hd.buildAccountDocumentsHal(address, vas, offset, reverse, doctype, dateQuery)
 	if err != nil {
 		return nil, false, err
 	}
@@ -548,11 +670,13 @@ func (hd *Handlers) buildAccountDocumentsHal(
 	offset string,
 	reverse bool,
 	doctype string,
+	dateQuery string,
 ) (Hal, error) {
 	baseSelf, err := hd.combineURL(HandlerPathAccountDocuments, "address", address.String())
 	if err != nil {
 		return nil, err
 	}
+	baseSelf = addQueryValue(baseSelf, dateQuery)
 
 	self := baseSelf
 	if len(offset) > 0 {
@@ -596,3 +720,68 @@ func (hd *Handlers) buildAccountDocumentsHal(
 
 	return hal, nil
 }
+
+func (hd *Handlers) handleAccountDelegates(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountDelegatesInGroup(address)
+	}); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			err = util.NotFoundError.Errorf("delegates of account, %s not found", address)
+		} else {
+			hd.Log().Error().Err(err).Str("address", address.String()).Msg("failed to get delegates")
+		}
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountDelegatesInGroup(address base.Address) (interface{}, error) {
+	dgs, height, _, err := hd.database.Delegates(address)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(HandlerPathAccountDelegates, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(dgs, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", height.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
@@ -2,10 +2,12 @@ package digest
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,14 +19,24 @@ func (hd *Handlers) handleDocuments(w http.ResponseWriter, r *http.Request) {
 	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
 	doctype := parseStringQuery(r.URL.Query().Get("doctype"))
 
-	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse), stringDoctypeQuery(doctype))
+	dates, dateQuery, err := parseDateRangeQuery(r.URL.Query().Get("since"), r.URL.Query().Get("until"))
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := CacheKey(
+		r.URL.Path, dateQuery, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse), stringDoctypeQuery(doctype),
+		stringLastHeightQuery(hd.database.LastBlock()),
+	)
 
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
 	}
 
 	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleDocumentsInGroup(offset, reverse, limit, doctype)
+		i, filled, err := hd.handleDocumentsInGroup(offset, reverse, limit, doctype, dates, dateQuery)
 
 		return []interface{}{i, filled}, err
 	}); err != nil {
@@ -56,6 +68,8 @@ func (hd *Handlers) handleDocumentsInGroup(
 	reverse bool,
 	l int64,
 	doctype string,
+	dates bson.D,
+	dateQuery string,
 ) ([]byte, bool, error) {
 	var limit int64
 	if l < 0 {
@@ -63,7 +77,7 @@ func (hd *Handlers) handleDocumentsInGroup(
 	} else {
 		limit = l
 	}
-	filter, err := buildDocumentsFilterByOffset(offset, reverse, doctype)
+	filter, err := buildDocumentsFilterByOffset(offset, reverse, doctype, dates)
 	if err != nil {
 		return nil, false, err
 	}
@@ -82,6 +96,8 @@ func (hd *Handlers) handleDocumentsInGroup(
 	if err != nil {
 		return nil, false, err
 	}
+	h = addQueryValue(h, dateQuery)
+
 	hal := hd.buildDocumentsHal(h, vas, offset, reverse)
 	if next := nextOffsetOfDocuments(h, vas, doctype, reverse); len(next) > 0 {
 		hal = hal.AddLink("next", NewHalLink(next, nil))
@@ -93,7 +109,7 @@ func (hd *Handlers) handleDocumentsInGroup(
 
 func (hd *Handlers) handleDocument(w http.ResponseWriter, r *http.Request) {
 
-	cachekey := CacheKeyPath(r)
+	cachekey := CacheKey(CacheKeyPath(r), stringLastHeightQuery(hd.database.LastBlock()))
 
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
@@ -137,13 +153,327 @@ func (hd *Handlers) handleDocumentInGroup(i string) ([]byte, error) {
 	}
 }
 
+func (hd *Handlers) handleDocumentResults(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	h, err := parseDocIdFromPath(mux.Vars(r)["documentid"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Errorf("invalid document id for voting results: %q", err), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleDocumentResultsInGroup(h)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleDocumentResultsInGroup(i string) ([]byte, error) {
+	var vas []Hal
+	if err := hd.database.VotingResults(i, func(vr document.VotingResult, height base.Height) (bool, error) {
+		hal, err := hd.buildVotingResultHal(vr, height)
+		if err != nil {
+			return false, err
+		}
+		vas = append(vas, hal)
+
+		return true, nil
+	}); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("voting results not found")
+	}
+
+	h, err := hd.combineURL(HandlerPathDocumentResults, "documentid", i)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathDocument, "documentid", i)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("document", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) handleDocumentVoting(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKey(CacheKeyPath(r), stringLastHeightQuery(hd.database.LastBlock()))
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	h, err := parseDocIdFromPath(mux.Vars(r)["documentid"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Errorf("invalid document id for voting: %q", err), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleDocumentVotingInGroup(h)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+// handleDocumentVotingInGroup shows the phase of the current round of voting
+// document at the next block and the tallies of the votes counted so far. In
+// secret ballot, the committed votes are not counted until revealed.
+func (hd *Handlers) handleDocumentVotingInGroup(i string) ([]byte, error) {
+	var doc document.BCVotingData
+	switch va, found, err := hd.database.Document(i); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, util.NotFoundError.Errorf("document value not found")
+	default:
+		j, ok := va.Document().(document.BCVotingData)
+		if !ok {
+			return nil, util.NotFoundError.Errorf("voting document, %q not found", i)
+		}
+		doc = j
+	}
+
+	var commits, voters int
+	switch box, _, err := hd.database.VotingBox(i); {
+	case err == nil:
+		if box.Round() == doc.Round() {
+			commits = len(box.Commits())
+			voters = len(box.Voters())
+		}
+	case !errors.Is(err, util.NotFoundError):
+		return nil, err
+	}
+
+	tallies := map[string]uint{}
+	var total uint
+	for j := range doc.Candidates() {
+		vc := doc.Candidates()[j]
+		tallies[vc.Address().String()] = vc.Count()
+		total += vc.Count()
+	}
+
+	h, err := hd.combineURL(HandlerPathDocumentVoting, "documentid", i)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(map[string]interface{}{
+		"documentid":      i,
+		"round":           doc.Round(),
+		"phase":           doc.Phase(hd.nextHeight()),
+		"secret":          doc.IsSecret(),
+		"deadline":        doc.Deadline(),
+		"reveal_deadline": doc.RevealDeadline(),
+		"weight":          doc.Weight(),
+		"snapshot":        doc.Snapshot(),
+		"tallies":         tallies,
+		"total":           total,
+		"voters":          voters,
+		"commits":         commits,
+	}, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathDocument, "documentid", i)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("document", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathDocumentResults, "documentid", i)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("results", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+// handleDocumentStats returns the stat change log of blockcity user document;
+// each entry has the statistics before and after the change at the height.
+func (hd *Handlers) handleDocumentStats(w http.ResponseWriter, r *http.Request) {
+	h, err := parseDocIdFromPath(mux.Vars(r)["documentid"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Errorf("invalid document id for stat changes: %q", err), http.StatusBadRequest)
+
+		return
+	}
+
+	limit := parseLimitQuery(r.URL.Query().Get("limit"))
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse))
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleDocumentStatsInGroup(h, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		var b []byte
+		var filled bool
+		{
+			l := v.([]interface{})
+			b = l[0].([]byte)
+			filled = l[1].(bool)
+		}
+
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+		if !shared {
+			expire := hd.expireNotFilled
+			if len(offset) > 0 && filled {
+				expire = time.Minute
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleDocumentStatsInGroup(
+	i string,
+	offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("document-stats")
+	} else {
+		limit = l
+	}
+
+	var vas []Hal
+	var lastHeight base.Height
+	if err := hd.database.StatChanges(i, reverse, offset, limit, func(va StatChangeValue) (bool, error) {
+		hal, err := hd.buildStatChangeHal(va)
+		if err != nil {
+			return false, err
+		}
+		vas = append(vas, hal)
+		lastHeight = va.Height()
+
+		return true, nil
+	}); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("stat changes not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathDocumentStats, "documentid", i)
+	if err != nil {
+		return nil, false, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(self, stringOffsetQuery(offset))
+	}
+	if reverse {
+		self = addQueryValue(self, stringBoolQuery("reverse", reverse))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathDocument, "documentid", i)
+	if err != nil {
+		return nil, false, err
+	}
+	hal = hal.AddLink("document", NewHalLink(h, nil))
+
+	next := addQueryValue(baseSelf, stringOffsetQuery(buildOffsetHeight(lastHeight)))
+	if reverse {
+		next = addQueryValue(next, stringBoolQuery("reverse", reverse))
+	}
+	hal = hal.AddLink("next", NewHalLink(next, nil))
+
+	b, err := hd.enc.Marshal(hal)
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildStatChangeHal(va StatChangeValue) (Hal, error) {
+	var hal Hal
+	hal = NewBaseHal(va, HalLink{})
+
+	h, err := hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+// buildVotingResultHal builds the Hal of the closed round with the tallies of
+// candidates and the elected candidate.
+func (hd *Handlers) buildVotingResultHal(vr document.VotingResult, height base.Height) (Hal, error) {
+	var hal Hal
+	hal = NewBaseHal(vr, HalLink{})
+
+	tallies := map[string]uint{}
+	var total uint
+	for j := range vr.Candidates() {
+		vc := vr.Candidates()[j]
+		tallies[vc.Address().String()] = vc.Count()
+		total += vc.Count()
+	}
+	hal = hal.AddExtras("tallies", tallies).AddExtras("total", total)
+
+	if winner, found := vr.Winner(); found {
+		hal = hal.AddExtras("winner", winner)
+	}
+
+	h, err := hd.combineURL(HandlerPathBlockByHeight, "height", height.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
 func (hd *Handlers) handleDocumentsByHeight(w http.ResponseWriter, r *http.Request) {
 	limit := parseLimitQuery(r.URL.Query().Get("limit"))
 	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
 	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
 	doctype := parseStringQuery(r.URL.Query().Get("doctype"))
 
-	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse), stringDoctypeQuery(doctype))
+	cachekey := CacheKey(
+		r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse), stringDoctypeQuery(doctype),
+		stringLastHeightQuery(hd.database.LastBlock()),
+	)
 
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
@@ -230,9 +560,155 @@ func (hd *Handlers) handleDocumentsByHeightInGroup(
 	return b, int64(len(vas)) == limit, err
 }
 
+// handleLandDocuments returns the land documents, whose geometry intersects the
+// bounding box, "bbox=<min longitude>,<min latitude>,<max longitude>,<max latitude>",
+// or is within the distance in meters from the point,
+// "near=<longitude>,<latitude>&distance=<meters>".
+func (hd *Handlers) handleLandDocuments(w http.ResponseWriter, r *http.Request) {
+	limit := parseLimitQuery(r.URL.Query().Get("limit"))
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+	bbox := parseStringQuery(r.URL.Query().Get("bbox"))
+	near := parseStringQuery(r.URL.Query().Get("near"))
+	distance := parseStringQuery(r.URL.Query().Get("distance"))
+
+	var geo bson.D
+	var geoQuery string
+	switch {
+	case len(bbox) > 0 && len(near) > 0:
+		HTTP2ProblemWithError(w, errors.Errorf("bbox and near can not be queried together"), http.StatusBadRequest)
+
+		return
+	case len(bbox) > 0:
+		l, err := parseCoordinatesQuery(bbox, 4)
+		if err != nil {
+			HTTP2ProblemWithError(w, errors.Wrap(err, "invalid bbox"), http.StatusBadRequest)
+
+			return
+		}
+		if l[0] >= l[2] || l[1] >= l[3] {
+			HTTP2ProblemWithError(w, errors.Errorf("invalid bbox; min should be lesser than max"), http.StatusBadRequest)
+
+			return
+		}
+
+		geo = buildBBoxFilter(l)
+		geoQuery = "bbox=" + bbox
+	case len(near) > 0:
+		l, err := parseCoordinatesQuery(near, 2)
+		if err != nil {
+			HTTP2ProblemWithError(w, errors.Wrap(err, "invalid near"), http.StatusBadRequest)
+
+			return
+		}
+
+		d, err := strconv.ParseFloat(distance, 64)
+		if err != nil || !(d > 0) {
+			HTTP2ProblemWithError(w, errors.Errorf("invalid distance, %q", distance), http.StatusBadRequest)
+
+			return
+		}
+
+		geo = buildNearFilter(l, d)
+		geoQuery = "near=" + near + "&distance=" + distance
+	default:
+		HTTP2ProblemWithError(w, errors.Errorf("bbox or near should be queried"), http.StatusBadRequest)
+
+		return
+	}
+
+	cachekey := CacheKey(
+		r.URL.Path, geoQuery, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse),
+		stringLastHeightQuery(hd.database.LastBlock()),
+	)
+
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleLandDocumentsInGroup(geo, geoQuery, offset, reverse, limit)
+
+		return []interface{}{i, filled}, err
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		var b []byte
+		var filled bool
+		{
+			l := v.([]interface{})
+			b = l[0].([]byte)
+			filled = l[1].(bool)
+		}
+
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+		if !shared {
+			expire := hd.expireNotFilled
+			if len(offset) > 0 && filled {
+				expire = time.Minute
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleLandDocumentsInGroup(
+	geo bson.D,
+	geoQuery string,
+	offset string,
+	reverse bool,
+	l int64,
+) ([]byte, bool, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("documents")
+	} else {
+		limit = l
+	}
+	filter, err := buildLandDocumentsFilter(geo, offset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var vas []Hal
+	switch l, e := hd.loadDocumentsHALFromDatabase(filter, reverse, limit); {
+	case e != nil:
+		return nil, false, e
+	case len(l) < 1:
+		return nil, false, util.NotFoundError.Errorf("land documents not found")
+	default:
+		vas = l
+	}
+
+	h, err := hd.combineURL(HandlerPathLandDocuments)
+	if err != nil {
+		return nil, false, err
+	}
+	h = addQueryValue(h, geoQuery)
+
+	hal := hd.buildDocumentsHal(h, vas, offset, reverse)
+	if next := nextOffsetOfDocuments(h, vas, "", reverse); len(next) > 0 {
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	b, err := hd.enc.Marshal(hal)
+	return b, int64(len(vas)) == limit, err
+}
+
+// nextHeight returns the height of the next block. The operations sent now
+// are processed in the next block at the earliest, so the expiry, the voting
+// phase and the lease of documents are shown at the next block.
+func (hd *Handlers) nextHeight() base.Height {
+	return hd.database.LastBlock() + 1
+}
+
 func (hd *Handlers) buildDocumentHal(va DocumentValue) (Hal, error) {
 	var hal Hal
 
+	va = va.WithNextHeight(hd.nextHeight())
+
 	h, err := hd.combineURL(HandlerPathDocument, "documentid", va.Document().DocumentId())
 	if err != nil {
 		return nil, err
@@ -251,6 +727,35 @@ func (hd *Handlers) buildDocumentHal(va DocumentValue) (Hal, error) {
 	}
 	hal = hal.AddLink("manifest", NewHalLink(h, nil))
 
+	if _, ok := va.Document().(document.BCVotingData); ok {
+		h, err = hd.combineURL(HandlerPathDocumentVoting, "documentid", va.Document().DocumentId())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("voting", NewHalLink(h, nil))
+
+		h, err = hd.combineURL(HandlerPathDocumentResults, "documentid", va.Document().DocumentId())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("results", NewHalLink(h, nil))
+	}
+
+	if _, ok := va.Document().(document.BCUserData); ok {
+		h, err = hd.combineURL(HandlerPathDocumentStats, "documentid", va.Document().DocumentId())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("stats", NewHalLink(h, nil))
+	}
+
+	if doc, ok := va.Document().(document.BCLandData); ok {
+		hal = hal.AddExtras("lease", map[string]interface{}{
+			"leased":    doc.IsLeased(hd.nextHeight()),
+			"lease_end": doc.LeaseEnd(),
+		})
+	}
+
 	return hal, nil
 }
 
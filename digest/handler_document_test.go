@@ -0,0 +1,83 @@
+//go:build mongodb
+// +build mongodb
+
+package digest
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/protoconNet/mitum-document/document"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/stretchr/testify/suite"
+)
+
+type testHandlerDocument struct {
+	baseTestHandlers
+}
+
+func (t *testHandlerDocument) SetupSuite() {
+	t.baseTestHandlers.SetupSuite()
+
+	_ = t.Encs.TestAddHinter(DocumentValue{})
+	_ = t.Encs.TestAddHinter(document.BSDocDataHinter)
+	_ = t.Encs.TestAddHinter(document.BSDocIdHinter)
+	_ = t.Encs.TestAddHinter(document.DocInfoHinter)
+	_ = t.Encs.TestAddHinter(document.DocSignHinter)
+}
+
+func (t *testHandlerDocument) requestDocument(handlers *Handlers, documentid string) DocumentValue {
+	self, err := handlers.router.Get(HandlerPathDocument).URLPath("documentid", documentid)
+	t.NoError(err)
+
+	w := t.requestOK(handlers, "GET", self.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hinter, err := t.JSONEnc.Decode(t.loadHal(b).RawInterface())
+	t.NoError(err)
+
+	va, ok := hinter.(DocumentValue)
+	t.True(ok)
+
+	return va
+}
+
+func (t *testHandlerDocument) TestExpiredAtNextBlock() {
+	st, _ := t.Database()
+
+	owner := t.newAccount()
+	signer := t.newAccount()
+
+	doc := document.MustNewBSDocData(
+		document.MustNewDocInfo("1sdi", document.BSDocDataType),
+		owner.Address(),
+		document.FileHash("filehash"),
+		document.NewDocSign(owner.Address(), "signcode", true),
+		"title",
+		currency.NewBig(10),
+		[]document.DocSign{document.NewDocSign(signer.Address(), "signcode", false)},
+	).WithDeadline(base.Height(33))
+
+	dd, err := NewDocumentDoc(t.JSONEnc, doc, base.Height(30))
+	t.NoError(err)
+	_ = t.insertDoc(st, defaultColNameDocument, dd)
+
+	// NOTE the expiry is cached by the last block height
+	handlers := t.handlers(st, NewLocalMemCache(100, time.Minute))
+
+	// signing is still allowed in the next block, 33
+	t.NoError(st.SetLastBlock(base.Height(32)))
+	t.False(t.requestDocument(handlers, doc.DocumentId()).Expired())
+
+	// signing in the next block, 34 is rejected by the deadline
+	t.NoError(st.SetLastBlock(base.Height(33)))
+	t.True(t.requestDocument(handlers, doc.DocumentId()).Expired())
+}
+
+func TestHandlerDocument(t *testing.T) {
+	suite.Run(t, new(testHandlerDocument))
+}
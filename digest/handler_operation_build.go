@@ -86,6 +86,33 @@ func (hd *Handlers) handleOperationBuildFactTemplate(w http.ResponseWriter, r *h
 	HTTP2WriteCache(w, CacheKeyPath(r), time.Hour*100*100*100)
 }
 
+func (hd *Handlers) handleOperationBuildDocTemplate(w http.ResponseWriter, r *http.Request) {
+	if err := LoadFromCache(hd.cache, CacheKeyPath(r), w); err == nil {
+		return
+	}
+
+	docType := mux.Vars(r)["doctype"]
+
+	builder := NewBuilder(hd.enc, hd.networkID).WithDatabase(hd.database)
+	hal, err := builder.GeneralDocumentTemplate(hint.Type(docType))
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusNotFound)
+
+		return
+	}
+
+	h, err := hd.combineURL(HandlerPathOperationBuildDocTemplate, "doctype", docType)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+	hal = hal.SetSelf(NewHalLink(h, nil))
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+	HTTP2WriteCache(w, CacheKeyPath(r), time.Hour*100*100*100)
+}
+
 func (hd *Handlers) handleOperationBuildFact(w http.ResponseWriter, r *http.Request) {
 	body := &bytes.Buffer{}
 	if _, err := io.Copy(body, r.Body); err != nil {
@@ -95,6 +122,10 @@ func (hd *Handlers) handleOperationBuildFact(w http.ResponseWriter, r *http.Requ
 	}
 
 	builder := NewBuilder(hd.enc, hd.networkID)
+	if parseBoolQuery(r.URL.Query().Get("expected")) {
+		builder = builder.WithDatabase(hd.database)
+	}
+
 	hal, err := builder.BuildFact(body.Bytes())
 	if err != nil {
 		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
@@ -68,6 +68,16 @@ var documentIndexModels = []mongo.IndexModel{
 		Options: options.Index().
 			SetName("mitum_digest_document_height"),
 	},
+	{
+		Keys: bson.D{bson.E{Key: "date", Value: -1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_document_date"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "geometry", Value: "2dsphere"}},
+		Options: options.Index().
+			SetName("mitum_digest_document_geometry"),
+	},
 }
 
 var documentsIndexModels = []mongo.IndexModel{
@@ -92,12 +102,87 @@ var documentsIndexModels = []mongo.IndexModel{
 	},
 }
 
+var permissionIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_permission"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_permission_height"),
+	},
+}
+
+var delegatesIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_delegates"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_delegates_height"),
+	},
+}
+
+var votingBoxIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "documentid", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_voting_box"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_voting_box_height"),
+	},
+}
+
+var votingResultIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "documentid", Value: 1}, bson.E{Key: "round", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_voting_result"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_voting_result_height"),
+	},
+}
+
+var statChangeIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "documentid", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_stat_change"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_stat_change_height"),
+	},
+}
+
 var operationIndexModels = []mongo.IndexModel{
 	{
 		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
 		Options: options.Index().
 			SetName("mitum_digest_account_operation"),
 	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "addresses", Value: 1},
+			bson.E{Key: "fact_type", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_account_operation_fact_type"),
+	},
 	{
 		Keys: bson.D{bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
 		Options: options.Index().
@@ -111,9 +196,14 @@ var operationIndexModels = []mongo.IndexModel{
 }
 
 var defaultIndexes = map[string] /* collection */ []mongo.IndexModel{
-	defaultColNameAccount:   accountIndexModels,
-	defaultColNameBalance:   balanceIndexModels,
-	defaultColNameDocument:  documentIndexModels,
-	defaultColNameDocuments: documentsIndexModels,
-	defaultColNameOperation: operationIndexModels,
+	defaultColNameAccount:      accountIndexModels,
+	defaultColNameBalance:      balanceIndexModels,
+	defaultColNameDocument:     documentIndexModels,
+	defaultColNameDocuments:    documentsIndexModels,
+	defaultColNamePermission:   permissionIndexModels,
+	defaultColNameDelegates:    delegatesIndexModels,
+	defaultColNameVotingBox:    votingBoxIndexModels,
+	defaultColNameVotingResult: votingResultIndexModels,
+	defaultColNameStatChange:   statChangeIndexModels,
+	defaultColNameOperation:    operationIndexModels,
 }
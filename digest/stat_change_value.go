@@ -0,0 +1,58 @@
+package digest
+
+import (
+	"github.com/protoconNet/mitum-document/document"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	StatChangeValueType = hint.Type("mitum-blockcity-stat-change-value")
+	StatChangeValueHint = hint.NewHint(StatChangeValueType, "v0.0.1")
+)
+
+// StatChangeValue is the entry of the stat change log of blockcity user
+// document; it keeps the statistics before and after the change at height.
+type StatChangeValue struct {
+	documentid string
+	previous   document.UserStatistics
+	statistics document.UserStatistics
+	height     base.Height
+}
+
+func NewStatChangeValue(
+	documentid string,
+	previous, statistics document.UserStatistics,
+	height base.Height,
+) StatChangeValue {
+	return StatChangeValue{
+		documentid: documentid,
+		previous:   previous,
+		statistics: statistics,
+		height:     height,
+	}
+}
+
+func (sv StatChangeValue) Hint() hint.Hint {
+	return StatChangeValueHint
+}
+
+func (sv StatChangeValue) DocumentId() string {
+	return sv.documentid
+}
+
+func (sv StatChangeValue) Previous() document.UserStatistics {
+	return sv.previous
+}
+
+func (sv StatChangeValue) Statistics() document.UserStatistics {
+	return sv.statistics
+}
+
+func (sv StatChangeValue) Delta() document.StatDelta {
+	return document.NewStatDeltaBetween(sv.previous, sv.statistics)
+}
+
+func (sv StatChangeValue) Height() base.Height {
+	return sv.height
+}
@@ -0,0 +1,35 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (sv StatChangeValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sv.Hint()),
+		bson.M{
+			"documentid": sv.documentid,
+			"previous":   sv.previous,
+			"statistics": sv.statistics,
+			"height":     sv.height,
+		},
+	))
+}
+
+type StatChangeValueBSONUnpacker struct {
+	DI string      `bson:"documentid"`
+	PV bson.Raw    `bson:"previous"`
+	ST bson.Raw    `bson:"statistics"`
+	HT base.Height `bson:"height"`
+}
+
+func (sv *StatChangeValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usv StatChangeValueBSONUnpacker
+	if err := enc.Unmarshal(b, &usv); err != nil {
+		return err
+	}
+
+	return sv.unpack(enc, usv.DI, usv.PV, usv.ST, usv.HT)
+}
@@ -0,0 +1,46 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/document"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (sv *StatChangeValue) unpack(
+	enc encoder.Encoder,
+	di string,
+	bpv, bst []byte,
+	height base.Height,
+) error {
+	previous, err := decodeUserStatistics(bpv, enc)
+	if err != nil {
+		return err
+	}
+
+	statistics, err := decodeUserStatistics(bst, enc)
+	if err != nil {
+		return err
+	}
+
+	sv.documentid = di
+	sv.previous = previous
+	sv.statistics = statistics
+	sv.height = height
+
+	return nil
+}
+
+func decodeUserStatistics(b []byte, enc encoder.Encoder) (document.UserStatistics, error) {
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		return document.UserStatistics{}, err
+	}
+
+	us, ok := hinter.(document.UserStatistics)
+	if !ok {
+		return document.UserStatistics{}, errors.Errorf("not UserStatistics: %T", hinter)
+	}
+
+	return us, nil
+}
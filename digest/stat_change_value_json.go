@@ -0,0 +1,45 @@
+package digest
+
+import (
+	"encoding/json"
+
+	"github.com/protoconNet/mitum-document/document"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type StatChangeValueJSONPacker struct {
+	jsonenc.HintedHead
+	DI string                  `json:"documentid"`
+	PV document.UserStatistics `json:"previous"`
+	ST document.UserStatistics `json:"statistics"`
+	DT document.StatDelta      `json:"delta"`
+	HT base.Height             `json:"height"`
+}
+
+func (sv StatChangeValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(StatChangeValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sv.Hint()),
+		DI:         sv.documentid,
+		PV:         sv.previous,
+		ST:         sv.statistics,
+		DT:         sv.Delta(),
+		HT:         sv.height,
+	})
+}
+
+type StatChangeValueJSONUnpacker struct {
+	DI string          `json:"documentid"`
+	PV json.RawMessage `json:"previous"`
+	ST json.RawMessage `json:"statistics"`
+	HT base.Height     `json:"height"`
+}
+
+func (sv *StatChangeValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usv StatChangeValueJSONUnpacker
+	if err := enc.Unmarshal(b, &usv); err != nil {
+		return err
+	}
+
+	return sv.unpack(enc, usv.DI, usv.PV, usv.ST, usv.HT)
+}
@@ -2,6 +2,7 @@ package digest
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func IsDocumentState(st state.State) (document.DocumentData, bool, error) {
@@ -124,6 +126,70 @@ func stringDoctypeQuery(doctype string) string {
 	return fmt.Sprintf("doctype=%s", doctype)
 }
 
+// stringLastHeightQuery is used for the cache key of the documents, which are
+// shown by the last block height like the expiry of deadline.
+func stringLastHeightQuery(height base.Height) string {
+	return fmt.Sprintf("last=%d", height)
+}
+
+// parseCoordinatesQuery parses the comma separated coordinates; longitudes
+// and latitudes should be in range.
+func parseCoordinatesQuery(s string, n int) ([]float64, error) {
+	l := strings.Split(strings.TrimSpace(s), ",")
+	if len(l) != n {
+		return nil, errors.Errorf("%d comma separated values needed, %q", n, s)
+	}
+
+	fs := make([]float64, n)
+	for i := range l {
+		f, err := strconv.ParseFloat(strings.TrimSpace(l[i]), 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid coordinate, %q", l[i])
+		}
+
+		limit := 180.0 // longitude
+		if i%2 == 1 {
+			limit = 90 // latitude
+		}
+		if math.IsNaN(f) || f < -limit || f > limit {
+			return nil, errors.Errorf("coordinate out of range, %v", f)
+		}
+
+		fs[i] = f
+	}
+
+	return fs, nil
+}
+
+// parseDateRangeQuery parses since and until query of the document date. It
+// returns the filter and the query string with the normalized dates; without
+// both, the filter is empty.
+func parseDateRangeQuery(since, until string) (bson.D, string, error) {
+	s, err := document.ParseDocumentTime(strings.TrimSpace(since))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "invalid since")
+	}
+
+	u, err := document.ParseDocumentTime(strings.TrimSpace(until))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "invalid until")
+	}
+
+	if !s.IsZero() && !u.IsZero() && u.Before(s) {
+		return nil, "", errors.Errorf("until should not be before since")
+	}
+
+	var qs []string
+	if !s.IsZero() {
+		qs = append(qs, "since="+s.String())
+	}
+	if !u.IsZero() {
+		qs = append(qs, "until="+u.String())
+	}
+
+	return buildDateRangeFilter(s, u), strings.Join(qs, "&"), nil
+}
+
 func parseBoolQuery(s string) bool {
 	return s == "1"
 }
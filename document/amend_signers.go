@@ -0,0 +1,173 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AmendSignersFactType   = hint.Type("mitum-blocksign-amend-signers-operation-fact")
+	AmendSignersFactHint   = hint.NewHint(AmendSignersFactType, "v0.0.1")
+	AmendSignersFactHinter = AmendSignersFact{BaseHinter: hint.NewBaseHinter(AmendSignersFactHint)}
+	AmendSignersType       = hint.Type("mitum-blocksign-amend-signers-operation")
+	AmendSignersHint       = hint.NewHint(AmendSignersType, "v0.0.1")
+	AmendSignersHinter     = AmendSigners{BaseOperation: operationHinter(AmendSignersHint)}
+)
+
+var MaxAmendSignersItems uint = 10
+
+type AmendSignersItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Add() []DocSign
+	Remove() []base.Address
+	Currency() currency.CurrencyID
+	Rebuild() AmendSignersItem
+}
+
+type AmendSignersFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []AmendSignersItem
+}
+
+func NewAmendSignersFact(token []byte, sender base.Address, items []AmendSignersItem) AmendSignersFact {
+	fact := AmendSignersFact{
+		BaseHinter: hint.NewBaseHinter(AmendSignersFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AmendSignersFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AmendSignersFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AmendSignersFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact AmendSignersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for AmendSignersFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxAmendSignersItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxAmendSignersItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	docIdMap := map[string]bool{}
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		k := it.DocumentId()
+		if _, found := docIdMap[k]; found {
+			return errors.Errorf("duplicated document id, %s", k)
+		}
+		docIdMap[k] = true
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact AmendSignersFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AmendSignersFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AmendSignersFact) Items() []AmendSignersItem {
+	return fact.items
+}
+
+func (fact AmendSignersFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	// NOTE amended signers can find the operation in their history
+	for i := range fact.items {
+		it := fact.items[i]
+		for j := range it.Add() {
+			as = append(as, it.Add()[j].Address())
+		}
+
+		as = append(as, it.Remove()...)
+	}
+
+	return as, nil
+}
+
+func (fact AmendSignersFact) Rebuild() AmendSignersFact {
+	items := make([]AmendSignersItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type AmendSigners struct {
+	currency.BaseOperation
+}
+
+func NewAmendSigners(fact AmendSignersFact, fs []base.FactSign, memo string) (AmendSigners, error) {
+	bo, err := currency.NewBaseOperationFromFact(AmendSignersHint, fact, fs, memo)
+	if err != nil {
+		return AmendSigners{}, err
+	} else {
+
+		return AmendSigners{BaseOperation: bo}, nil
+	}
+}
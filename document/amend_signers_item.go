@@ -0,0 +1,124 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	AmendSignersItemImplType   = hint.Type("mitum-blocksign-amend-signers-item")
+	AmendSignersItemImplHint   = hint.NewHint(AmendSignersItemImplType, "v0.0.1")
+	AmendSignersItemImplHinter = AmendSignersItemImpl{BaseHinter: hint.NewBaseHinter(AmendSignersItemImplHint)}
+)
+
+// AmendSignersItemImpl adds new pending signers to the blocksign document and
+// removes signers, who have not signed.
+type AmendSignersItemImpl struct {
+	hint.BaseHinter
+	id     string
+	add    []DocSign
+	remove []base.Address
+	cid    currency.CurrencyID
+}
+
+func NewAmendSignersItemImpl(
+	id string,
+	add []DocSign,
+	remove []base.Address,
+	cid currency.CurrencyID) AmendSignersItemImpl {
+
+	return AmendSignersItemImpl{
+		BaseHinter: hint.NewBaseHinter(AmendSignersItemImplHint),
+		id:         id,
+		add:        add,
+		remove:     remove,
+		cid:        cid,
+	}
+}
+
+func (it AmendSignersItemImpl) Bytes() []byte {
+	bs := make([][]byte, len(it.add)+len(it.remove)+2)
+	bs[0] = []byte(it.id)
+	for i := range it.add {
+		bs[i+1] = it.add[i].Bytes()
+	}
+	for i := range it.remove {
+		bs[len(it.add)+i+1] = it.remove[i].Bytes()
+	}
+	bs[len(bs)-1] = it.cid.Bytes()
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it AmendSignersItemImpl) IsValid([]byte) error {
+	if _, ty, err := ParseDocId(it.id); err != nil {
+		return isvalid.InvalidError.Errorf("invalid AmendSignersItem: %w", err)
+	} else if ty != BSDocIdType {
+		return isvalid.InvalidError.Errorf("invalid AmendSignersItem: not blocksign document id, %q", it.id)
+	}
+
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid AmendSignersItem: %w", err)
+	}
+
+	if len(it.add) < 1 && len(it.remove) < 1 {
+		return isvalid.InvalidError.Errorf("invalid AmendSignersItem: empty signers to add and remove")
+	}
+
+	founds := map[string]struct{}{}
+	for i := range it.add {
+		ds := it.add[i]
+		if err := isvalid.Check(nil, false, ds, ds.Address()); err != nil {
+			return isvalid.InvalidError.Errorf("invalid AmendSignersItem: %w", err)
+		}
+
+		if ds.Status() != DocSignStatusPending {
+			return isvalid.InvalidError.Errorf("invalid AmendSignersItem: new signer should be pending, %q", ds.Address())
+		}
+
+		if _, found := founds[ds.Address().String()]; found {
+			return isvalid.InvalidError.Errorf("invalid AmendSignersItem: duplicated signer, %q", ds.Address())
+		}
+		founds[ds.Address().String()] = struct{}{}
+	}
+
+	for i := range it.remove {
+		if err := it.remove[i].IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid AmendSignersItem: %w", err)
+		}
+
+		if _, found := founds[it.remove[i].String()]; found {
+			return isvalid.InvalidError.Errorf("invalid AmendSignersItem: duplicated signer, %q", it.remove[i])
+		}
+		founds[it.remove[i].String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (it AmendSignersItemImpl) DocumentId() string {
+	return it.id
+}
+
+func (it AmendSignersItemImpl) Add() []DocSign {
+	return it.add
+}
+
+func (it AmendSignersItemImpl) Remove() []base.Address {
+	return it.remove
+}
+
+func (it AmendSignersItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it AmendSignersItemImpl) Rebuild() AmendSignersItem {
+	return it
+}
@@ -0,0 +1,35 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it AmendSignersItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.id,
+				"add":        it.add,
+				"remove":     it.remove,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type AmendSignersItemImplBSONUnpacker struct {
+	DI string                `bson:"documentid"`
+	AD bson.Raw              `bson:"add"`
+	RM []base.AddressDecoder `bson:"remove"`
+	CI string                `bson:"currency"`
+}
+
+func (it *AmendSignersItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uas AmendSignersItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &uas); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uas.DI, uas.AD, uas.RM, uas.CI)
+}
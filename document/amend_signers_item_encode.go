@@ -0,0 +1,48 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *AmendSignersItemImpl) unpack(
+	enc encoder.Encoder,
+	id string,
+	bad []byte,
+	brm []base.AddressDecoder,
+	cid string,
+) error {
+	hits, err := enc.DecodeSlice(bad)
+	if err != nil {
+		return err
+	}
+
+	add := make([]DocSign, len(hits))
+	for i := range hits {
+		s, ok := hits[i].(DocSign)
+		if !ok {
+			return errors.Errorf("not DocSign : %T", hits[i])
+		}
+
+		add[i] = s
+	}
+
+	remove := make([]base.Address, len(brm))
+	for i := range brm {
+		a, err := brm[i].Encode(enc)
+		if err != nil {
+			return err
+		}
+
+		remove[i] = a
+	}
+
+	it.id = id
+	it.add = add
+	it.remove = remove
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
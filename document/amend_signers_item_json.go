@@ -0,0 +1,43 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AmendSignersItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	AD []DocSign           `json:"add"`
+	RM []base.Address      `json:"remove"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it AmendSignersItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AmendSignersItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.id,
+		AD:         it.add,
+		RM:         it.remove,
+		CI:         it.cid,
+	})
+}
+
+type AmendSignersItemImplJSONUnpacker struct {
+	DI string                `json:"documentid"`
+	AD json.RawMessage       `json:"add"`
+	RM []base.AddressDecoder `json:"remove"`
+	CI string                `json:"currency"`
+}
+
+func (it *AmendSignersItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uas AmendSignersItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uas); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uas.DI, uas.AD, uas.RM, uas.CI)
+}
@@ -0,0 +1,296 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var AmendSignersItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AmendSignersItemProcessor)
+	},
+}
+
+var AmendSignersProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AmendSignersProcessor)
+	},
+}
+
+func (op AmendSigners) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type AmendSignersItemProcessor struct {
+	cp     *currency.CurrencyPool
+	h      valuehash.Hash
+	sender base.Address
+	item   AmendSignersItem
+	nds    state.State // amended document data state
+}
+
+func (opp *AmendSignersItemProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) error {
+
+	if err := opp.item.IsValid(nil); err != nil {
+		return operation.NewBaseReasonError(err.Error())
+	}
+
+	// check existence of new signer accounts
+	for i := range opp.item.Add() {
+		if err := checkExistsState(currency.StateKeyAccount(opp.item.Add()[i].Address()), getState); err != nil {
+			return err
+		}
+	}
+
+	st, err := existsState(StateKeyDocumentData(opp.item.DocumentId()), "document", getState)
+	if err != nil {
+		return err
+	}
+
+	doc, err := opp.amendSigners(st)
+	if err != nil {
+		return err
+	}
+
+	nst, err := SetStateDocumentDataValue(st, doc)
+	if err != nil {
+		return err
+	}
+
+	opp.nds = nst
+
+	return nil
+}
+
+// Process returns the document data state amended in PreProcess; the other
+// operations on the same document are not allowed in the same proposal, see
+// OperationProcessor.checkDocumentDuplication.
+func (opp *AmendSignersItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+
+	sts := make([]state.State, 1)
+	sts[0] = opp.nds
+
+	return sts, nil
+}
+
+// amendSigners returns new BSDocData from the document state, which signers
+// are added and removed by item. Signed signers can not be removed.
+func (opp *AmendSignersItemProcessor) amendSigners(st state.State) (BSDocData, error) {
+	dd, err := StateDocumentDataValue(st)
+	if err != nil {
+		return BSDocData{}, err
+	}
+
+	if IsArchivedDocumentData(dd) {
+		return BSDocData{}, operation.NewBaseReasonError("document already removed, %q", opp.item.DocumentId())
+	}
+
+	v, ok := dd.(BSDocData)
+	if !ok {
+		return BSDocData{}, operation.NewBaseReasonError("Document is not Blocksign Document, %v", opp.item.DocumentId())
+	}
+
+	if !v.Owner().Equal(opp.sender) {
+		return BSDocData{}, operation.NewBaseReasonError("sender not matched with owner in document, %v", opp.sender)
+	}
+
+	removes := map[string]struct{}{}
+	for i := range opp.item.Remove() {
+		removes[opp.item.Remove()[i].String()] = struct{}{}
+	}
+
+	signers := make([]DocSign, 0, len(v.Signers())+len(opp.item.Add()))
+	for i := range v.Signers() {
+		ds := v.Signers()[i]
+
+		if _, found := removes[ds.Address().String()]; !found {
+			signers = append(signers, ds)
+
+			continue
+		}
+
+		if ds.Signed() {
+			return BSDocData{}, operation.NewBaseReasonError("signed signer can not be removed, %v", ds.Address())
+		}
+
+		delete(removes, ds.Address().String())
+	}
+
+	if len(removes) > 0 {
+		for i := range opp.item.Remove() {
+			if _, found := removes[opp.item.Remove()[i].String()]; found {
+				return BSDocData{}, operation.NewBaseReasonError(
+					"signer to remove not found in document Signers, %v", opp.item.Remove()[i])
+			}
+		}
+	}
+
+	for i := range opp.item.Add() {
+		ds := opp.item.Add()[i]
+
+		for j := range signers {
+			if signers[j].Address().Equal(ds.Address()) {
+				return BSDocData{}, operation.NewBaseReasonError("signer already in document Signers, %v", ds.Address())
+			}
+		}
+
+		signers = append(signers, ds)
+	}
+
+	v.signers = signers
+
+	return v, nil
+}
+
+func (opp *AmendSignersItemProcessor) Close() error {
+	opp.cp = nil
+	opp.h = nil
+	opp.sender = nil
+	opp.item = nil
+	opp.nds = nil
+
+	AmendSignersItemProcessorPool.Put(opp)
+
+	return nil
+}
+
+type AmendSignersProcessor struct {
+	cp *currency.CurrencyPool
+	AmendSigners
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	ns       []*AmendSignersItemProcessor                 // ItemProcessor
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewAmendSignersProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(AmendSigners)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not AmendSigners, %T", op)
+		}
+
+		opp := AmendSignersProcessorPool.Get().(*AmendSignersProcessor)
+
+		opp.cp = cp
+		opp.AmendSigners = i
+		opp.sb = nil
+		opp.ns = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *AmendSignersProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AmendSignersFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	// prepare item processor for each items
+	ns := make([]*AmendSignersItemProcessor, len(fact.items))
+	for i := range fact.items {
+		c := AmendSignersItemProcessorPool.Get().(*AmendSignersItemProcessor)
+		c.cp = opp.cp
+		c.h = opp.Hash()
+		c.sender = fact.sender
+		c.item = fact.items[i]
+
+		if err := c.PreProcess(getState, setState); err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		ns[i] = c
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.ns = ns
+
+	return opp, nil
+}
+
+func (opp *AmendSignersProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	// get fact
+	fact := opp.Fact().(AmendSignersFact)
+
+	var sts []state.State // nolint:prealloc
+
+	// append amended document data state
+	for i := range opp.ns {
+		if s, err := opp.ns[i].Process(getState, setState); err != nil {
+			return operation.NewBaseReasonError("failed to process amend signers item: %w", err)
+		} else {
+			sts = append(sts, s...)
+		}
+	}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *AmendSignersProcessor) Close() error {
+	for i := range opp.ns {
+		_ = opp.ns[i].Close()
+	}
+
+	opp.cp = nil
+	opp.AmendSigners = AmendSigners{}
+	opp.sb = nil
+	opp.ns = nil
+	opp.required = nil
+
+	AmendSignersProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *AmendSignersProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(AmendSignersFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
@@ -0,0 +1,166 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CastVoteFactType   = hint.Type("mitum-cast-vote-operation-fact")
+	CastVoteFactHint   = hint.NewHint(CastVoteFactType, "v0.0.1")
+	CastVoteFactHinter = CastVoteFact{BaseHinter: hint.NewBaseHinter(CastVoteFactHint)}
+	CastVoteType       = hint.Type("mitum-cast-vote-operation")
+	CastVoteHint       = hint.NewHint(CastVoteType, "v0.0.1")
+	CastVoteHinter     = CastVote{BaseOperation: operationHinter(CastVoteHint)}
+)
+
+var MaxCastVoteItems uint = 10
+
+type CastVoteItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Candidate() base.Address
+	Currency() currency.CurrencyID
+	Rebuild() CastVoteItem
+}
+
+type CastVoteFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []CastVoteItem
+}
+
+func NewCastVoteFact(token []byte, sender base.Address, items []CastVoteItem) CastVoteFact {
+	fact := CastVoteFact{
+		BaseHinter: hint.NewBaseHinter(CastVoteFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CastVoteFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CastVoteFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CastVoteFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact CastVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for CastVoteFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxCastVoteItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxCastVoteItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CastVoteFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CastVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CastVoteFact) Items() []CastVoteItem {
+	return fact.items
+}
+
+func (fact CastVoteFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	// NOTE candidates can find the votes in their history
+	for i := range fact.items {
+		as = append(as, fact.items[i].Candidate())
+	}
+
+	return as, nil
+}
+
+func (fact CastVoteFact) Rebuild() CastVoteFact {
+	items := make([]CastVoteItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type CastVote struct {
+	currency.BaseOperation
+}
+
+func NewCastVote(fact CastVoteFact, fs []base.FactSign, memo string) (CastVote, error) {
+	bo, err := currency.NewBaseOperationFromFact(CastVoteHint, fact, fs, memo)
+	if err != nil {
+		return CastVote{}, err
+	} else {
+
+		return CastVote{BaseOperation: bo}, nil
+	}
+}
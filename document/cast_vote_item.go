@@ -0,0 +1,77 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	CastVoteItemImplType   = hint.Type("mitum-cast-vote-item")
+	CastVoteItemImplHint   = hint.NewHint(CastVoteItemImplType, "v0.0.1")
+	CastVoteItemImplHinter = CastVoteItemImpl{BaseHinter: hint.NewBaseHinter(CastVoteItemImplHint)}
+)
+
+// CastVoteItemImpl votes for the candidate of the blockcity voting document.
+type CastVoteItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	candidate  base.Address
+	cid        currency.CurrencyID
+}
+
+func NewCastVoteItemImpl(
+	documentid string,
+	candidate base.Address,
+	cid currency.CurrencyID) CastVoteItemImpl {
+
+	return CastVoteItemImpl{
+		BaseHinter: hint.NewBaseHinter(CastVoteItemImplHint),
+		documentid: documentid,
+		candidate:  candidate,
+		cid:        cid,
+	}
+}
+
+func (it CastVoteItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.candidate.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it CastVoteItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.candidate,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid CastVoteItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid CastVoteItem: empty documentid")
+	}
+
+	return nil
+}
+
+func (it CastVoteItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it CastVoteItemImpl) Candidate() base.Address {
+	return it.candidate
+}
+
+func (it CastVoteItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it CastVoteItemImpl) Rebuild() CastVoteItem {
+	return it
+}
@@ -0,0 +1,33 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it CastVoteItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"candidate":  it.candidate,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type CastVoteItemImplBSONUnpacker struct {
+	DI string              `bson:"documentid"`
+	CD base.AddressDecoder `bson:"candidate"`
+	CI string              `bson:"currency"`
+}
+
+func (it *CastVoteItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ucv CastVoteItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.CD, ucv.CI)
+}
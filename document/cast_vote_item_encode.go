@@ -0,0 +1,25 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *CastVoteItemImpl) unpack(
+	enc encoder.Encoder,
+	di string,
+	bcd base.AddressDecoder,
+	cid string,
+) error {
+	a, err := bcd.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	it.documentid = di
+	it.candidate = a
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type CastVoteItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	CD base.Address        `json:"candidate"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it CastVoteItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CastVoteItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		CD:         it.candidate,
+		CI:         it.cid,
+	})
+}
+
+type CastVoteItemImplJSONUnpacker struct {
+	DI string              `json:"documentid"`
+	CD base.AddressDecoder `json:"candidate"`
+	CI string              `json:"currency"`
+}
+
+func (it *CastVoteItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ucv CastVoteItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.CD, ucv.CI)
+}
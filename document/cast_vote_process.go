@@ -0,0 +1,256 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var CastVoteProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CastVoteProcessor)
+	},
+}
+
+func (op CastVote) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type CastVoteProcessor struct {
+	cp *currency.CurrencyPool
+	CastVote
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+	nsts     []state.State                                // voting document and voting box states with vote
+}
+
+func NewCastVoteProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(CastVote)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not CastVote, %T", op)
+		}
+
+		opp := CastVoteProcessorPool.Get().(*CastVoteProcessor)
+
+		opp.cp = cp
+		opp.CastVote = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+		opp.nsts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *CastVoteProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CastVoteProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CastVoteFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	sts, err := opp.castVote(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.nsts = sts
+
+	return opp, nil
+}
+
+// pooledStates returns the voting document and voting box states with the
+// votes; the next votes in the same proposal are counted over them.
+func (opp *CastVoteProcessor) pooledStates() []state.State {
+	return opp.nsts
+}
+
+// Process sets the states with the votes counted in PreProcess; the votes on
+// the same voting document in the same proposal are merged into the pooled
+// document, see OperationProcessor.setState.
+func (opp *CastVoteProcessor) Process( // nolint:dupl
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CastVoteFact)
+
+	sts := make([]state.State, len(opp.nsts), len(opp.nsts)+len(opp.required))
+	copy(sts, opp.nsts)
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// castVote returns the voting document states and the voting box states, which
+// the votes of sender are applied to.
+func (opp *CastVoteProcessor) castVote(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(CastVoteFact)
+
+	var sts []state.State
+	for i := range fact.items {
+		it := fact.items[i]
+
+		dst, doc, err := loadVotingDocument(it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		if phase := doc.Phase(opp.height); phase != VotingPhaseVoting {
+			return nil, operation.NewBaseReasonError("votes not accepted in %s phase, %q", phase, it.DocumentId())
+		}
+
+		if _, found := doc.Candidate(it.Candidate()); !found {
+			return nil, operation.NewBaseReasonError("candidate not found in document, %q", it.Candidate())
+		}
+
+		bst, box, err := loadVotingBox(doc, getState)
+		if err != nil {
+			return nil, err
+		}
+
+		if box.HasVoted(fact.sender) {
+			return nil, operation.NewBaseReasonError("sender already voted, %v; %q", fact.sender, it.DocumentId())
+		}
+
+		weight, err := votingWeight(doc, fact.sender, getState)
+		if err != nil {
+			return nil, err
+		}
+
+		ndoc, err := doc.addVote(it.Candidate(), weight)
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		nst, err := SetStateDocumentDataValue(dst, ndoc)
+		if err != nil {
+			return nil, err
+		}
+
+		nbst, err := SetStateVotingBoxValue(bst, box.Vote(fact.sender))
+		if err != nil {
+			return nil, err
+		}
+
+		sts = append(sts, nst, nbst)
+	}
+
+	return sts, nil
+}
+
+func (opp *CastVoteProcessor) Close() error {
+	opp.cp = nil
+	opp.CastVote = CastVote{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+	opp.nsts = nil
+
+	CastVoteProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *CastVoteProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(CastVoteFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
+
+// loadVotingDocument returns the state and the blockcity voting document of
+// documentid.
+func loadVotingDocument(
+	documentid string,
+	getState func(key string) (state.State, bool, error),
+) (state.State, BCVotingData, error) {
+	st, err := existsState(StateKeyDocumentData(documentid), "document", getState)
+	if err != nil {
+		return nil, BCVotingData{}, err
+	}
+
+	dd, err := StateDocumentDataValue(st)
+	if err != nil {
+		return nil, BCVotingData{}, err
+	}
+
+	if IsArchivedDocumentData(dd) {
+		return nil, BCVotingData{}, operation.NewBaseReasonError("document already removed, %q", documentid)
+	}
+
+	doc, ok := dd.(BCVotingData)
+	if !ok {
+		return nil, BCVotingData{}, operation.NewBaseReasonError("not voting document, %q", documentid)
+	}
+
+	return st, doc, nil
+}
+
+// loadVotingBox returns the state and the voting box of the current round of
+// voting document. The voters of the previous round can vote again, so the new
+// voting box is returned when the round is changed.
+func loadVotingBox(
+	doc BCVotingData,
+	getState func(key string) (state.State, bool, error),
+) (state.State, VotingBox, error) {
+	st, found, err := getState(StateKeyVotingBox(doc.DocumentId()))
+	if err != nil {
+		return nil, VotingBox{}, err
+	}
+
+	if found {
+		box, err := StateVotingBoxValue(st)
+		if err != nil {
+			return nil, VotingBox{}, err
+		}
+
+		if box.Round() == doc.Round() {
+			return st, box, nil
+		}
+	}
+
+	return st, NewVotingBox(doc.Round(), nil), nil
+}
@@ -0,0 +1,241 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCastVoteProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testCastVoteProcessor) TestVotersInSameBlock() {
+	owner, sts0 := t.newAccount()
+	voter0, sts1 := t.newAccount()
+	voter1, sts2 := t.newAccount()
+	candidate, sts3 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	pool, opr := t.statepool(sts0, sts1, sts2, sts3, t.newDocumentStates(owner.Address(), doc))
+
+	// all the votes in the block are counted
+	errs := t.process(opr,
+		t.newCastVote(voter0, doc.DocumentId(), candidate.Address()),
+		t.newCastVote(voter1, doc.DocumentId(), candidate.Address()),
+	)
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+
+	voted := t.updatedDocument(pool, doc.DocumentId()).(BCVotingData)
+	vc, found := voted.Candidate(candidate.Address())
+	t.True(found)
+	t.Equal(uint(2), vc.Count())
+
+	st, found := t.updated(pool, StateKeyVotingBox(doc.DocumentId()))
+	t.True(found)
+
+	box, err := StateVotingBoxValue(st)
+	t.NoError(err)
+	t.True(box.HasVoted(voter0.Address()))
+	t.True(box.HasVoted(voter1.Address()))
+}
+
+func (t *testCastVoteProcessor) TestVoteAndUpdateInSameBlock() {
+	owner, sts0 := t.newAdmin()
+	voter, sts1 := t.newAccount()
+	candidate, sts2 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	_, opr := t.statepool(sts0, sts1, sts2, t.newDocumentStates(owner.Address(), doc))
+
+	vote := t.newCastVote(voter, doc.DocumentId(), candidate.Address())
+
+	// the other operation on the voting document is not allowed with the votes
+	ppr, err := opr.PreProcess(vote)
+	t.NoError(err)
+	t.NoError(opr.Process(ppr))
+
+	items := []UpdateDocumentsItem{NewUpdateDocumentsItemImpl(doc, t.cid)}
+	fact := NewUpdateDocumentsFact(util.UUID().Bytes(), owner.Address(), items)
+
+	op, err := NewUpdateDocuments(fact, t.newFactSigns(fact, owner.Privs()...), "")
+	t.NoError(err)
+
+	_, err = opr.PreProcess(op)
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated document")
+}
+
+func (t *testCastVoteProcessor) TestVoteTwice() {
+	owner, sts0 := t.newAccount()
+	voter, sts1 := t.newAccount()
+	candidate, sts2 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	pool, opr := t.statepool(sts0, sts1, sts2, dsts)
+
+	errs := t.process(opr, t.newCastVote(voter, doc.DocumentId(), candidate.Address()))
+	t.NoError(errs[0])
+
+	_, opr = t.nextStatepool(pool, sts0, sts1, sts2, dsts)
+
+	errs = t.process(opr, t.newCastVote(voter, doc.DocumentId(), candidate.Address()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender already voted")
+}
+
+func (t *testCastVoteProcessor) TestVoteAfterDeadline() {
+	owner, sts0 := t.newAccount()
+	voter, sts1 := t.newAccount()
+	candidate, sts2 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	_, opr := t.statepoolAt(base.Height(34), sts0, sts1, sts2, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newCastVote(voter, doc.DocumentId(), candidate.Address()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "votes not accepted")
+}
+
+// newWeightedBCVotingData returns the voting document weighted by weight,
+// which round opened at 10.
+func (t *testCastVoteProcessor) newWeightedBCVotingData(
+	owner base.Address, weight VotingWeight, candidates ...base.Address,
+) BCVotingData {
+	return t.newBCVotingData(owner, "1cvi", base.Height(33), candidates...).
+		WithWeight(weight, t.cid).
+		withSnapshot(base.Height(10))
+}
+
+func (t *testCastVoteProcessor) TestBalanceWeightedVote() {
+	owner, sts0 := t.newAccount()
+	voter0, sts1 := t.newAccount()
+	voter1, sts2 := t.newAccount()
+	candidate, sts3 := t.newAccount()
+
+	doc := t.newWeightedBCVotingData(owner.Address(), VotingWeightBalance, candidate.Address())
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	// the balance of voter1 changed after the round opened; the balance at
+	// the snapshot is kept as checkpoint
+	changed := t.newBalanceState(voter1.Address(), currency.NewBig(500), t.cid).SetHeight(base.Height(15))
+
+	checkpoint := t.newBalanceState(voter1.Address(), currency.NewBig(200), t.cid)
+	cst, err := state.NewStateV0(StateKeyVotingCheckpoint(checkpoint.Key(), doc.Snapshot()), checkpoint.Value(), base.NilHeight)
+	t.NoError(err)
+
+	pool, opr := t.statepoolAt(base.Height(20), sts0, sts1, sts2, sts3, dsts, []state.State{changed, cst})
+
+	errs := t.process(opr,
+		t.newCastVote(voter0, doc.DocumentId(), candidate.Address()),
+		t.newCastVote(voter1, doc.DocumentId(), candidate.Address()),
+	)
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+
+	vc, _ := t.updatedDocument(pool, doc.DocumentId()).(BCVotingData).Candidate(candidate.Address())
+	t.Equal(uint(1000+200), vc.Count())
+}
+
+func (t *testCastVoteProcessor) TestBalanceCreditedAfterRoundOpened() {
+	owner, sts0 := t.newAdmin()
+	voter, sts1 := t.newAccount()
+	sender, sts2 := t.newAccount()
+	candidate, sts3 := t.newAccount()
+
+	// the weighted voting without deadline is started at 10
+	old := t.newBCVotingData(owner.Address(), "1cvi", base.Height(0), candidate.Address()).
+		WithWeight(VotingWeightBalance, t.cid)
+
+	pool, opr := t.statepoolAt(base.Height(10), sts0, sts1, sts2, sts3, t.newDocumentStates(owner.Address(), old))
+
+	items := []UpdateDocumentsItem{NewUpdateDocumentsItemImpl(old.WithDeadline(base.Height(33)), t.cid)}
+	ufact := NewUpdateDocumentsFact(util.UUID().Bytes(), owner.Address(), items)
+
+	update, err := NewUpdateDocuments(ufact, t.newFactSigns(ufact, owner.Privs()...), "")
+	t.NoError(err)
+
+	// the third party credits voter in the block, in which the round opened
+	titems := []currency.TransfersItem{
+		currency.NewTransfersItemSingleAmount(voter.Address(), currency.NewAmount(currency.NewBig(1), t.cid)),
+	}
+	tfact := currency.NewTransfersFact(util.UUID().Bytes(), sender.Address(), titems)
+
+	transfers, err := currency.NewTransfers(tfact, t.newFactSigns(tfact, sender.Privs()...), "")
+	t.NoError(err)
+
+	errs := t.process(opr, update, transfers)
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+	t.NoError(opr.Close())
+
+	st, found := t.updated(pool, StateKeyVotingSnapshots)
+	t.True(found)
+
+	snapshots, err := StateVotingSnapshotsValue(st)
+	t.NoError(err)
+	t.Equal([]base.Height{10}, snapshots.Heights())
+	t.Equal([]base.Height{33}, snapshots.Untils())
+
+	st, found = t.updated(pool, StateKeyVotingCheckpoint(currency.StateKeyBalance(voter.Address(), t.cid), base.Height(10)))
+	t.True(found)
+
+	am, err := currency.StateBalanceValue(st)
+	t.NoError(err)
+	t.Equal(currency.NewBig(1000), am.Big())
+
+	// the credit does not lock voter out; the balance at the snapshot is the
+	// weight
+	pool, opr = t.nextStatepool(pool, sts0, sts1, sts2, sts3)
+
+	errs = t.process(opr, t.newCastVote(voter, old.DocumentId(), candidate.Address()))
+	t.NoError(errs[0])
+
+	vc, _ := t.updatedDocument(pool, old.DocumentId()).(BCVotingData).Candidate(candidate.Address())
+	t.Equal(uint(1000), vc.Count())
+}
+
+func (t *testCastVoteProcessor) TestGoldWeightedVote() {
+	owner, sts0 := t.newAccount()
+	voter0, sts1 := t.newAccount()
+	voter1, sts2 := t.newAccount()
+	candidate, sts3 := t.newAccount()
+
+	doc := t.newWeightedBCVotingData(owner.Address(), VotingWeightGold, candidate.Address())
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	// the gold of all the user documents of voter0 is counted
+	usts := t.newDocumentStates(voter0.Address(),
+		t.newBCUserData(voter0.Address(), "1cui", 30, 100),
+		t.newBCUserData(voter0.Address(), "2cui", 20, 100),
+	)
+
+	pool, opr := t.statepoolAt(base.Height(20), sts0, sts1, sts2, sts3, dsts, usts)
+
+	errs := t.process(opr, t.newCastVote(voter0, doc.DocumentId(), candidate.Address()))
+	t.NoError(errs[0])
+
+	vc, _ := t.updatedDocument(pool, doc.DocumentId()).(BCVotingData).Candidate(candidate.Address())
+	t.Equal(uint(50), vc.Count())
+
+	// voter1 has no user document
+	_, opr = t.statepoolAt(base.Height(20), sts0, sts1, sts2, sts3, dsts, usts)
+
+	errs = t.process(opr, t.newCastVote(voter1, doc.DocumentId(), candidate.Address()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "voter has no voting weight")
+}
+
+func TestCastVoteProcessor(t *testing.T) {
+	suite.Run(t, new(testCastVoteProcessor))
+}
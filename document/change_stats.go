@@ -0,0 +1,163 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ChangeStatsFactType   = hint.Type("mitum-change-stats-operation-fact")
+	ChangeStatsFactHint   = hint.NewHint(ChangeStatsFactType, "v0.0.1")
+	ChangeStatsFactHinter = ChangeStatsFact{BaseHinter: hint.NewBaseHinter(ChangeStatsFactHint)}
+	ChangeStatsType       = hint.Type("mitum-change-stats-operation")
+	ChangeStatsHint       = hint.NewHint(ChangeStatsType, "v0.0.1")
+	ChangeStatsHinter     = ChangeStats{BaseOperation: operationHinter(ChangeStatsHint)}
+)
+
+var MaxChangeStatsItems uint = 10
+
+type ChangeStatsItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Delta() StatDelta
+	Currency() currency.CurrencyID
+	Rebuild() ChangeStatsItem
+}
+
+// ChangeStatsFact changes the statistics of blockcity user documents by the
+// signed deltas; the sender should have the blockcity admin permission.
+type ChangeStatsFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []ChangeStatsItem
+}
+
+func NewChangeStatsFact(token []byte, sender base.Address, items []ChangeStatsItem) ChangeStatsFact {
+	fact := ChangeStatsFact{
+		BaseHinter: hint.NewBaseHinter(ChangeStatsFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ChangeStatsFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ChangeStatsFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ChangeStatsFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact ChangeStatsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for ChangeStatsFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxChangeStatsItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxChangeStatsItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact ChangeStatsFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ChangeStatsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ChangeStatsFact) Items() []ChangeStatsItem {
+	return fact.items
+}
+
+func (fact ChangeStatsFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact ChangeStatsFact) Rebuild() ChangeStatsFact {
+	items := make([]ChangeStatsItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type ChangeStats struct {
+	currency.BaseOperation
+}
+
+func NewChangeStats(fact ChangeStatsFact, fs []base.FactSign, memo string) (ChangeStats, error) {
+	bo, err := currency.NewBaseOperationFromFact(ChangeStatsHint, fact, fs, memo)
+	if err != nil {
+		return ChangeStats{}, err
+	} else {
+
+		return ChangeStats{BaseOperation: bo}, nil
+	}
+}
@@ -0,0 +1,77 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	ChangeStatsItemImplType   = hint.Type("mitum-change-stats-item")
+	ChangeStatsItemImplHint   = hint.NewHint(ChangeStatsItemImplType, "v0.0.1")
+	ChangeStatsItemImplHinter = ChangeStatsItemImpl{BaseHinter: hint.NewBaseHinter(ChangeStatsItemImplHint)}
+)
+
+// ChangeStatsItemImpl changes the statistics of the blockcity user document by
+// the delta.
+type ChangeStatsItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	delta      StatDelta
+	cid        currency.CurrencyID
+}
+
+func NewChangeStatsItemImpl(
+	documentid string,
+	delta StatDelta,
+	cid currency.CurrencyID) ChangeStatsItemImpl {
+
+	return ChangeStatsItemImpl{
+		BaseHinter: hint.NewBaseHinter(ChangeStatsItemImplHint),
+		documentid: documentid,
+		delta:      delta,
+		cid:        cid,
+	}
+}
+
+func (it ChangeStatsItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.delta.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it ChangeStatsItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.delta,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid ChangeStatsItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid ChangeStatsItem: empty documentid")
+	}
+
+	return nil
+}
+
+func (it ChangeStatsItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it ChangeStatsItemImpl) Delta() StatDelta {
+	return it.delta
+}
+
+func (it ChangeStatsItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it ChangeStatsItemImpl) Rebuild() ChangeStatsItem {
+	return it
+}
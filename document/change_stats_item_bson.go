@@ -0,0 +1,32 @@
+package document // nolint:dupl
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it ChangeStatsItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"delta":      it.delta,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type ChangeStatsItemImplBSONUnpacker struct {
+	DI string   `bson:"documentid"`
+	DT bson.Raw `bson:"delta"`
+	CI string   `bson:"currency"`
+}
+
+func (it *ChangeStatsItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u ChangeStatsItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.DT, u.CI)
+}
@@ -0,0 +1,30 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *ChangeStatsItemImpl) unpack(
+	enc encoder.Encoder,
+	di string,
+	bdt []byte,
+	cid string,
+) error {
+	hinter, err := enc.Decode(bdt)
+	if err != nil {
+		return err
+	}
+
+	delta, ok := hinter.(StatDelta)
+	if !ok {
+		return util.WrongTypeError.Errorf("expected StatDelta, not %T", hinter)
+	}
+
+	it.documentid = di
+	it.delta = delta
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
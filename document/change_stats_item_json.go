@@ -0,0 +1,39 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type ChangeStatsItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	DT StatDelta           `json:"delta"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it ChangeStatsItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ChangeStatsItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		DT:         it.delta,
+		CI:         it.cid,
+	})
+}
+
+type ChangeStatsItemImplJSONUnpacker struct {
+	DI string          `json:"documentid"`
+	DT json.RawMessage `json:"delta"`
+	CI string          `json:"currency"`
+}
+
+func (it *ChangeStatsItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var u ChangeStatsItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.DT, u.CI)
+}
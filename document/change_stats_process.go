@@ -0,0 +1,210 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var ChangeStatsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ChangeStatsProcessor)
+	},
+}
+
+func (op ChangeStats) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type ChangeStatsProcessor struct {
+	cp *currency.CurrencyPool
+	ChangeStats
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewChangeStatsProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(ChangeStats)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not ChangeStats, %T", op)
+		}
+
+		opp := ChangeStatsProcessorPool.Get().(*ChangeStatsProcessor)
+
+		opp.cp = cp
+		opp.ChangeStats = i
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *ChangeStatsProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ChangeStatsFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkAccountPermission(fact.sender, extension.BlockcityAdmin, getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if _, err := opp.changeStats(getState); err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *ChangeStatsProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ChangeStatsFact)
+
+	sts, err := opp.changeStats(getState)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process change stats: %w", err)
+	}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// changeStats returns the user document states, which statistics are changed
+// by the deltas and checked by the stat policy.
+func (opp *ChangeStatsProcessor) changeStats(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(ChangeStatsFact)
+
+	sts := make([]state.State, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+
+		st, err := existsState(StateKeyDocumentData(it.DocumentId()), "document", getState)
+		if err != nil {
+			return nil, err
+		}
+
+		dd, err := StateDocumentDataValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		if IsArchivedDocumentData(dd) {
+			return nil, operation.NewBaseReasonError("document already removed, %q", it.DocumentId())
+		}
+
+		doc, ok := dd.(BCUserData)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not user document, %q", it.DocumentId())
+		}
+
+		ndoc, err := doc.changeStatistics(it.Delta())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		if err := checkStatPolicy(ndoc, getState); err != nil {
+			return nil, err
+		}
+
+		if sts[i], err = SetStateDocumentDataValue(st, ndoc); err != nil {
+			return nil, err
+		}
+	}
+
+	return sts, nil
+}
+
+func (opp *ChangeStatsProcessor) Close() error {
+	opp.cp = nil
+	opp.ChangeStats = ChangeStats{}
+	opp.sb = nil
+	opp.required = nil
+
+	ChangeStatsProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *ChangeStatsProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(ChangeStatsFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
+
+// loadStatPolicy returns the stat policy; without the policy, the statistics
+// are not bounded.
+func loadStatPolicy(getState func(key string) (state.State, bool, error)) (StatPolicy, bool, error) {
+	switch st, found, err := getState(StateKeyStatPolicy); {
+	case err != nil:
+		return StatPolicy{}, false, err
+	case !found:
+		return StatPolicy{}, false, nil
+	default:
+		policy, err := StateStatPolicyValue(st)
+		if err != nil {
+			return StatPolicy{}, false, err
+		}
+
+		return policy, true, nil
+	}
+}
+
+// checkStatPolicy checks the statistics of user document by the stat policy,
+// when the policy is set.
+func checkStatPolicy(doc BCUserData, getState func(key string) (state.State, bool, error)) error {
+	policy, found, err := loadStatPolicy(getState)
+	switch {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	}
+
+	if err := policy.Check(doc.Statistics()); err != nil {
+		return operation.NewBaseReasonError("statistics not allowed by stat policy, %q: %w", doc.DocumentId(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testChangeStatsProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testChangeStatsProcessor) newChangeStats(sender testAccount, documentid string, delta StatDelta) ChangeStats {
+	items := []ChangeStatsItem{NewChangeStatsItemImpl(documentid, delta, t.cid)}
+	fact := NewChangeStatsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewChangeStats(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testChangeStatsProcessor) newUpdateStatPolicy(sender testAccount, policy StatPolicy) UpdateStatPolicy {
+	fact := NewUpdateStatPolicyFact(util.UUID().Bytes(), sender.Address(), policy, t.cid)
+
+	op, err := NewUpdateStatPolicy(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testChangeStatsProcessor) TestChangeStats() {
+	admin, sts0 := t.newAdmin()
+	owner, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+
+	pool, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newChangeStats(admin, doc.DocumentId(), NewStatDelta(2, 0, 0, 0, 0, 0, -1)))
+	t.NoError(errs[0])
+
+	changed := t.updatedDocument(pool, doc.DocumentId()).(BCUserData)
+	t.True(MustNewUserStatistics(3, 1, 1, 1, 1, 1, 0).Equal(changed.Statistics()))
+}
+
+func (t *testChangeStatsProcessor) TestChangeStatsByNotAdmin() {
+	owner, sts0 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+
+	_, opr := t.statepool(sts0, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newChangeStats(owner, doc.DocumentId(), NewStatDelta(1, 0, 0, 0, 0, 0, 0)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "has no permission")
+}
+
+func (t *testChangeStatsProcessor) TestChangeStatsUnderZero() {
+	admin, sts0 := t.newAdmin()
+	owner, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+
+	_, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newChangeStats(admin, doc.DocumentId(), NewStatDelta(-2, 0, 0, 0, 0, 0, 0)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "hp under zero, 1 - 2")
+}
+
+func (t *testChangeStatsProcessor) TestChangeStatsInSameBlock() {
+	admin0, sts0 := t.newAdmin()
+	admin1, sts1 := t.newAdmin()
+	owner, sts2 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+
+	pool, opr := t.statepool(sts0, sts1, sts2, t.newDocumentStates(owner.Address(), doc))
+
+	// the change of admin1 is rejected instead of overwriting the change of
+	// admin0
+	errs := t.process(opr,
+		t.newChangeStats(admin0, doc.DocumentId(), NewStatDelta(1, 0, 0, 0, 0, 0, 0)),
+		t.newChangeStats(admin1, doc.DocumentId(), NewStatDelta(0, 1, 0, 0, 0, 0, 0)),
+	)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document")
+
+	changed := t.updatedDocument(pool, doc.DocumentId()).(BCUserData)
+	t.True(MustNewUserStatistics(2, 1, 1, 1, 1, 1, 1).Equal(changed.Statistics()))
+}
+
+func (t *testChangeStatsProcessor) TestStatPolicy() {
+	admin, sts0 := t.newAdmin()
+	owner, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	policy := NewStatPolicy(
+		MustNewUserStatistics(1, 1, 1, 1, 1, 1, 1),
+		MustNewUserStatistics(5, 5, 5, 5, 5, 5, 5),
+		10,
+	)
+
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	// the second policy update is rejected in the same block
+	errs := t.process(opr, t.newUpdateStatPolicy(admin, policy), t.newUpdateStatPolicy(admin, policy))
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated stat policy update")
+
+	st, found := t.updated(pool, StateKeyStatPolicy)
+	t.True(found)
+
+	updated, err := StateStatPolicyValue(st)
+	t.NoError(err)
+	t.True(policy.Equal(updated))
+
+	// the statistics are checked by the policy in the next block
+	_, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newChangeStats(admin, doc.DocumentId(), NewStatDelta(5, 0, 0, 0, 0, 0, 0)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "hp over max, 6 > 5")
+
+	_, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newChangeStats(admin, doc.DocumentId(), NewStatDelta(2, 2, 0, 0, 0, 0, 0)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sum of statistics over budget, 11 > 10")
+}
+
+func TestChangeStatsProcessor(t *testing.T) {
+	suite.Run(t, new(testChangeStatsProcessor))
+}
@@ -0,0 +1,162 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CloseVotingFactType   = hint.Type("mitum-close-voting-operation-fact")
+	CloseVotingFactHint   = hint.NewHint(CloseVotingFactType, "v0.0.1")
+	CloseVotingFactHinter = CloseVotingFact{BaseHinter: hint.NewBaseHinter(CloseVotingFactHint)}
+	CloseVotingType       = hint.Type("mitum-close-voting-operation")
+	CloseVotingHint       = hint.NewHint(CloseVotingType, "v0.0.1")
+	CloseVotingHinter     = CloseVoting{BaseOperation: operationHinter(CloseVotingHint)}
+)
+
+var MaxCloseVotingItems uint = 10
+
+type CloseVotingItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	NextDeadline() base.Height
+	OpensNextRound() bool
+	Currency() currency.CurrencyID
+	Rebuild() CloseVotingItem
+}
+
+type CloseVotingFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []CloseVotingItem
+}
+
+func NewCloseVotingFact(token []byte, sender base.Address, items []CloseVotingItem) CloseVotingFact {
+	fact := CloseVotingFact{
+		BaseHinter: hint.NewBaseHinter(CloseVotingFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CloseVotingFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CloseVotingFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CloseVotingFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact CloseVotingFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for CloseVotingFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxCloseVotingItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxCloseVotingItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CloseVotingFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CloseVotingFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CloseVotingFact) Items() []CloseVotingItem {
+	return fact.items
+}
+
+func (fact CloseVotingFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact CloseVotingFact) Rebuild() CloseVotingFact {
+	items := make([]CloseVotingItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type CloseVoting struct {
+	currency.BaseOperation
+}
+
+func NewCloseVoting(fact CloseVotingFact, fs []base.FactSign, memo string) (CloseVoting, error) {
+	bo, err := currency.NewBaseOperationFromFact(CloseVotingHint, fact, fs, memo)
+	if err != nil {
+		return CloseVoting{}, err
+	} else {
+
+		return CloseVoting{BaseOperation: bo}, nil
+	}
+}
@@ -0,0 +1,82 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	CloseVotingItemImplType   = hint.Type("mitum-close-voting-item")
+	CloseVotingItemImplHint   = hint.NewHint(CloseVotingItemImplType, "v0.0.1")
+	CloseVotingItemImplHinter = CloseVotingItemImpl{BaseHinter: hint.NewBaseHinter(CloseVotingItemImplHint)}
+)
+
+// CloseVotingItemImpl closes the current round of the blockcity voting
+// document; with the next deadline, the next round is opened.
+type CloseVotingItemImpl struct {
+	hint.BaseHinter
+	documentid   string
+	nextDeadline base.Height // the next round is not opened when not over zero
+	cid          currency.CurrencyID
+}
+
+func NewCloseVotingItemImpl(
+	documentid string,
+	nextDeadline base.Height,
+	cid currency.CurrencyID) CloseVotingItemImpl {
+
+	return CloseVotingItemImpl{
+		BaseHinter:   hint.NewBaseHinter(CloseVotingItemImplHint),
+		documentid:   documentid,
+		nextDeadline: nextDeadline,
+		cid:          cid,
+	}
+}
+
+func (it CloseVotingItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.nextDeadline.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it CloseVotingItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid CloseVotingItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid CloseVotingItem: empty documentid")
+	}
+
+	return nil
+}
+
+func (it CloseVotingItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it CloseVotingItemImpl) NextDeadline() base.Height {
+	return it.nextDeadline
+}
+
+// OpensNextRound checks whether the next round is opened after closing.
+func (it CloseVotingItemImpl) OpensNextRound() bool {
+	return it.nextDeadline > base.Height(0)
+}
+
+func (it CloseVotingItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it CloseVotingItemImpl) Rebuild() CloseVotingItem {
+	return it
+}
@@ -0,0 +1,33 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it CloseVotingItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid":    it.documentid,
+				"next_deadline": it.nextDeadline,
+				"currency":      it.cid,
+			}),
+	)
+}
+
+type CloseVotingItemImplBSONUnpacker struct {
+	DI string      `bson:"documentid"`
+	ND base.Height `bson:"next_deadline"`
+	CI string      `bson:"currency"`
+}
+
+func (it *CloseVotingItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ucv CloseVotingItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.ND, ucv.CI)
+}
@@ -0,0 +1,20 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *CloseVotingItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	nd base.Height,
+	cid string,
+) error {
+	it.documentid = di
+	it.nextDeadline = nd
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
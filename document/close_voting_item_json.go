@@ -0,0 +1,38 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type CloseVotingItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	ND base.Height         `json:"next_deadline,omitempty"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it CloseVotingItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CloseVotingItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		ND:         it.nextDeadline,
+		CI:         it.cid,
+	})
+}
+
+type CloseVotingItemImplJSONUnpacker struct {
+	DI string      `json:"documentid"`
+	ND base.Height `json:"next_deadline"`
+	CI string      `json:"currency"`
+}
+
+func (it *CloseVotingItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ucv CloseVotingItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.ND, ucv.CI)
+}
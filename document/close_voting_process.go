@@ -0,0 +1,197 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var CloseVotingProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CloseVotingProcessor)
+	},
+}
+
+func (op CloseVoting) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type CloseVotingProcessor struct {
+	cp *currency.CurrencyPool
+	CloseVoting
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+	nsts     []state.State                                // closed voting documents and results
+}
+
+func NewCloseVotingProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(CloseVoting)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not CloseVoting, %T", op)
+		}
+
+		opp := CloseVotingProcessorPool.Get().(*CloseVotingProcessor)
+
+		opp.cp = cp
+		opp.CloseVoting = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+		opp.nsts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *CloseVotingProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CloseVotingProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CloseVotingFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	sts, err := opp.closeVoting(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.nsts = sts
+
+	return opp, nil
+}
+
+// Process sets the voting documents closed in PreProcess; the other operations
+// on the same voting document are not allowed in the same proposal, see
+// OperationProcessor.checkDocumentDuplication.
+func (opp *CloseVotingProcessor) Process( // nolint:dupl
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CloseVotingFact)
+
+	sts := make([]state.State, len(opp.nsts), len(opp.nsts)+len(opp.required))
+	copy(sts, opp.nsts)
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// closeVoting returns the voting document states and the voting result states
+// of the closed rounds. The sender should be the owner of document or the
+// delegate allowed by the owner.
+func (opp *CloseVotingProcessor) closeVoting(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(CloseVotingFact)
+
+	var sts []state.State
+	for i := range fact.items {
+		it := fact.items[i]
+
+		dst, doc, err := loadVotingDocument(it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		if !doc.Owner().Equal(fact.sender) {
+			if err := checkDelegation(doc.Owner(), fact.sender, doc, opp.height, getState); err != nil {
+				return nil, err
+			}
+		}
+
+		switch phase := doc.Phase(opp.height); {
+		case phase != VotingPhaseEnded:
+			return nil, operation.NewBaseReasonError("voting can not be closed in %s phase, %q", phase, it.DocumentId())
+		case it.OpensNextRound() && it.NextDeadline() <= opp.height:
+			return nil, operation.NewBaseReasonError("next deadline already passed, %v <= %v", it.NextDeadline(), opp.height)
+		}
+
+		rst, found, err := getState(StateKeyVotingResult(it.DocumentId(), doc.Round()))
+		switch {
+		case err != nil:
+			return nil, err
+		case found:
+			return nil, operation.NewBaseReasonError("voting result already exists, %q; round %d", it.DocumentId(), doc.Round())
+		}
+
+		nrst, err := SetStateVotingResultValue(rst,
+			NewVotingResult(it.DocumentId(), doc.Round(), doc.Candidates(), opp.height))
+		if err != nil {
+			return nil, err
+		}
+
+		ndoc := doc.closeRound()
+		if it.OpensNextRound() {
+			ndoc = ndoc.openRound(it.NextDeadline(), opp.height)
+		}
+
+		nst, err := SetStateDocumentDataValue(dst, ndoc)
+		if err != nil {
+			return nil, err
+		}
+
+		sts = append(sts, nst, nrst)
+	}
+
+	return sts, nil
+}
+
+func (opp *CloseVotingProcessor) Close() error {
+	opp.cp = nil
+	opp.CloseVoting = CloseVoting{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+	opp.nsts = nil
+
+	CloseVotingProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *CloseVotingProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(CloseVotingFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
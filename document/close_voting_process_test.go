@@ -0,0 +1,135 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCloseVotingProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testCloseVotingProcessor) newCloseVoting(
+	sender testAccount, documentid string, nextDeadline base.Height,
+) CloseVoting {
+	items := []CloseVotingItem{NewCloseVotingItemImpl(documentid, nextDeadline, t.cid)}
+	fact := NewCloseVotingFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewCloseVoting(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testCloseVotingProcessor) TestCloseAndOpenNextRound() {
+	owner, sts0 := t.newAccount()
+	voter, sts1 := t.newAccount()
+	candidate, sts2 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	pool, opr := t.statepool(sts0, sts1, sts2, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newCastVote(voter, doc.DocumentId(), candidate.Address()))
+	t.NoError(errs[0])
+
+	// the voting is closed after deadline and the next round takes the votes
+	// until 66
+	pool, opr = t.statepoolAt(base.Height(34), sts0, sts1, sts2, t.updates(pool))
+
+	errs = t.process(opr, t.newCloseVoting(owner, doc.DocumentId(), base.Height(66)))
+	t.NoError(errs[0])
+
+	closed := t.updatedDocument(pool, doc.DocumentId()).(BCVotingData)
+	t.Equal(uint(2), closed.Round())
+	t.Equal(base.Height(66), closed.Deadline())
+	t.False(closed.Closed())
+	t.Equal(VotingPhaseVoting, closed.Phase(base.Height(35)))
+	t.True(closed.Account().Equal(candidate.Address()))
+
+	vc, found := closed.Candidate(candidate.Address())
+	t.True(found)
+	t.Equal(uint(0), vc.Count())
+
+	st, found := t.updated(pool, StateKeyVotingResult(doc.DocumentId(), 1))
+	t.True(found)
+
+	result, err := StateVotingResultValue(st)
+	t.NoError(err)
+	t.Equal(base.Height(34), result.ClosedAt())
+
+	winner, found := result.Winner()
+	t.True(found)
+	t.True(winner.Address().Equal(candidate.Address()))
+	t.Equal(uint(1), winner.Count())
+}
+
+func (t *testCloseVotingProcessor) TestCloseBeforeDeadline() {
+	owner, sts0 := t.newAccount()
+	candidate, sts1 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	_, opr := t.statepoolAt(base.Height(33), sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newCloseVoting(owner, doc.DocumentId(), base.Height(0)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "voting can not be closed in voting phase")
+}
+
+func (t *testCloseVotingProcessor) TestCloseTwice() {
+	owner, sts0 := t.newAccount()
+	candidate, sts1 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	pool, opr := t.statepoolAt(base.Height(34), sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newCloseVoting(owner, doc.DocumentId(), base.Height(0)))
+	t.NoError(errs[0])
+
+	closed := t.updatedDocument(pool, doc.DocumentId()).(BCVotingData)
+	t.True(closed.Closed())
+	t.Equal(uint(1), closed.Round())
+
+	_, opr = t.nextStatepool(pool, sts0, sts1)
+
+	errs = t.process(opr, t.newCloseVoting(owner, doc.DocumentId(), base.Height(66)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "voting can not be closed in closed phase")
+}
+
+func (t *testCloseVotingProcessor) TestNextDeadlinePassed() {
+	owner, sts0 := t.newAccount()
+	candidate, sts1 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	_, opr := t.statepoolAt(base.Height(34), sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newCloseVoting(owner, doc.DocumentId(), base.Height(34)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "next deadline already passed")
+}
+
+func (t *testCloseVotingProcessor) TestCloseByNotOwner() {
+	owner, sts0 := t.newAccount()
+	other, sts1 := t.newAccount()
+	candidate, sts2 := t.newAccount()
+
+	doc := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+
+	_, opr := t.statepoolAt(base.Height(34), sts0, sts1, sts2, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newCloseVoting(other, doc.DocumentId(), base.Height(0)))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender not allowed to update document")
+}
+
+func TestCloseVotingProcessor(t *testing.T) {
+	suite.Run(t, new(testCloseVotingProcessor))
+}
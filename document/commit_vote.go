@@ -0,0 +1,161 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CommitVoteFactType   = hint.Type("mitum-commit-vote-operation-fact")
+	CommitVoteFactHint   = hint.NewHint(CommitVoteFactType, "v0.0.1")
+	CommitVoteFactHinter = CommitVoteFact{BaseHinter: hint.NewBaseHinter(CommitVoteFactHint)}
+	CommitVoteType       = hint.Type("mitum-commit-vote-operation")
+	CommitVoteHint       = hint.NewHint(CommitVoteType, "v0.0.1")
+	CommitVoteHinter     = CommitVote{BaseOperation: operationHinter(CommitVoteHint)}
+)
+
+var MaxCommitVoteItems uint = 10
+
+type CommitVoteItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Commitment() valuehash.Hash
+	Currency() currency.CurrencyID
+	Rebuild() CommitVoteItem
+}
+
+type CommitVoteFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []CommitVoteItem
+}
+
+func NewCommitVoteFact(token []byte, sender base.Address, items []CommitVoteItem) CommitVoteFact {
+	fact := CommitVoteFact{
+		BaseHinter: hint.NewBaseHinter(CommitVoteFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CommitVoteFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CommitVoteFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CommitVoteFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact CommitVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for CommitVoteFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxCommitVoteItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxCommitVoteItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact CommitVoteFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CommitVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CommitVoteFact) Items() []CommitVoteItem {
+	return fact.items
+}
+
+func (fact CommitVoteFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact CommitVoteFact) Rebuild() CommitVoteFact {
+	items := make([]CommitVoteItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type CommitVote struct {
+	currency.BaseOperation
+}
+
+func NewCommitVote(fact CommitVoteFact, fs []base.FactSign, memo string) (CommitVote, error) {
+	bo, err := currency.NewBaseOperationFromFact(CommitVoteHint, fact, fs, memo)
+	if err != nil {
+		return CommitVote{}, err
+	} else {
+
+		return CommitVote{BaseOperation: bo}, nil
+	}
+}
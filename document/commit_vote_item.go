@@ -0,0 +1,78 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CommitVoteItemImplType   = hint.Type("mitum-commit-vote-item")
+	CommitVoteItemImplHint   = hint.NewHint(CommitVoteItemImplType, "v0.0.1")
+	CommitVoteItemImplHinter = CommitVoteItemImpl{BaseHinter: hint.NewBaseHinter(CommitVoteItemImplHint)}
+)
+
+// CommitVoteItemImpl commits the secret vote for the blockcity voting
+// document; see NewVoteCommitment.
+type CommitVoteItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	commitment valuehash.Hash
+	cid        currency.CurrencyID
+}
+
+func NewCommitVoteItemImpl(
+	documentid string,
+	commitment valuehash.Hash,
+	cid currency.CurrencyID) CommitVoteItemImpl {
+
+	return CommitVoteItemImpl{
+		BaseHinter: hint.NewBaseHinter(CommitVoteItemImplHint),
+		documentid: documentid,
+		commitment: commitment,
+		cid:        cid,
+	}
+}
+
+func (it CommitVoteItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.commitment.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it CommitVoteItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.commitment,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid CommitVoteItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid CommitVoteItem: empty documentid")
+	}
+
+	return nil
+}
+
+func (it CommitVoteItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it CommitVoteItemImpl) Commitment() valuehash.Hash {
+	return it.commitment
+}
+
+func (it CommitVoteItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it CommitVoteItemImpl) Rebuild() CommitVoteItem {
+	return it
+}
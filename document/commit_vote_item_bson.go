@@ -0,0 +1,33 @@
+package document // nolint:dupl
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it CommitVoteItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"commitment": it.commitment,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type CommitVoteItemImplBSONUnpacker struct {
+	DI string          `bson:"documentid"`
+	CM valuehash.Bytes `bson:"commitment"`
+	CI string          `bson:"currency"`
+}
+
+func (it *CommitVoteItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ucv CommitVoteItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.CM, ucv.CI)
+}
@@ -0,0 +1,20 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (it *CommitVoteItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	cm valuehash.Bytes,
+	cid string,
+) error {
+	it.documentid = di
+	it.commitment = cm
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
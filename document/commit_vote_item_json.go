@@ -0,0 +1,38 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type CommitVoteItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	CM valuehash.Hash      `json:"commitment"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it CommitVoteItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CommitVoteItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		CM:         it.commitment,
+		CI:         it.cid,
+	})
+}
+
+type CommitVoteItemImplJSONUnpacker struct {
+	DI string          `json:"documentid"`
+	CM valuehash.Bytes `json:"commitment"`
+	CI string          `json:"currency"`
+}
+
+func (it *CommitVoteItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ucv CommitVoteItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.CM, ucv.CI)
+}
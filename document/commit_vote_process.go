@@ -0,0 +1,191 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var CommitVoteProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CommitVoteProcessor)
+	},
+}
+
+func (op CommitVote) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type CommitVoteProcessor struct {
+	cp *currency.CurrencyPool
+	CommitVote
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+	nsts     []state.State                                // voting box states with commit
+}
+
+func NewCommitVoteProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(CommitVote)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not CommitVote, %T", op)
+		}
+
+		opp := CommitVoteProcessorPool.Get().(*CommitVoteProcessor)
+
+		opp.cp = cp
+		opp.CommitVote = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+		opp.nsts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *CommitVoteProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *CommitVoteProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CommitVoteFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	sts, err := opp.commitVote(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.nsts = sts
+
+	return opp, nil
+}
+
+// pooledStates returns the voting box states with the commits; the next commits
+// in the same proposal are added over them.
+func (opp *CommitVoteProcessor) pooledStates() []state.State {
+	return opp.nsts
+}
+
+// Process sets the voting box states with the commits added in PreProcess; the
+// commits on the same voting document in the same proposal are merged into the
+// pooled voting box, see OperationProcessor.setState.
+func (opp *CommitVoteProcessor) Process( // nolint:dupl
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CommitVoteFact)
+
+	sts := make([]state.State, len(opp.nsts), len(opp.nsts)+len(opp.required))
+	copy(sts, opp.nsts)
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// commitVote returns the voting box states, which the committed votes of
+// sender are added with the weights of sender. The voting document is not
+// changed, so the tally is not shown until the votes are revealed.
+func (opp *CommitVoteProcessor) commitVote(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(CommitVoteFact)
+
+	var sts []state.State
+	for i := range fact.items {
+		it := fact.items[i]
+
+		_, doc, err := loadVotingDocument(it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		if phase := doc.Phase(opp.height); phase != VotingPhaseCommit {
+			return nil, operation.NewBaseReasonError("commits not accepted in %s phase, %q", phase, it.DocumentId())
+		}
+
+		bst, box, err := loadVotingBox(doc, getState)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := box.Commit(fact.sender); found {
+			return nil, operation.NewBaseReasonError("sender already committed, %v; %q", fact.sender, it.DocumentId())
+		}
+
+		// the weight is taken at commit; the fee of commit may change the
+		// balance of sender before reveal
+		weight, err := votingWeight(doc, fact.sender, getState)
+		if err != nil {
+			return nil, err
+		}
+
+		nbst, err := SetStateVotingBoxValue(bst, box.AddCommit(NewVoteCommit(fact.sender, it.Commitment(), weight)))
+		if err != nil {
+			return nil, err
+		}
+
+		sts = append(sts, nbst)
+	}
+
+	return sts, nil
+}
+
+func (opp *CommitVoteProcessor) Close() error {
+	opp.cp = nil
+	opp.CommitVote = CommitVote{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+	opp.nsts = nil
+
+	CommitVoteProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *CommitVoteProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(CommitVoteFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
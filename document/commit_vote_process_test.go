@@ -0,0 +1,61 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCommitVoteProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testCommitVoteProcessor) TestCommitsInSameBlock() {
+	owner, sts0 := t.newAccount()
+	voter0, sts1 := t.newAccount()
+	voter1, sts2 := t.newAccount()
+	candidate, sts3 := t.newAccount()
+
+	doc := t.newSecretBCVotingData(owner.Address(), candidate.Address())
+
+	pool, opr := t.statepool(sts0, sts1, sts2, sts3, t.newDocumentStates(owner.Address(), doc))
+
+	// all the commits in the block are added to the voting box
+	errs := t.process(opr,
+		t.newCommitVote(voter0, doc.DocumentId(), candidate.Address(), "salt0"),
+		t.newCommitVote(voter1, doc.DocumentId(), candidate.Address(), "salt1"),
+	)
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+
+	st, found := t.updated(pool, StateKeyVotingBox(doc.DocumentId()))
+	t.True(found)
+
+	box, err := StateVotingBoxValue(st)
+	t.NoError(err)
+	t.Equal(2, len(box.Commits()))
+
+	_, found = box.Commit(voter0.Address())
+	t.True(found)
+	_, found = box.Commit(voter1.Address())
+	t.True(found)
+}
+
+func (t *testCommitVoteProcessor) TestCommitAfterDeadline() {
+	owner, sts0 := t.newAccount()
+	voter, sts1 := t.newAccount()
+	candidate, sts2 := t.newAccount()
+
+	doc := t.newSecretBCVotingData(owner.Address(), candidate.Address())
+
+	_, opr := t.statepoolAt(base.Height(34), sts0, sts1, sts2, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newCommitVote(voter, doc.DocumentId(), candidate.Address(), "salt"))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "commits not accepted")
+}
+
+func TestCommitVoteProcessor(t *testing.T) {
+	suite.Run(t, new(testCommitVoteProcessor))
+}
@@ -24,7 +24,7 @@ func NewCreateDocumentsItemImpl(
 	doc DocumentData,
 	cid currency.CurrencyID) CreateDocumentsItemImpl {
 
-	if doc.Info().docType != doc.Hint().Type() {
+	if _, ok := doc.(GeneralDocData); !ok && doc.Info().docType != doc.Hint().Type() {
 		panic(util.WrongTypeError.Errorf("Document Info Type not matched with DocumentData Type, not %v", doc.Hint().Type()))
 	}
 
@@ -37,7 +37,7 @@ type CreateDocumentsItemProcessor struct {
 	item    CreateDocumentsItem
 	nds     state.State // new document data state (key = document id)
 	docInfo DocInfo     // new document info
-
+	height  base.Height // height of block in processing
 }
 
 func (opp *CreateDocumentsItemProcessor) PreProcess(
@@ -49,6 +49,10 @@ func (opp *CreateDocumentsItemProcessor) PreProcess(
 		return operation.NewBaseReasonError(err.Error())
 	}
 
+	if IsLegacyDocumentData(opp.item.Doc()) {
+		return operation.NewBaseReasonError("v0.0.1 document data not accepted, %q", opp.item.DocumentId())
+	}
+
 	// check existence of new document state with documentid and get document state
 	switch st, found, err := getState(StateKeyDocumentData(opp.item.DocumentId())); {
 	case err != nil:
@@ -59,7 +63,50 @@ func (opp *CreateDocumentsItemProcessor) PreProcess(
 		opp.nds = st
 	}
 
-	id := NewDocId(opp.item.DocumentId())
+	if err := checkDocumentDataPermission(opp.sender, opp.item.Doc(), getState); err != nil {
+		return err
+	}
+
+	// the votes of voting document are counted only by CastVote
+	if doc, ok := opp.item.Doc().(BCVotingData); ok {
+		if doc.Closed() {
+			return operation.NewBaseReasonError("new voting document can not be closed, %q", doc.DocumentId())
+		}
+
+		for i := range doc.Candidates() {
+			if doc.Candidates()[i].Count() > 0 {
+				return operation.NewBaseReasonError(
+					"count of candidate should be zero, %v", doc.Candidates()[i].Address())
+			}
+		}
+	}
+
+	// the gold of user document is moved only by the gold operations
+	if doc, ok := opp.item.Doc().(BCUserData); ok && (doc.Gold() > 0 || doc.BankGold() > 0) {
+		return operation.NewBaseReasonError("new user document can not have gold, %q", doc.DocumentId())
+	}
+
+	if doc, ok := opp.item.Doc().(BCUserData); ok {
+		if err := checkStatPolicy(doc, getState); err != nil {
+			return err
+		}
+	}
+
+	// the lease of land is started only by RentLand
+	if doc, ok := opp.item.Doc().(BCLandData); ok && doc.LeaseEnd() > base.Height(0) {
+		return operation.NewBaseReasonError("new land document can not be leased, %q", doc.DocumentId())
+	}
+
+	// the document type of GeneralDocData is checked by the registry state
+	var id DocId
+	if doc, ok := opp.item.Doc().(GeneralDocData); ok {
+		if err := checkGeneralDocData(doc, getState); err != nil {
+			return err
+		}
+		id = doc.Info().id
+	} else if id = NewDocId(opp.item.DocumentId()); id == nil {
+		return operation.NewBaseReasonError("unknown document id type, %q", opp.item.DocumentId())
+	}
 
 	// check existence of DocumentData related accounts
 	for i := range opp.item.Doc().Accounts() {
@@ -88,8 +135,14 @@ func (opp *CreateDocumentsItemProcessor) Process(
 
 	sts := make([]state.State, 1)
 
+	// the weights of voting document are snapshotted at the creation
+	doc := opp.item.Doc()
+	if vd, ok := doc.(BCVotingData); ok {
+		doc = vd.withSnapshot(opp.height)
+	}
+
 	// set new document state
-	if dst, err := SetStateDocumentDataValue(opp.nds, opp.item.Doc()); err != nil {
+	if dst, err := SetStateDocumentDataValue(opp.nds, doc); err != nil {
 		return nil, err
 	} else {
 		sts[0] = dst
@@ -105,6 +158,7 @@ func (opp *CreateDocumentsItemProcessor) Close() error {
 	opp.item = nil
 	opp.nds = nil
 	opp.docInfo = DocInfo{}
+	opp.height = base.NilHeight
 
 	CreateDocumentsItemProcessorPool.Put(opp)
 
@@ -119,6 +173,7 @@ type CreateDocumentsProcessor struct {
 	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
 	ns       []*CreateDocumentsItemProcessor              // ItemProcessor
 	required map[currency.CurrencyID][2]currency.Big      // Fee
+	height   base.Height                                  // height of block in processing
 }
 
 func NewCreateDocumentsProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
@@ -137,12 +192,17 @@ func NewCreateDocumentsProcessor(cp *currency.CurrencyPool) currency.GetNewProce
 		opp.sb = nil
 		opp.ns = nil
 		opp.required = nil
+		opp.height = base.NilHeight
 
 		return opp, nil
 
 	}
 }
 
+func (opp *CreateDocumentsProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *CreateDocumentsProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
@@ -189,6 +249,7 @@ func (opp *CreateDocumentsProcessor) PreProcess(
 		c.h = opp.Hash()
 		c.sender = fact.sender
 		c.item = fact.items[i]
+		c.height = opp.height
 
 		if err := c.PreProcess(getState, setState); err != nil {
 			return nil, err
@@ -262,6 +323,7 @@ func (opp *CreateDocumentsProcessor) Close() error {
 	opp.sb = nil
 	opp.ndinvs = nil
 	opp.required = nil
+	opp.height = base.NilHeight
 
 	CreateDocumentsProcessorPool.Put(opp)
 
@@ -279,7 +341,15 @@ func (opp *CreateDocumentsProcessor) calculateItemsFee() (map[currency.CurrencyI
 	return CalculateDocumentItemsFee(opp.cp, items)
 }
 
-func CalculateDocumentItemsFee(cp *currency.CurrencyPool, items []CreateDocumentsItem) (map[currency.CurrencyID][2]currency.Big, error) {
+// CurrencyItem is the item of operation, which fee is paid in the currency of
+// item by sender.
+type CurrencyItem interface {
+	Currency() currency.CurrencyID
+}
+
+// CalculateCurrencyItemsFee returns the amounts, which sender pays, and the
+// fees of the items by currency.
+func CalculateCurrencyItemsFee(cp *currency.CurrencyPool, items []CurrencyItem) (map[currency.CurrencyID][2]currency.Big, error) {
 	required := map[currency.CurrencyID][2]currency.Big{}
 
 	for i := range items {
@@ -309,12 +379,20 @@ func CalculateDocumentItemsFee(cp *currency.CurrencyPool, items []CreateDocument
 		default:
 			required[it.Currency()] = [2]currency.Big{rq[0].Add(k), rq[1].Add(k)}
 		}
-
 	}
 
 	return required, nil
 }
 
+func CalculateDocumentItemsFee(cp *currency.CurrencyPool, items []CreateDocumentsItem) (map[currency.CurrencyID][2]currency.Big, error) {
+	cis := make([]CurrencyItem, len(items))
+	for i := range items {
+		cis[i] = items[i]
+	}
+
+	return CalculateCurrencyItemsFee(cp, cis)
+}
+
 func CheckDocumentOwnerEnoughBalance(
 	holder base.Address,
 	required map[currency.CurrencyID][2]currency.Big,
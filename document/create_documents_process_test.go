@@ -0,0 +1,135 @@
+package document
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCreateDocumentsProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testCreateDocumentsProcessor) newCreateDocuments(sender testAccount, doc DocumentData) CreateDocuments {
+	items := []CreateDocumentsItem{NewCreateDocumentsItemImpl(doc, t.cid)}
+	fact := NewCreateDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewCreateDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testCreateDocumentsProcessor) TestCreateSameDocumentInSameBlock() {
+	owner0, sts0 := t.newAccount()
+	owner1, sts1 := t.newAccount()
+
+	pool, opr := t.statepool(sts0, sts1)
+
+	doc0 := t.newBSDocData(owner0.Address(), "1sdi")
+	doc1 := t.newBSDocData(owner1.Address(), "1sdi")
+
+	// the document of owner1 is rejected instead of overwriting the document
+	// of owner0
+	errs := t.process(opr, t.newCreateDocuments(owner0, doc0), t.newCreateDocuments(owner1, doc1))
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document")
+
+	t.True(t.updatedDocument(pool, "1sdi").Owner().Equal(owner0.Address()))
+
+	_, opr = t.nextStatepool(pool, sts0, sts1)
+
+	errs = t.process(opr, t.newCreateDocuments(owner1, doc1))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "documentid already registered")
+}
+
+func (t *testCreateDocumentsProcessor) newBCLandData(owner base.Address, geometry LandGeometry) BCLandData {
+	return NewBCLandData(
+		MustNewDocInfo("1cli", BCLandDataType),
+		owner,
+		"address",
+		"area",
+		"",
+		owner,
+		NewDocumentTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		30,
+	).WithGeometry(geometry)
+}
+
+func (t *testCreateDocumentsProcessor) TestCreateBCLandDataWithGeometry() {
+	owner, sts0 := t.newAdmin()
+
+	geometry := NewPolygonLandGeometry([][2]float64{{126.97, 37.56}, {126.98, 37.56}, {126.98, 37.57}, {126.97, 37.56}})
+
+	pool, opr := t.statepool(sts0)
+
+	errs := t.process(opr, t.newCreateDocuments(owner, t.newBCLandData(owner.Address(), geometry)))
+	t.NoError(errs[0])
+
+	doc := t.updatedDocument(pool, "1cli").(BCLandData)
+	t.True(doc.HasGeometry())
+	t.True(geometry.Equal(doc.Geometry()))
+}
+
+func (t *testCreateDocumentsProcessor) TestCreateBCLandDataWithInvalidGeometry() {
+	owner, _ := t.newAdmin()
+
+	cases := []struct {
+		name     string
+		geometry LandGeometry
+		err      string
+	}{
+		{
+			name:     "longitude",
+			geometry: NewPointLandGeometry(180.1, 37.56),
+			err:      "invalid longitude of land geometry",
+		},
+		{
+			name:     "latitude",
+			geometry: NewPointLandGeometry(126.97, -90.1),
+			err:      "invalid latitude of land geometry",
+		},
+		{
+			name:     "point with polygon",
+			geometry: NewLandGeometry(GeometryPoint, [][2]float64{{126.97, 37.56}, {126.98, 37.56}}),
+			err:      "point land geometry should have one coordinate",
+		},
+		{
+			name:     "not closed",
+			geometry: NewPolygonLandGeometry([][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}}),
+			err:      "polygon land geometry not closed",
+		},
+		{
+			name:     "self-intersecting",
+			geometry: NewPolygonLandGeometry([][2]float64{{0, 0}, {1, 1}, {1, 0}, {0, 1}, {0, 0}}),
+			err:      "polygon land geometry is self-intersecting",
+		},
+		{
+			name:     "unknown type",
+			geometry: NewLandGeometry(GeometryType("LineString"), [][2]float64{{0, 0}, {1, 1}}),
+			err:      `unknown land geometry type, "LineString"`,
+		},
+	}
+
+	for i, c := range cases {
+		items := []CreateDocumentsItem{NewCreateDocumentsItemImpl(t.newBCLandData(owner.Address(), c.geometry), t.cid)}
+		fact := NewCreateDocumentsFact(util.UUID().Bytes(), owner.Address(), items)
+
+		op, err := NewCreateDocuments(fact, t.newFactSigns(fact, owner.Privs()...), "")
+		t.NoError(err, "%d: %v", i, c.name)
+
+		err = op.IsValid(t.networkID)
+		t.Error(err, "%d: %v", i, c.name)
+		t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+	}
+}
+
+func TestCreateDocumentsProcessor(t *testing.T) {
+	suite.Run(t, new(testCreateDocumentsProcessor))
+}
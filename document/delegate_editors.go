@@ -0,0 +1,174 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	DelegateEditorsFactType   = hint.Type("mitum-delegate-editors-operation-fact")
+	DelegateEditorsFactHint   = hint.NewHint(DelegateEditorsFactType, "v0.0.1")
+	DelegateEditorsFactHinter = DelegateEditorsFact{BaseHinter: hint.NewBaseHinter(DelegateEditorsFactHint)}
+	DelegateEditorsType       = hint.Type("mitum-delegate-editors-operation")
+	DelegateEditorsHint       = hint.NewHint(DelegateEditorsType, "v0.0.1")
+	DelegateEditorsHinter     = DelegateEditors{BaseOperation: operationHinter(DelegateEditorsHint)}
+)
+
+var MaxDelegateEditorsItems uint = 10
+
+type DelegateEditorsItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	Delegate() base.Address
+	DocumentId() string
+	DocumentType() hint.Type
+	Expiry() base.Height
+	Mode() DelegateMode
+	Delegation() Delegation
+	Currency() currency.CurrencyID
+	Rebuild() DelegateEditorsItem
+}
+
+type DelegateEditorsFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []DelegateEditorsItem
+}
+
+func NewDelegateEditorsFact(token []byte, sender base.Address, items []DelegateEditorsItem) DelegateEditorsFact {
+	fact := DelegateEditorsFact{
+		BaseHinter: hint.NewBaseHinter(DelegateEditorsFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact DelegateEditorsFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact DelegateEditorsFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DelegateEditorsFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact DelegateEditorsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for DelegateEditorsFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxDelegateEditorsItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxDelegateEditorsItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		if it.Delegate().Equal(fact.sender) {
+			return errors.Errorf("sender can not be delegate, %q", it.Delegate())
+		}
+
+		for j := range fact.items[:i] {
+			if fact.items[j].Delegation().SameTarget(it.Delegation()) {
+				return errors.Errorf("duplicated delegation, %q", it.Delegate())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact DelegateEditorsFact) Token() []byte {
+	return fact.token
+}
+
+func (fact DelegateEditorsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DelegateEditorsFact) Items() []DelegateEditorsItem {
+	return fact.items
+}
+
+func (fact DelegateEditorsFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	// NOTE delegates can find the operation in their history
+	for i := range fact.items {
+		as = append(as, fact.items[i].Delegate())
+	}
+
+	return as, nil
+}
+
+func (fact DelegateEditorsFact) Rebuild() DelegateEditorsFact {
+	items := make([]DelegateEditorsItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type DelegateEditors struct {
+	currency.BaseOperation
+}
+
+func NewDelegateEditors(fact DelegateEditorsFact, fs []base.FactSign, memo string) (DelegateEditors, error) {
+	bo, err := currency.NewBaseOperationFromFact(DelegateEditorsHint, fact, fs, memo)
+	if err != nil {
+		return DelegateEditors{}, err
+	} else {
+
+		return DelegateEditors{BaseOperation: bo}, nil
+	}
+}
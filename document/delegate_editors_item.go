@@ -0,0 +1,127 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type DelegateMode string
+
+const (
+	DelegateAllow  DelegateMode = "allow"
+	DelegateCancel DelegateMode = "cancel"
+)
+
+func (mode DelegateMode) Bytes() []byte {
+	return []byte(mode)
+}
+
+func (mode DelegateMode) IsValid([]byte) error {
+	switch mode {
+	case DelegateAllow, DelegateCancel:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown delegate mode, %q", mode)
+	}
+}
+
+var (
+	DelegateEditorsItemImplType   = hint.Type("mitum-delegate-editors-item")
+	DelegateEditorsItemImplHint   = hint.NewHint(DelegateEditorsItemImplType, "v0.0.1")
+	DelegateEditorsItemImplHinter = DelegateEditorsItemImpl{BaseHinter: hint.NewBaseHinter(DelegateEditorsItemImplHint)}
+)
+
+// DelegateEditorsItemImpl allows or cancels the delegate to update the
+// document of documentid or the documents of doctype; only one of them is set.
+type DelegateEditorsItemImpl struct {
+	hint.BaseHinter
+	delegate   base.Address
+	documentid string
+	docType    hint.Type
+	expiry     base.Height
+	mode       DelegateMode
+	cid        currency.CurrencyID
+}
+
+func NewDelegateEditorsItemImpl(
+	delegate base.Address,
+	documentid string,
+	docType hint.Type,
+	expiry base.Height,
+	mode DelegateMode,
+	cid currency.CurrencyID) DelegateEditorsItemImpl {
+
+	return DelegateEditorsItemImpl{
+		BaseHinter: hint.NewBaseHinter(DelegateEditorsItemImplHint),
+		delegate:   delegate,
+		documentid: documentid,
+		docType:    docType,
+		expiry:     expiry,
+		mode:       mode,
+		cid:        cid,
+	}
+}
+
+func (it DelegateEditorsItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.delegate.Bytes(),
+		[]byte(it.documentid),
+		it.docType.Bytes(),
+		it.expiry.Bytes(),
+		it.mode.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it DelegateEditorsItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.Delegation(),
+		it.mode,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid DelegateEditorsItem: %w", err)
+	}
+
+	if it.mode == DelegateCancel && it.expiry > base.Height(0) {
+		return isvalid.InvalidError.Errorf("invalid DelegateEditorsItem: expiry set for cancel")
+	}
+
+	return nil
+}
+
+func (it DelegateEditorsItemImpl) Delegate() base.Address {
+	return it.delegate
+}
+
+func (it DelegateEditorsItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it DelegateEditorsItemImpl) DocumentType() hint.Type {
+	return it.docType
+}
+
+func (it DelegateEditorsItemImpl) Expiry() base.Height {
+	return it.expiry
+}
+
+func (it DelegateEditorsItemImpl) Mode() DelegateMode {
+	return it.mode
+}
+
+func (it DelegateEditorsItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it DelegateEditorsItemImpl) Delegation() Delegation {
+	return NewDelegation(it.delegate, it.documentid, it.docType, it.expiry)
+}
+
+func (it DelegateEditorsItemImpl) Rebuild() DelegateEditorsItem {
+	return it
+}
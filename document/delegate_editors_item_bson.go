@@ -0,0 +1,39 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it DelegateEditorsItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"delegate":   it.delegate,
+				"documentid": it.documentid,
+				"doctype":    it.docType,
+				"expiry":     it.expiry,
+				"mode":       it.mode,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type DelegateEditorsItemImplBSONUnpacker struct {
+	DG base.AddressDecoder `bson:"delegate"`
+	DI string              `bson:"documentid"`
+	DT string              `bson:"doctype"`
+	EX base.Height         `bson:"expiry"`
+	MD string              `bson:"mode"`
+	CI string              `bson:"currency"`
+}
+
+func (it *DelegateEditorsItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ude DelegateEditorsItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &ude); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ude.DG, ude.DI, ude.DT, ude.EX, ude.MD, ude.CI)
+}
@@ -0,0 +1,32 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+func (it *DelegateEditorsItemImpl) unpack(
+	enc encoder.Encoder,
+	bdg base.AddressDecoder,
+	di string,
+	dt string,
+	ex base.Height,
+	md string,
+	cid string,
+) error {
+	a, err := bdg.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	it.delegate = a
+	it.documentid = di
+	it.docType = hint.Type(dt)
+	it.expiry = ex
+	it.mode = DelegateMode(md)
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
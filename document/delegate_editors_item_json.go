@@ -0,0 +1,48 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+type DelegateEditorsItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DG base.Address        `json:"delegate"`
+	DI string              `json:"documentid,omitempty"`
+	DT hint.Type           `json:"doctype,omitempty"`
+	EX base.Height         `json:"expiry,omitempty"`
+	MD DelegateMode        `json:"mode"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it DelegateEditorsItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DelegateEditorsItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DG:         it.delegate,
+		DI:         it.documentid,
+		DT:         it.docType,
+		EX:         it.expiry,
+		MD:         it.mode,
+		CI:         it.cid,
+	})
+}
+
+type DelegateEditorsItemImplJSONUnpacker struct {
+	DG base.AddressDecoder `json:"delegate"`
+	DI string              `json:"documentid"`
+	DT string              `json:"doctype"`
+	EX base.Height         `json:"expiry"`
+	MD string              `json:"mode"`
+	CI string              `json:"currency"`
+}
+
+func (it *DelegateEditorsItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ude DelegateEditorsItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ude); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ude.DG, ude.DI, ude.DT, ude.EX, ude.MD, ude.CI)
+}
@@ -0,0 +1,228 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var DelegateEditorsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DelegateEditorsProcessor)
+	},
+}
+
+func (op DelegateEditors) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type DelegateEditorsProcessor struct {
+	cp *currency.CurrencyPool
+	DelegateEditors
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewDelegateEditorsProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(DelegateEditors)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not DelegateEditors, %T", op)
+		}
+
+		opp := DelegateEditorsProcessorPool.Get().(*DelegateEditorsProcessor)
+
+		opp.cp = cp
+		opp.DelegateEditors = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *DelegateEditorsProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *DelegateEditorsProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(DelegateEditorsFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	// check existence of delegate accounts
+	for i := range fact.items {
+		if err := checkExistsState(currency.StateKeyAccount(fact.items[i].Delegate()), getState); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := opp.delegate(getState); err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *DelegateEditorsProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(DelegateEditorsFact)
+
+	nst, err := opp.delegate(getState)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process delegate editors: %w", err)
+	}
+
+	sts := []state.State{nst}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// delegate returns the delegates state of sender, which the items are applied
+// to. The delegated document should be owned by sender.
+func (opp *DelegateEditorsProcessor) delegate(
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	fact := opp.Fact().(DelegateEditorsFact)
+
+	st, found, err := getState(StateKeyDelegates(fact.sender))
+	if err != nil {
+		return nil, err
+	}
+
+	dgs := NewDocumentDelegates(nil)
+	if found {
+		i, err := StateDelegatesValue(st)
+		if err != nil {
+			return nil, err
+		}
+		dgs = i
+	}
+
+	for i := range fact.items {
+		it := fact.items[i]
+
+		if it.Mode() == DelegateCancel {
+			j, err := dgs.Cancel(it.Delegation())
+			if err != nil {
+				return nil, operation.NewBaseReasonErrorFromError(err)
+			}
+			dgs = j
+
+			continue
+		}
+
+		if it.Expiry() > base.Height(0) && it.Expiry() <= opp.height {
+			return nil, operation.NewBaseReasonError("expiry already passed, %v <= %v", it.Expiry(), opp.height)
+		}
+
+		if len(it.DocumentId()) > 0 {
+			dst, err := existsState(StateKeyDocumentData(it.DocumentId()), "document", getState)
+			if err != nil {
+				return nil, err
+			}
+
+			dd, err := StateDocumentDataValue(dst)
+			if err != nil {
+				return nil, err
+			}
+
+			if IsArchivedDocumentData(dd) {
+				return nil, operation.NewBaseReasonError("document already removed, %q", it.DocumentId())
+			}
+
+			if !dd.Owner().Equal(fact.sender) {
+				return nil, operation.NewBaseReasonError("sender not matched with Owner in document, %v", fact.sender)
+			}
+		}
+
+		dgs = dgs.Allow(it.Delegation())
+	}
+
+	return SetStateDelegatesValue(st, dgs)
+}
+
+func (opp *DelegateEditorsProcessor) Close() error {
+	opp.cp = nil
+	opp.DelegateEditors = DelegateEditors{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+
+	DelegateEditorsProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *DelegateEditorsProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(DelegateEditorsFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
+
+// checkDelegation checks that the delegate is allowed to update the document
+// by the owner at the given block height.
+func checkDelegation(
+	owner, delegate base.Address,
+	doc DocumentData,
+	height base.Height,
+	getState func(key string) (state.State, bool, error),
+) error {
+	switch st, found, err := getState(StateKeyDelegates(owner)); {
+	case err != nil:
+		return err
+	case found:
+		dgs, err := StateDelegatesValue(st)
+		if err != nil {
+			return err
+		}
+
+		if dgs.Allowed(delegate, doc.DocumentId(), doc.DocumentType(), height) {
+			return nil
+		}
+	}
+
+	return operation.NewBaseReasonError("sender not allowed to update document, %v; %q", delegate, doc.DocumentId())
+}
@@ -0,0 +1,196 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testDelegateEditorsProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testDelegateEditorsProcessor) newDelegateEditors(sender testAccount, items ...DelegateEditorsItem) DelegateEditors {
+	fact := NewDelegateEditorsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewDelegateEditors(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+// patch returns UpdateDocuments by sender, which increases the hp of the
+// BCUserDatas.
+func (t *testDelegateEditorsProcessor) patch(sender testAccount, documentids ...string) UpdateDocuments {
+	items := make([]UpdateDocumentsItem, len(documentids))
+	for i := range documentids {
+		items[i] = NewPatchDocumentsItemImpl(documentids[i], []DocumentPatch{NewIncrementPatch("statistics.hp", 1)}, t.cid)
+	}
+
+	fact := NewUpdateDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewUpdateDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testDelegateEditorsProcessor) TestDelegateDocument() {
+	owner, sts0 := t.newAccount()
+	editor, sts1 := t.newAccount()
+
+	doc0 := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+	doc1 := t.newBCUserData(owner.Address(), "2cui", 0, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc0, doc1)
+
+	pool, opr := t.statepoolAt(base.Height(10), sts0, sts1, dsts)
+
+	errs := t.process(opr, t.newDelegateEditors(owner,
+		NewDelegateEditorsItemImpl(editor.Address(), doc0.DocumentId(), "", base.Height(20), DelegateAllow, t.cid),
+	))
+	t.NoError(errs[0])
+
+	st, found := t.updated(pool, StateKeyDelegates(owner.Address()))
+	t.True(found)
+
+	dgs, err := StateDelegatesValue(st)
+	t.NoError(err)
+	t.Equal(1, len(dgs.Delegations()))
+	t.True(dgs.Allowed(editor.Address(), doc0.DocumentId(), BCUserDataType, base.Height(20)))
+
+	// the delegated document is updated by editor, but the other document is
+	// not
+	npool, opr := t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.patch(editor, doc0.DocumentId()), t.patch(editor, doc1.DocumentId()))
+	t.NoError(errs[0])
+	t.True(MustNewUserStatistics(2, 1, 1, 1, 1, 1, 1).Equal(
+		t.updatedDocument(npool, doc0.DocumentId()).(BCUserData).Statistics()))
+
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "sender not allowed to update document")
+
+	// the delegation is not expired at the expiry height
+	_, opr = t.statepoolAt(base.Height(20), sts0, sts1, dsts, t.updates(pool))
+
+	errs = t.process(opr, t.patch(editor, doc0.DocumentId()))
+	t.NoError(errs[0])
+
+	_, opr = t.statepoolAt(base.Height(21), sts0, sts1, dsts, t.updates(pool))
+
+	errs = t.process(opr, t.patch(editor, doc0.DocumentId()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender not allowed to update document")
+}
+
+func (t *testDelegateEditorsProcessor) TestDelegateDocumentType() {
+	owner, sts0 := t.newAccount()
+	editor, sts1 := t.newAccount()
+
+	doc0 := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+	doc1 := t.newBCUserData(owner.Address(), "2cui", 0, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc0, doc1)
+
+	pool, opr := t.statepoolAt(base.Height(10), sts0, sts1, dsts)
+
+	errs := t.process(opr, t.newDelegateEditors(owner,
+		NewDelegateEditorsItemImpl(editor.Address(), "", BCUserDataType, base.Height(0), DelegateAllow, t.cid),
+	))
+	t.NoError(errs[0])
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.patch(editor, doc0.DocumentId(), doc1.DocumentId()))
+	t.NoError(errs[0])
+
+	for _, documentid := range []string{doc0.DocumentId(), doc1.DocumentId()} {
+		t.True(MustNewUserStatistics(2, 1, 1, 1, 1, 1, 1).Equal(
+			t.updatedDocument(pool, documentid).(BCUserData).Statistics()))
+	}
+}
+
+func (t *testDelegateEditorsProcessor) TestUpdateWithoutDelegation() {
+	owner, sts0 := t.newAccount()
+	editor, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+
+	_, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.patch(editor, doc.DocumentId()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender not allowed to update document")
+}
+
+func (t *testDelegateEditorsProcessor) TestExpiryAlreadyPassed() {
+	owner, sts0 := t.newAccount()
+	editor, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+
+	_, opr := t.statepoolAt(base.Height(10), sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newDelegateEditors(owner,
+		NewDelegateEditorsItemImpl(editor.Address(), doc.DocumentId(), "", base.Height(10), DelegateAllow, t.cid),
+	))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "expiry already passed, 10 <= 10")
+}
+
+func (t *testDelegateEditorsProcessor) TestDelegateNotOwnedDocument() {
+	owner, sts0 := t.newAccount()
+	other, sts1 := t.newAccount()
+	editor, sts2 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+
+	_, opr := t.statepool(sts0, sts1, sts2, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newDelegateEditors(other,
+		NewDelegateEditorsItemImpl(editor.Address(), doc.DocumentId(), "", base.Height(0), DelegateAllow, t.cid),
+	))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender not matched with Owner in document")
+}
+
+func (t *testDelegateEditorsProcessor) TestCancel() {
+	owner, sts0 := t.newAccount()
+	editor, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	allow := NewDelegateEditorsItemImpl(editor.Address(), doc.DocumentId(), "", base.Height(0), DelegateAllow, t.cid)
+	cancel := NewDelegateEditorsItemImpl(editor.Address(), doc.DocumentId(), "", base.Height(0), DelegateCancel, t.cid)
+
+	// cancel without delegation
+	_, opr := t.statepool(sts0, sts1, dsts)
+
+	errs := t.process(opr, t.newDelegateEditors(owner, cancel))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "delegation not found")
+
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newDelegateEditors(owner, allow))
+	t.NoError(errs[0])
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newDelegateEditors(owner, cancel))
+	t.NoError(errs[0])
+
+	_, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.patch(editor, doc.DocumentId()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender not allowed to update document")
+}
+
+func TestDelegateEditorsProcessor(t *testing.T) {
+	suite.Run(t, new(testDelegateEditorsProcessor))
+}
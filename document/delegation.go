@@ -0,0 +1,223 @@
+package document
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	DelegationType   = hint.Type("mitum-document-delegation")
+	DelegationHint   = hint.NewHint(DelegationType, "v0.0.1")
+	DelegationHinter = Delegation{BaseHinter: hint.NewBaseHinter(DelegationHint)}
+)
+
+// Delegation allows the delegate to update the documents of owner; the target
+// is one document by documentid or every document of the document type.
+type Delegation struct {
+	hint.BaseHinter
+	delegate   base.Address
+	documentid string
+	docType    hint.Type
+	expiry     base.Height // no expiry when not over zero
+}
+
+func NewDelegation(delegate base.Address, documentid string, docType hint.Type, expiry base.Height) Delegation {
+	return Delegation{
+		BaseHinter: hint.NewBaseHinter(DelegationHint),
+		delegate:   delegate,
+		documentid: documentid,
+		docType:    docType,
+		expiry:     expiry,
+	}
+}
+
+func (dg Delegation) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		dg.delegate.Bytes(),
+		[]byte(dg.documentid),
+		dg.docType.Bytes(),
+		dg.expiry.Bytes(),
+	)
+}
+
+func (dg Delegation) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, dg.BaseHinter, dg.delegate); err != nil {
+		return isvalid.InvalidError.Errorf("invalid delegation: %w", err)
+	}
+
+	switch {
+	case len(dg.documentid) > 0 && len(dg.docType) > 0:
+		return isvalid.InvalidError.Errorf("invalid delegation: both documentid and doctype set")
+	case len(dg.documentid) > 0:
+		if len(dg.documentid) <= DocIdShortTypeSize {
+			return isvalid.InvalidError.Errorf("invalid delegation: invalid documentid, %q", dg.documentid)
+		}
+	case len(dg.docType) > 0:
+		if err := dg.docType.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid delegation: %w", err)
+		}
+	default:
+		return isvalid.InvalidError.Errorf("invalid delegation: empty documentid and doctype")
+	}
+
+	return nil
+}
+
+func (dg Delegation) Delegate() base.Address {
+	return dg.delegate
+}
+
+func (dg Delegation) DocumentId() string {
+	return dg.documentid
+}
+
+func (dg Delegation) DocumentType() hint.Type {
+	return dg.docType
+}
+
+func (dg Delegation) Expiry() base.Height {
+	return dg.expiry
+}
+
+func (dg Delegation) HasExpiry() bool {
+	return dg.expiry > base.Height(0)
+}
+
+// IsExpired checks whether the expiry is passed at the given block height.
+func (dg Delegation) IsExpired(height base.Height) bool {
+	return dg.HasExpiry() && height > dg.expiry
+}
+
+// SameTarget checks the delegate and the target document or document type.
+func (dg Delegation) SameTarget(b Delegation) bool {
+	return dg.delegate.Equal(b.delegate) && dg.documentid == b.documentid && dg.docType == b.docType
+}
+
+// Covers checks whether the delegation targets the document.
+func (dg Delegation) Covers(documentid string, docType hint.Type) bool {
+	if len(dg.documentid) > 0 {
+		return dg.documentid == documentid
+	}
+
+	return dg.docType == docType
+}
+
+func (dg Delegation) Equal(b Delegation) bool {
+	return dg.SameTarget(b) && dg.expiry == b.expiry
+}
+
+var (
+	DocumentDelegatesType   = hint.Type("mitum-document-delegates")
+	DocumentDelegatesHint   = hint.NewHint(DocumentDelegatesType, "v0.0.1")
+	DocumentDelegatesHinter = DocumentDelegates{BaseHinter: hint.NewBaseHinter(DocumentDelegatesHint)}
+)
+
+// DocumentDelegates is the state value of the delegations of one owner.
+type DocumentDelegates struct {
+	hint.BaseHinter
+	delegations []Delegation
+}
+
+func NewDocumentDelegates(delegations []Delegation) DocumentDelegates {
+	if delegations == nil {
+		delegations = []Delegation{}
+	}
+
+	return DocumentDelegates{BaseHinter: hint.NewBaseHinter(DocumentDelegatesHint), delegations: delegations}
+}
+
+func (dgs DocumentDelegates) Bytes() []byte {
+	bs := make([][]byte, len(dgs.delegations))
+	for i := range dgs.delegations {
+		bs[i] = dgs.delegations[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (dgs DocumentDelegates) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(dgs.Bytes())
+}
+
+func (dgs DocumentDelegates) IsValid([]byte) error {
+	if err := dgs.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	for i := range dgs.delegations {
+		if err := dgs.delegations[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		for j := range dgs.delegations[:i] {
+			if dgs.delegations[j].SameTarget(dgs.delegations[i]) {
+				return isvalid.InvalidError.Errorf("duplicated delegation, %q", dgs.delegations[i].Delegate())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (dgs DocumentDelegates) Delegations() []Delegation {
+	return dgs.delegations
+}
+
+// Allowed checks whether the delegate can update the document at the given
+// block height.
+func (dgs DocumentDelegates) Allowed(delegate base.Address, documentid string, docType hint.Type, height base.Height) bool {
+	for i := range dgs.delegations {
+		dg := dgs.delegations[i]
+
+		if !dg.Delegate().Equal(delegate) || dg.IsExpired(height) {
+			continue
+		}
+
+		if dg.Covers(documentid, docType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Allow returns new DocumentDelegates with the given delegation; the expiry of
+// the delegation with same target is replaced.
+func (dgs DocumentDelegates) Allow(dg Delegation) DocumentDelegates {
+	delegations := make([]Delegation, 0, len(dgs.delegations)+1)
+	for i := range dgs.delegations {
+		if !dgs.delegations[i].SameTarget(dg) {
+			delegations = append(delegations, dgs.delegations[i])
+		}
+	}
+	delegations = append(delegations, dg)
+
+	sort.SliceStable(delegations, func(i, j int) bool {
+		return delegations[i].Delegate().String() < delegations[j].Delegate().String()
+	})
+
+	return NewDocumentDelegates(delegations)
+}
+
+// Cancel returns new DocumentDelegates without the delegation with same
+// target.
+func (dgs DocumentDelegates) Cancel(dg Delegation) (DocumentDelegates, error) {
+	delegations := make([]Delegation, 0, len(dgs.delegations))
+	for i := range dgs.delegations {
+		if !dgs.delegations[i].SameTarget(dg) {
+			delegations = append(delegations, dgs.delegations[i])
+		}
+	}
+
+	if len(delegations) == len(dgs.delegations) {
+		return DocumentDelegates{}, errors.Errorf("delegation not found, %q", dg.Delegate())
+	}
+
+	return NewDocumentDelegates(delegations), nil
+}
@@ -0,0 +1,65 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (dg Delegation) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"delegate": dg.delegate,
+	}
+
+	if len(dg.documentid) > 0 {
+		m["documentid"] = dg.documentid
+	}
+
+	if len(dg.docType) > 0 {
+		m["doctype"] = dg.docType
+	}
+
+	if dg.HasExpiry() {
+		m["expiry"] = dg.expiry
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(dg.Hint()), m))
+}
+
+type DelegationBSONUnpacker struct {
+	DG base.AddressDecoder `bson:"delegate"`
+	DI string              `bson:"documentid,omitempty"`
+	DT string              `bson:"doctype,omitempty"`
+	EX base.Height         `bson:"expiry,omitempty"`
+}
+
+func (dg *Delegation) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var udg DelegationBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &udg); err != nil {
+		return err
+	}
+
+	return dg.unpack(enc, udg.DG, udg.DI, udg.DT, udg.EX)
+}
+
+func (dgs DocumentDelegates) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(dgs.Hint()),
+		bson.M{
+			"delegations": dgs.delegations,
+		}),
+	)
+}
+
+type DocumentDelegatesBSONUnpacker struct {
+	DS bson.Raw `bson:"delegations"`
+}
+
+func (dgs *DocumentDelegates) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var udgs DocumentDelegatesBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &udgs); err != nil {
+		return err
+	}
+
+	return dgs.unpack(enc, udgs.DS)
+}
@@ -0,0 +1,52 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+func (dg *Delegation) unpack(
+	enc encoder.Encoder,
+	bdg base.AddressDecoder,
+	di string,
+	dt string,
+	ex base.Height,
+) error {
+	a, err := bdg.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	dg.delegate = a
+	dg.documentid = di
+	dg.docType = hint.Type(dt)
+	dg.expiry = ex
+
+	return nil
+}
+
+func (dgs *DocumentDelegates) unpack(
+	enc encoder.Encoder,
+	bds []byte,
+) error {
+	hds, err := enc.DecodeSlice(bds)
+	if err != nil {
+		return err
+	}
+
+	delegations := make([]Delegation, len(hds))
+	for i := range hds {
+		dg, ok := hds[i].(Delegation)
+		if !ok {
+			return errors.Errorf("not Delegation: %T", hds[i])
+		}
+
+		delegations[i] = dg
+	}
+
+	dgs.delegations = delegations
+
+	return nil
+}
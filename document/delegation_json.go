@@ -0,0 +1,68 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+type DelegationJSONPacker struct {
+	jsonenc.HintedHead
+	DG base.Address `json:"delegate"`
+	DI string       `json:"documentid,omitempty"`
+	DT hint.Type    `json:"doctype,omitempty"`
+	EX base.Height  `json:"expiry,omitempty"`
+}
+
+func (dg Delegation) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DelegationJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(dg.Hint()),
+		DG:         dg.delegate,
+		DI:         dg.documentid,
+		DT:         dg.docType,
+		EX:         dg.expiry,
+	})
+}
+
+type DelegationJSONUnpacker struct {
+	DG base.AddressDecoder `json:"delegate"`
+	DI string              `json:"documentid"`
+	DT string              `json:"doctype"`
+	EX base.Height         `json:"expiry"`
+}
+
+func (dg *Delegation) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var udg DelegationJSONUnpacker
+	if err := enc.Unmarshal(b, &udg); err != nil {
+		return err
+	}
+
+	return dg.unpack(enc, udg.DG, udg.DI, udg.DT, udg.EX)
+}
+
+type DocumentDelegatesJSONPacker struct {
+	jsonenc.HintedHead
+	DS []Delegation `json:"delegations"`
+}
+
+func (dgs DocumentDelegates) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DocumentDelegatesJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(dgs.Hint()),
+		DS:         dgs.delegations,
+	})
+}
+
+type DocumentDelegatesJSONUnpacker struct {
+	DS json.RawMessage `json:"delegations"`
+}
+
+func (dgs *DocumentDelegates) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var udgs DocumentDelegatesJSONUnpacker
+	if err := enc.Unmarshal(b, &udgs); err != nil {
+		return err
+	}
+
+	return dgs.unpack(enc, udgs.DS)
+}
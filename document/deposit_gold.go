@@ -0,0 +1,161 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	DepositGoldFactType   = hint.Type("mitum-deposit-gold-operation-fact")
+	DepositGoldFactHint   = hint.NewHint(DepositGoldFactType, "v0.0.1")
+	DepositGoldFactHinter = DepositGoldFact{BaseHinter: hint.NewBaseHinter(DepositGoldFactHint)}
+	DepositGoldType       = hint.Type("mitum-deposit-gold-operation")
+	DepositGoldHint       = hint.NewHint(DepositGoldType, "v0.0.1")
+	DepositGoldHinter     = DepositGold{BaseOperation: operationHinter(DepositGoldHint)}
+)
+
+var MaxDepositGoldItems uint = 10
+
+type DepositGoldItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Amount() uint
+	Currency() currency.CurrencyID
+	Rebuild() DepositGoldItem
+}
+
+type DepositGoldFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []DepositGoldItem
+}
+
+func NewDepositGoldFact(token []byte, sender base.Address, items []DepositGoldItem) DepositGoldFact {
+	fact := DepositGoldFact{
+		BaseHinter: hint.NewBaseHinter(DepositGoldFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact DepositGoldFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact DepositGoldFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DepositGoldFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact DepositGoldFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for DepositGoldFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxDepositGoldItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxDepositGoldItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact DepositGoldFact) Token() []byte {
+	return fact.token
+}
+
+func (fact DepositGoldFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DepositGoldFact) Items() []DepositGoldItem {
+	return fact.items
+}
+
+func (fact DepositGoldFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact DepositGoldFact) Rebuild() DepositGoldFact {
+	items := make([]DepositGoldItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type DepositGold struct {
+	currency.BaseOperation
+}
+
+func NewDepositGold(fact DepositGoldFact, fs []base.FactSign, memo string) (DepositGold, error) {
+	bo, err := currency.NewBaseOperationFromFact(DepositGoldHint, fact, fs, memo)
+	if err != nil {
+		return DepositGold{}, err
+	} else {
+
+		return DepositGold{BaseOperation: bo}, nil
+	}
+}
@@ -0,0 +1,79 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	DepositGoldItemImplType   = hint.Type("mitum-deposit-gold-item")
+	DepositGoldItemImplHint   = hint.NewHint(DepositGoldItemImplType, "v0.0.1")
+	DepositGoldItemImplHinter = DepositGoldItemImpl{BaseHinter: hint.NewBaseHinter(DepositGoldItemImplHint)}
+)
+
+// DepositGoldItemImpl moves gold of the blockcity user document into its bank.
+type DepositGoldItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	amount     uint
+	cid        currency.CurrencyID
+}
+
+func NewDepositGoldItemImpl(
+	documentid string,
+	amount uint,
+	cid currency.CurrencyID) DepositGoldItemImpl {
+
+	return DepositGoldItemImpl{
+		BaseHinter: hint.NewBaseHinter(DepositGoldItemImplHint),
+		documentid: documentid,
+		amount:     amount,
+		cid:        cid,
+	}
+}
+
+func (it DepositGoldItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		util.UintToBytes(it.amount),
+		it.cid.Bytes(),
+	)
+}
+
+func (it DepositGoldItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid DepositGoldItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid DepositGoldItem: empty documentid")
+	}
+
+	if it.amount < 1 {
+		return isvalid.InvalidError.Errorf("invalid DepositGoldItem: amount should be over zero")
+	}
+
+	return nil
+}
+
+func (it DepositGoldItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it DepositGoldItemImpl) Amount() uint {
+	return it.amount
+}
+
+func (it DepositGoldItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it DepositGoldItemImpl) Rebuild() DepositGoldItem {
+	return it
+}
@@ -0,0 +1,32 @@
+package document // nolint:dupl
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it DepositGoldItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"amount":     it.amount,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type DepositGoldItemImplBSONUnpacker struct {
+	DI string `bson:"documentid"`
+	AM uint   `bson:"amount"`
+	CI string `bson:"currency"`
+}
+
+func (it *DepositGoldItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u DepositGoldItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.AM, u.CI)
+}
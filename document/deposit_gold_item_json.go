@@ -0,0 +1,37 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type DepositGoldItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	AM uint                `json:"amount"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it DepositGoldItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DepositGoldItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		AM:         it.amount,
+		CI:         it.cid,
+	})
+}
+
+type DepositGoldItemImplJSONUnpacker struct {
+	DI string `json:"documentid"`
+	AM uint   `json:"amount"`
+	CI string `json:"currency"`
+}
+
+func (it *DepositGoldItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var u DepositGoldItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.AM, u.CI)
+}
@@ -0,0 +1,223 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var DepositGoldProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DepositGoldProcessor)
+	},
+}
+
+func (op DepositGold) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type DepositGoldProcessor struct {
+	cp *currency.CurrencyPool
+	DepositGold
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewDepositGoldProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(DepositGold)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not DepositGold, %T", op)
+		}
+
+		opp := DepositGoldProcessorPool.Get().(*DepositGoldProcessor)
+
+		opp.cp = cp
+		opp.DepositGold = i
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *DepositGoldProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(DepositGoldFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if _, err := opp.depositGold(getState); err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *DepositGoldProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(DepositGoldFact)
+
+	sts, err := opp.depositGold(getState)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process deposit gold: %w", err)
+	}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// depositGold returns the user document states, which gold is moved into the
+// bank.
+func (opp *DepositGoldProcessor) depositGold(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(DepositGoldFact)
+
+	gl := newGoldLedger()
+	for i := range fact.items {
+		it := fact.items[i]
+
+		doc, err := gl.load(fact.sender, it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		ndoc, err := doc.depositGold(it.Amount())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+		gl.set(ndoc)
+	}
+
+	return gl.states()
+}
+
+func (opp *DepositGoldProcessor) Close() error {
+	opp.cp = nil
+	opp.DepositGold = DepositGold{}
+	opp.sb = nil
+	opp.required = nil
+
+	DepositGoldProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *DepositGoldProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(DepositGoldFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
+
+// goldLedger keeps the user documents, which gold is moved by the items of
+// gold operation, so the items on the same document are applied in order and
+// all the documents are set at once.
+type goldLedger struct {
+	keys []string
+	sts  map[string]state.State
+	docs map[string]BCUserData
+}
+
+func newGoldLedger() *goldLedger {
+	return &goldLedger{
+		sts:  map[string]state.State{},
+		docs: map[string]BCUserData{},
+	}
+}
+
+// load returns the user document; when owner is given, the document should be
+// owned by it.
+func (gl *goldLedger) load(
+	owner base.Address,
+	documentid string,
+	getState func(key string) (state.State, bool, error),
+) (BCUserData, error) {
+	doc, found := gl.docs[documentid]
+	if !found {
+		st, err := existsState(StateKeyDocumentData(documentid), "document", getState)
+		if err != nil {
+			return BCUserData{}, err
+		}
+
+		dd, err := StateDocumentDataValue(st)
+		if err != nil {
+			return BCUserData{}, err
+		}
+
+		if IsArchivedDocumentData(dd) {
+			return BCUserData{}, operation.NewBaseReasonError("document already removed, %q", documentid)
+		}
+
+		i, ok := dd.(BCUserData)
+		if !ok {
+			return BCUserData{}, operation.NewBaseReasonError("not user document, %q", documentid)
+		}
+
+		gl.keys = append(gl.keys, documentid)
+		gl.sts[documentid] = st
+		gl.docs[documentid] = i
+		doc = i
+	}
+
+	if owner != nil && !doc.Owner().Equal(owner) {
+		return BCUserData{}, operation.NewBaseReasonError("sender not matched with Owner in document, %v", owner)
+	}
+
+	return doc, nil
+}
+
+func (gl *goldLedger) set(doc BCUserData) {
+	gl.docs[doc.DocumentId()] = doc
+}
+
+func (gl *goldLedger) states() ([]state.State, error) {
+	sts := make([]state.State, len(gl.keys))
+	for i := range gl.keys {
+		st, err := SetStateDocumentDataValue(gl.sts[gl.keys[i]], gl.docs[gl.keys[i]])
+		if err != nil {
+			return nil, err
+		}
+		sts[i] = st
+	}
+
+	return sts, nil
+}
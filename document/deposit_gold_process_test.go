@@ -0,0 +1,82 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testDepositGoldProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testDepositGoldProcessor) newDepositGold(sender testAccount, documentid string, amount uint) DepositGold {
+	items := []DepositGoldItem{NewDepositGoldItemImpl(documentid, amount, t.cid)}
+	fact := NewDepositGoldFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewDepositGold(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testDepositGoldProcessor) newWithdrawGold(sender testAccount, documentid string, amount uint) WithdrawGold {
+	items := []WithdrawGoldItem{NewWithdrawGoldItemImpl(documentid, amount, t.cid)}
+	fact := NewWithdrawGoldFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewWithdrawGold(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testDepositGoldProcessor) TestDepositAndWithdraw() {
+	owner, sts0 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 100, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	pool, opr := t.statepool(sts0, dsts)
+
+	errs := t.process(opr, t.newDepositGold(owner, doc.DocumentId(), 30))
+	t.NoError(errs[0])
+
+	deposited := t.updatedDocument(pool, doc.DocumentId()).(BCUserData)
+	t.Equal(uint(70), deposited.Gold())
+	t.Equal(uint(30), deposited.BankGold())
+
+	pool, opr = t.nextStatepool(pool, sts0, dsts)
+
+	errs = t.process(opr, t.newWithdrawGold(owner, doc.DocumentId(), 10))
+	t.NoError(errs[0])
+
+	withdrawn := t.updatedDocument(pool, doc.DocumentId()).(BCUserData)
+	t.Equal(uint(80), withdrawn.Gold())
+	t.Equal(uint(20), withdrawn.BankGold())
+
+	_, opr = t.nextStatepool(pool, sts0, dsts)
+
+	errs = t.process(opr, t.newWithdrawGold(owner, doc.DocumentId(), 50))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "not enough bank gold")
+}
+
+func (t *testDepositGoldProcessor) TestDepositNotOwned() {
+	owner, sts0 := t.newAccount()
+	other, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 100, 0)
+
+	_, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newDepositGold(other, doc.DocumentId(), 30))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender not matched with Owner")
+}
+
+func TestDepositGoldProcessor(t *testing.T) {
+	suite.Run(t, new(testDepositGoldProcessor))
+}
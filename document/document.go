@@ -142,7 +142,7 @@ func NewDocInfo(id string, docType hint.Type) DocInfo {
 	case BCHistoryDataType:
 		i = NewHistoryDocId(id)
 	default:
-		return DocInfo{}
+		i = NewGeneralDocId(id)
 	}
 
 	docInfo := DocInfo{
@@ -153,6 +153,17 @@ func NewDocInfo(id string, docType hint.Type) DocInfo {
 	return docInfo
 }
 
+// NewGeneralDocInfo returns DocInfo of the document type registered by
+// RegisterDocumentType; whether the type is registered is checked by the
+// operation processors with the registry state.
+func NewGeneralDocInfo(id string, docType hint.Type) DocInfo {
+	return DocInfo{
+		BaseHinter: hint.NewBaseHinter(DocInfoHint),
+		id:         NewGeneralDocId(id),
+		docType:    docType,
+	}
+}
+
 func MustNewDocInfo(id string, docType hint.Type) DocInfo {
 	docInfo := NewDocInfo(id, docType)
 	if err := docInfo.IsValid(nil); err != nil {
@@ -255,19 +266,78 @@ var (
 	DocSignHinter = DocSign{BaseHinter: hint.NewBaseHinter(DocSignHint)}
 )
 
+type DocSignStatus string
+
+const (
+	DocSignStatusPending  DocSignStatus = "pending"
+	DocSignStatusSigned   DocSignStatus = "signed"
+	DocSignStatusDeclined DocSignStatus = "declined"
+	DocSignStatusRevoked  DocSignStatus = "revoked"
+)
+
+func (st DocSignStatus) Bytes() []byte {
+	// NOTE pending and signed keep the bytes of the previous boolean signed.
+	switch st {
+	case DocSignStatusSigned:
+		return []byte{byte(1)}
+	case DocSignStatusDeclined:
+		return []byte{byte(2)}
+	case DocSignStatusRevoked:
+		return []byte{byte(3)}
+	default:
+		return []byte{byte(0)}
+	}
+}
+
+func (st DocSignStatus) String() string {
+	return string(st)
+}
+
+func (st DocSignStatus) IsValid([]byte) error {
+	switch st {
+	case DocSignStatusPending, DocSignStatusSigned, DocSignStatusDeclined, DocSignStatusRevoked:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown DocSign status, %q", st)
+	}
+}
+
+// CanChangeTo checks the status transition of signer. pending can be signed
+// or declined, signed can be revoked and revoked can be signed or declined
+// again; declined is final.
+func (st DocSignStatus) CanChangeTo(b DocSignStatus) bool {
+	switch st {
+	case DocSignStatusPending, DocSignStatusRevoked:
+		return b == DocSignStatusSigned || b == DocSignStatusDeclined
+	case DocSignStatusSigned:
+		return b == DocSignStatusRevoked
+	default:
+		return false
+	}
+}
+
 type DocSign struct {
 	hint.BaseHinter
 	address  base.Address
 	signcode string
-	signed   bool
+	status   DocSignStatus
 }
 
 func NewDocSign(address base.Address, signcode string, signed bool) DocSign {
+	status := DocSignStatusPending
+	if signed {
+		status = DocSignStatusSigned
+	}
+
+	return NewDocSignWithStatus(address, signcode, status)
+}
+
+func NewDocSignWithStatus(address base.Address, signcode string, status DocSignStatus) DocSign {
 	doc := DocSign{
 		BaseHinter: hint.NewBaseHinter(DocSignHint),
 		address:    address,
 		signcode:   signcode,
-		signed:     signed,
+		status:     status,
 	}
 	return doc
 }
@@ -287,12 +357,8 @@ func (doc DocSign) Address() base.Address {
 func (ds DocSign) Bytes() []byte {
 	bs := make([][]byte, 3)
 	bs[0] = ds.address.Bytes()
-	var v int8
-	if ds.signed {
-		v = 1
-	}
 	bs[1] = []byte(ds.signcode)
-	bs[2] = []byte{byte(v)}
+	bs[2] = ds.status.Bytes()
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -309,7 +375,7 @@ func (ds DocSign) Hint() hint.Hint {
 }
 
 func (ds DocSign) IsValid([]byte) error {
-	return nil
+	return ds.status.IsValid(nil)
 }
 
 func (ds DocSign) IsEmpty() bool {
@@ -317,8 +383,7 @@ func (ds DocSign) IsEmpty() bool {
 }
 
 func (ds DocSign) String() string {
-	v := fmt.Sprintf("%v", ds.signed)
-	return fmt.Sprintf("%s:%s", ds.address.String(), v)
+	return fmt.Sprintf("%s:%s", ds.address.String(), ds.status)
 }
 
 func (ds DocSign) Equal(b DocSign) bool {
@@ -331,7 +396,7 @@ func (ds DocSign) Equal(b DocSign) bool {
 		return false
 	}
 
-	if ds.signed != b.signed {
+	if ds.status != b.status {
 		return false
 	}
 
@@ -339,11 +404,25 @@ func (ds DocSign) Equal(b DocSign) bool {
 }
 
 func (ds *DocSign) Signed() bool {
-	return ds.signed
+	return ds.status == DocSignStatusSigned
 }
 
 func (ds *DocSign) SetSigned() {
-	ds.signed = true
+	ds.status = DocSignStatusSigned
+}
+
+func (ds DocSign) Status() DocSignStatus {
+	return ds.status
+}
+
+func (ds *DocSign) SetStatus(status DocSignStatus) error {
+	if !ds.status.CanChangeTo(status) {
+		return errors.Errorf("DocSign status can not be changed from %q to %q", ds.status, status)
+	}
+
+	ds.status = status
+
+	return nil
 }
 
 var MaxManifest = 100
@@ -385,6 +464,18 @@ func (vc VotingCandidate) Address() base.Address {
 	return vc.address
 }
 
+func (vc VotingCandidate) Nickname() string {
+	return vc.nickname
+}
+
+func (vc VotingCandidate) Manifest() string {
+	return vc.manifest
+}
+
+func (vc VotingCandidate) Count() uint {
+	return vc.count
+}
+
 func (vc VotingCandidate) Bytes() []byte {
 
 	return util.ConcatBytesSlice(vc.address.Bytes(), []byte(vc.nickname), []byte(vc.manifest), util.UintToBytes(vc.count))
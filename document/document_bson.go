@@ -30,17 +30,27 @@ func (doc *Document) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 }
 
 func (doc BSDocData) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"info":     doc.info,
+		"owner":    doc.owner,
+		"filehash": doc.fileHash,
+		"creator":  doc.creator,
+		"title":    doc.title,
+		"size":     doc.size,
+		"signers":  doc.signers,
+	}
+
+	if doc.IsSequential() {
+		m["signing_policy"] = doc.policy
+	}
+
+	if doc.HasDeadline() {
+		m["deadline"] = doc.deadline
+	}
+
 	return bsonenc.Marshal(bsonenc.MergeBSONM(
 		bsonenc.NewHintedDoc(doc.Hint()),
-		bson.M{
-			"info":     doc.info,
-			"owner":    doc.owner,
-			"filehash": doc.fileHash,
-			"creator":  doc.creator,
-			"title":    doc.title,
-			"size":     doc.size,
-			"signers":  doc.signers,
-		}),
+		m),
 	)
 }
 
@@ -52,6 +62,8 @@ type BSDocDataBSONUnpacker struct {
 	TL string              `bson:"title"`
 	SZ currency.Big        `bson:"size"`
 	SG bson.Raw            `bson:"signers"`
+	DL base.Height         `bson:"deadline,omitempty"`
+	PL string              `bson:"signing_policy,omitempty"`
 }
 
 func (doc *BSDocData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -60,7 +72,7 @@ func (doc *BSDocData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, udoc.DI, udoc.OW, udoc.FH, udoc.CR, udoc.TL, udoc.SZ, udoc.SG)
+	return doc.unpack(enc, udoc.DI, udoc.OW, udoc.FH, udoc.CR, udoc.TL, udoc.SZ, udoc.SG, udoc.DL, udoc.PL)
 }
 
 func (doc BCUserData) MarshalBSON() ([]byte, error) {
@@ -94,30 +106,43 @@ func (doc *BCUserData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 }
 
 func (doc BCLandData) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"info":      doc.info,
+		"owner":     doc.owner,
+		"address":   doc.address,
+		"area":      doc.area,
+		"renter":    doc.renter,
+		"account":   doc.account,
+		"rentdate":  doc.rentdate,
+		"periodday": doc.periodday,
+	}
+
+	if doc.leaseEnd > base.Height(0) {
+		m["lease_end"] = doc.leaseEnd
+	}
+
+	if doc.HasGeometry() {
+		m["geometry"] = doc.geometry
+	}
+
 	return bsonenc.Marshal(bsonenc.MergeBSONM(
 		bsonenc.NewHintedDoc(doc.Hint()),
-		bson.M{
-			"info":      doc.info,
-			"owner":     doc.owner,
-			"address":   doc.address,
-			"area":      doc.area,
-			"renter":    doc.renter,
-			"account":   doc.account,
-			"rentdate":  doc.rentdate,
-			"periodday": doc.periodday,
-		}),
+		m),
 	)
 }
 
 type BCLandDataBSONUnpacker struct {
+	HT string              `bson:"_hint"`
 	DI bson.Raw            `bson:"info"`
 	OW base.AddressDecoder `bson:"owner"`
 	AD string              `bson:"address"`
 	AR string              `bson:"area"`
 	RT string              `bson:"renter"`
 	AC base.AddressDecoder `bson:"account"`
-	RD string              `bson:"rentdate"`
+	RD bson.RawValue       `bson:"rentdate"`
 	PD uint                `bson:"periodday"`
+	LE base.Height         `bson:"lease_end,omitempty"`
+	GM bson.Raw            `bson:"geometry,omitempty"`
 }
 
 func (doc *BCLandData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -126,34 +151,69 @@ func (doc *BCLandData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, uld.DI, uld.OW, uld.AD, uld.AR, uld.RT, uld.AC, uld.RD, uld.PD)
+	rd, err := decodeDocumentTimeBSON(uld.RD, uld.HT == BCLandDataLegacyHint.String())
+	if err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uld.DI, uld.OW, uld.AD, uld.AR, uld.RT, uld.AC, rd, uld.PD, uld.LE, uld.GM)
 }
 
 func (doc BCVotingData) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"info":         doc.info,
+		"owner":        doc.owner,
+		"round":        doc.round,
+		"endvotetime":  doc.endVoteTime,
+		"candidates":   doc.candidates,
+		"bossname":     doc.bossname,
+		"account":      doc.account,
+		"termofoffice": doc.termofoffice,
+	}
+
+	if doc.HasDeadline() {
+		m["deadline"] = doc.deadline
+	}
+
+	if doc.closed {
+		m["closed"] = doc.closed
+	}
+
+	if doc.IsSecret() {
+		m["reveal_deadline"] = doc.reveal
+	}
+
+	if doc.IsWeighted() {
+		m["weight"] = doc.weight
+		m["snapshot"] = doc.snapshot
+	}
+
+	if len(doc.weightcid) > 0 {
+		m["weight_currency"] = doc.weightcid
+	}
+
 	return bsonenc.Marshal(bsonenc.MergeBSONM(
 		bsonenc.NewHintedDoc(doc.Hint()),
-		bson.M{
-			"info":         doc.info,
-			"owner":        doc.owner,
-			"round":        doc.round,
-			"endvotetime":  doc.endVoteTime,
-			"candidates":   doc.candidates,
-			"bossname":     doc.bossname,
-			"account":      doc.account,
-			"termofoffice": doc.termofoffice,
-		}),
+		m),
 	)
 }
 
 type BCVotingDataBSONUnpacker struct {
+	HT string              `bson:"_hint"`
 	DI bson.Raw            `bson:"info"`
 	OW base.AddressDecoder `bson:"owner"`
 	RD uint                `bson:"round"`
-	VT string              `bson:"endvotetime"`
+	VT bson.RawValue       `bson:"endvotetime"`
 	CD bson.Raw            `bson:"candidates"`
 	BN string              `bson:"bossname"`
 	AC base.AddressDecoder `bson:"account"`
-	TM string              `bson:"termofoffice"`
+	TM bson.RawValue       `bson:"termofoffice"`
+	DL base.Height         `bson:"deadline,omitempty"`
+	CL bool                `bson:"closed,omitempty"`
+	RV base.Height         `bson:"reveal_deadline,omitempty"`
+	WG string              `bson:"weight,omitempty"`
+	WC string              `bson:"weight_currency,omitempty"`
+	SN base.Height         `bson:"snapshot,omitempty"`
 }
 
 func (doc *BCVotingData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -162,7 +222,18 @@ func (doc *BCVotingData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, uvd.DI, uvd.OW, uvd.RD, uvd.VT, uvd.CD, uvd.BN, uvd.AC, uvd.TM)
+	legacy := uvd.HT == BCVotingDataLegacyHint.String()
+	vt, err := decodeDocumentTimeBSON(uvd.VT, legacy)
+	if err != nil {
+		return err
+	}
+
+	tm, err := decodeDocumentTermBSON(uvd.TM, legacy)
+	if err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uvd.DI, uvd.OW, uvd.RD, vt, uvd.CD, uvd.BN, uvd.AC, tm, uvd.DL, uvd.CL, uvd.RV, uvd.WG, uvd.WC, uvd.SN)
 }
 
 func (doc BCHistoryData) MarshalBSON() ([]byte, error) {
@@ -181,11 +252,12 @@ func (doc BCHistoryData) MarshalBSON() ([]byte, error) {
 }
 
 type BCHistoryDataBSONUnpacker struct {
+	HT string              `bson:"_hint"`
 	DI bson.Raw            `bson:"info"`
 	OW base.AddressDecoder `bson:"owner"`
 	NM string              `bson:"name"`
 	AC base.AddressDecoder `bson:"account"`
-	DT string              `bson:"date"`
+	DT bson.RawValue       `bson:"date"`
 	US string              `bson:"usage"`
 	AP string              `bson:"application"`
 }
@@ -196,7 +268,34 @@ func (doc *BCHistoryData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, uhd.DI, uhd.OW, uhd.NM, uhd.AC, uhd.DT, uhd.US, uhd.AP)
+	dt, err := decodeDocumentTimeBSON(uhd.DT, uhd.HT == BCHistoryDataLegacyHint.String())
+	if err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uhd.DI, uhd.OW, uhd.NM, uhd.AC, dt, uhd.US, uhd.AP)
+}
+
+func (doc ArchivedDocumentData) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(doc.Hint()),
+		bson.M{
+			"documentdata": doc.data,
+		}),
+	)
+}
+
+type ArchivedDocumentDataBSONUnpacker struct {
+	DD bson.Raw `bson:"documentdata"`
+}
+
+func (doc *ArchivedDocumentData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uad ArchivedDocumentDataBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &uad); err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uad.DD)
 }
 
 func (us UserStatistics) MarshalBSON() ([]byte, error) {
@@ -263,7 +362,8 @@ func (ds DocSign) MarshalBSON() ([]byte, error) {
 		bson.M{
 			"address":  ds.address,
 			"signcode": ds.signcode,
-			"signed":   ds.signed,
+			"signed":   ds.Signed(),
+			"status":   ds.status,
 		}),
 	)
 }
@@ -272,6 +372,7 @@ type DocSignBSONUnpacker struct {
 	AD base.AddressDecoder `bson:"address"`
 	SC string              `bson:"signcode"`
 	SG bool                `bson:"signed"`
+	ST string              `bson:"status"`
 }
 
 func (ds *DocSign) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -280,7 +381,7 @@ func (ds *DocSign) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return ds.unpack(enc, uds.AD, uds.SC, uds.SG)
+	return ds.unpack(enc, uds.AD, uds.SC, uds.SG, uds.ST)
 }
 
 func (di VotingCandidate) MarshalBSON() ([]byte, error) {
@@ -404,3 +505,21 @@ func (di *HistoryDocId) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return di.unpack(enc, udi.BI)
 }
+
+func (di GeneralDocId) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(di.Hint()),
+		bson.M{
+			"id": di.s,
+		}),
+	)
+}
+
+func (di *GeneralDocId) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var udi DocIdBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &udi); err != nil {
+		return err
+	}
+
+	return di.unpack(enc, udi.BI)
+}
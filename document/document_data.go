@@ -105,6 +105,32 @@ var (
 	BSDocDataHinter = BSDocData{BaseHinter: hint.NewBaseHinter(BSDocDataHint)}
 )
 
+type SigningPolicy string
+
+const (
+	// SigningPolicyParallel lets signers sign in any order.
+	SigningPolicyParallel SigningPolicy = "parallel"
+	// SigningPolicySequential lets signers sign in the order of signers.
+	SigningPolicySequential SigningPolicy = "sequential"
+)
+
+func (sp SigningPolicy) Bytes() []byte {
+	return []byte(sp)
+}
+
+func (sp SigningPolicy) String() string {
+	return string(sp)
+}
+
+func (sp SigningPolicy) IsValid([]byte) error {
+	switch sp {
+	case SigningPolicyParallel, SigningPolicySequential:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown signing policy, %q", sp)
+	}
+}
+
 type BSDocData struct {
 	hint.BaseHinter
 	info     DocInfo
@@ -114,6 +140,8 @@ type BSDocData struct {
 	title    string
 	size     currency.Big
 	signers  []DocSign
+	deadline base.Height // no deadline when not over zero
+	policy   SigningPolicy
 }
 
 func NewBSDocData(info DocInfo,
@@ -133,6 +161,7 @@ func NewBSDocData(info DocInfo,
 		title:      title,
 		size:       size,
 		signers:    signers,
+		policy:     SigningPolicyParallel,
 	}
 
 	return doc
@@ -165,12 +194,27 @@ func (doc BSDocData) Bytes() []byte {
 	bs[4] = []byte(doc.title)
 	bs[5] = doc.size.Bytes()
 
-	sort.Slice(doc.signers, func(i, j int) bool {
-		return bytes.Compare(doc.signers[i].Address().Bytes(), doc.signers[j].Address().Bytes()) < 0
-	})
+	// NOTE the order of signers matters only in sequential signing
+	signers := doc.signers
+	if !doc.IsSequential() {
+		signers = make([]DocSign, len(doc.signers))
+		copy(signers, doc.signers)
 
-	for i := range doc.signers {
-		bs[i+6] = doc.signers[i].Bytes()
+		sort.Slice(signers, func(i, j int) bool {
+			return bytes.Compare(signers[i].Address().Bytes(), signers[j].Address().Bytes()) < 0
+		})
+	}
+
+	for i := range signers {
+		bs[i+6] = signers[i].Bytes()
+	}
+
+	if doc.HasDeadline() {
+		bs = append(bs, doc.deadline.Bytes())
+	}
+
+	if doc.IsSequential() {
+		bs = append(bs, doc.policy.Bytes())
 	}
 
 	return util.ConcatBytesSlice(bs...)
@@ -199,6 +243,7 @@ func (doc BSDocData) IsValid([]byte) error {
 		doc.info,
 		doc.owner,
 		doc.creator,
+		doc.policy,
 	); err != nil {
 		return isvalid.InvalidError.Errorf("invalid User Document Data: %w", err)
 	}
@@ -217,6 +262,46 @@ func (doc BSDocData) Owner() base.Address {
 	return doc.owner
 }
 
+// WithDeadline sets the block height, after which the document can not be
+// signed.
+func (doc BSDocData) WithDeadline(height base.Height) BSDocData {
+	doc.deadline = height
+
+	return doc
+}
+
+// WithSigningPolicy sets the signing policy of signers.
+func (doc BSDocData) WithSigningPolicy(policy SigningPolicy) BSDocData {
+	doc.policy = policy
+
+	return doc
+}
+
+func (doc BSDocData) SigningPolicy() SigningPolicy {
+	return doc.policy
+}
+
+func (doc BSDocData) IsSequential() bool {
+	return doc.policy == SigningPolicySequential
+}
+
+func (doc BSDocData) Deadline() base.Height {
+	return doc.deadline
+}
+
+func (doc BSDocData) HasDeadline() bool {
+	return doc.deadline > base.Height(0)
+}
+
+// IsExpired checks whether the deadline is passed at the given block height.
+func (doc BSDocData) IsExpired(height base.Height) bool {
+	return doc.HasDeadline() && height > doc.deadline
+}
+
+func (doc BSDocData) FileHash() FileHash {
+	return doc.fileHash
+}
+
 func (doc BSDocData) Creator() DocSign {
 	return doc.creator
 }
@@ -263,15 +348,35 @@ func (doc BSDocData) Equal(b BSDocData) bool {
 		return false
 	}
 
-	sort.Slice(doc.signers, func(i, j int) bool {
-		return bytes.Compare(doc.signers[i].Bytes(), doc.signers[j].Bytes()) < 0
-	})
-	sort.Slice(b.signers, func(i, j int) bool {
-		return bytes.Compare(b.signers[i].Bytes(), b.signers[j].Bytes()) < 0
-	})
+	if doc.deadline != b.deadline {
+		return false
+	}
 
-	for i := range doc.signers {
-		if !doc.signers[i].Equal(b.signers[i]) {
+	if doc.policy != b.policy {
+		return false
+	}
+
+	if len(doc.signers) != len(b.signers) {
+		return false
+	}
+
+	as, bs := doc.signers, b.signers
+	if !doc.IsSequential() {
+		as = make([]DocSign, len(doc.signers))
+		copy(as, doc.signers)
+		bs = make([]DocSign, len(b.signers))
+		copy(bs, b.signers)
+
+		sort.Slice(as, func(i, j int) bool {
+			return bytes.Compare(as[i].Bytes(), as[j].Bytes()) < 0
+		})
+		sort.Slice(bs, func(i, j int) bool {
+			return bytes.Compare(bs[i].Bytes(), bs[j].Bytes()) < 0
+		})
+	}
+
+	for i := range as {
+		if !as[i].Equal(bs[i]) {
 			return false
 		}
 	}
@@ -379,6 +484,83 @@ func (doc BCUserData) Accounts() []base.Address {
 	return nil
 }
 
+func (doc BCUserData) Gold() uint {
+	return doc.gold
+}
+
+func (doc BCUserData) BankGold() uint {
+	return doc.bankgold
+}
+
+func (doc BCUserData) Statistics() UserStatistics {
+	return doc.statistics
+}
+
+// changeStatistics returns new BCUserData, which statistics are changed by the
+// delta.
+func (doc BCUserData) changeStatistics(d StatDelta) (BCUserData, error) {
+	us, err := doc.statistics.applyStatDelta(d)
+	if err != nil {
+		return doc, errors.Wrapf(err, "failed to change statistics, %q", doc.DocumentId())
+	}
+	doc.statistics = us
+
+	return doc, nil
+}
+
+// addGold returns new BCUserData with the gold added; the gold in the bank is
+// not changed.
+func (doc BCUserData) addGold(amount uint) (BCUserData, error) {
+	if doc.gold+amount < doc.gold {
+		return doc, errors.Errorf("gold overflow, %q", doc.DocumentId())
+	}
+	doc.gold += amount
+
+	return doc, nil
+}
+
+// subGold returns new BCUserData with the gold subtracted; the gold can not be
+// negative.
+func (doc BCUserData) subGold(amount uint) (BCUserData, error) {
+	if doc.gold < amount {
+		return doc, errors.Errorf("not enough gold, %d < %d; %q", doc.gold, amount, doc.DocumentId())
+	}
+	doc.gold -= amount
+
+	return doc, nil
+}
+
+// depositGold returns new BCUserData, which gold is moved into the bank.
+func (doc BCUserData) depositGold(amount uint) (BCUserData, error) {
+	if doc.bankgold+amount < doc.bankgold {
+		return doc, errors.Errorf("bank gold overflow, %q", doc.DocumentId())
+	}
+
+	doc, err := doc.subGold(amount)
+	if err != nil {
+		return doc, err
+	}
+	doc.bankgold += amount
+
+	return doc, nil
+}
+
+// withdrawGold returns new BCUserData, which gold is moved out of the bank.
+func (doc BCUserData) withdrawGold(amount uint) (BCUserData, error) {
+	if doc.bankgold < amount {
+		return doc, errors.Errorf(
+			"not enough bank gold, %d < %d; %q", doc.bankgold, amount, doc.DocumentId())
+	}
+
+	doc, err := doc.addGold(amount)
+	if err != nil {
+		return doc, err
+	}
+	doc.bankgold -= amount
+
+	return doc, nil
+}
+
 func (doc BCUserData) Info() DocInfo {
 	return doc.info
 }
@@ -410,8 +592,12 @@ func (doc BCUserData) Equal(b BCUserData) bool {
 
 var (
 	BCLandDataType   = hint.Type("mitum-blockcity-document-land-data")
-	BCLandDataHint   = hint.NewHint(BCLandDataType, "v0.0.1")
+	BCLandDataHint   = hint.NewHint(BCLandDataType, "v0.0.2")
 	BCLandDataHinter = BCLandData{BaseHinter: hint.NewBaseHinter(BCLandDataHint)}
+	// BCLandDataLegacyHint is the hint of land document with the free-form
+	// rentdate; it is still decoded, but not accepted as new one.
+	BCLandDataLegacyHint   = hint.NewHint(BCLandDataType, "v0.0.1")
+	BCLandDataLegacyHinter = BCLandData{BaseHinter: hint.NewBaseHinter(BCLandDataLegacyHint)}
 )
 
 type BCLandData struct {
@@ -422,15 +608,17 @@ type BCLandData struct {
 	area      string
 	renter    string
 	account   base.Address
-	rentdate  string
+	rentdate  DocumentTime
 	periodday uint
+	leaseEnd  base.Height // height of block, at which the lease ends; not leased when not over zero
+	geometry  LandGeometry
 }
 
 func NewBCLandData(info DocInfo,
 	owner base.Address,
 	address, area, renter string,
 	account base.Address,
-	rentdate string,
+	rentdate DocumentTime,
 	periodday uint,
 ) BCLandData {
 	doc := BCLandData{
@@ -451,7 +639,7 @@ func MustNewBCLandData(info DocInfo,
 	owner base.Address,
 	address, area, renter string,
 	account base.Address,
-	rentdate string,
+	rentdate DocumentTime,
 	periodday uint,
 ) BCLandData {
 	doc := NewBCLandData(info, owner, address, area, renter, account, rentdate, periodday)
@@ -478,8 +666,17 @@ func (doc BCLandData) Bytes() []byte {
 	bs[3] = []byte(doc.area)
 	bs[4] = []byte(doc.renter)
 	bs[5] = doc.account.Bytes()
-	bs[6] = []byte(doc.rentdate)
+	bs[6] = doc.rentdate.Bytes()
 	bs[7] = util.UintToBytes(doc.periodday)
+
+	if doc.leaseEnd > base.Height(0) {
+		bs = append(bs, doc.leaseEnd.Bytes())
+	}
+
+	if doc.HasGeometry() {
+		bs = append(bs, doc.geometry.Bytes())
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -504,6 +701,19 @@ func (doc BCLandData) IsValid([]byte) error {
 	); err != nil {
 		return errors.Wrap(err, "Invalid Land document data")
 	}
+
+	if !doc.IsLegacy() {
+		if err := doc.rentdate.IsValid(nil); err != nil {
+			return errors.Wrap(err, "Invalid Land document data")
+		}
+	}
+
+	if doc.HasGeometry() {
+		if err := doc.geometry.IsValid(nil); err != nil {
+			return errors.Wrap(err, "Invalid Land document data")
+		}
+	}
+
 	return nil
 }
 
@@ -519,6 +729,81 @@ func (doc BCLandData) Owner() base.Address {
 	return doc.owner
 }
 
+func (doc BCLandData) Address() string {
+	return doc.address
+}
+
+func (doc BCLandData) Area() string {
+	return doc.area
+}
+
+func (doc BCLandData) Renter() string {
+	return doc.renter
+}
+
+func (doc BCLandData) Account() base.Address {
+	return doc.account
+}
+
+func (doc BCLandData) RentDate() DocumentTime {
+	return doc.rentdate
+}
+
+func (doc BCLandData) PeriodDay() uint {
+	return doc.periodday
+}
+
+// IsLegacy checks whether the land document is of the v0.0.1 hint.
+func (doc BCLandData) IsLegacy() bool {
+	return doc.Hint().Equal(BCLandDataLegacyHint)
+}
+
+// LeaseEnd returns the height of block, at which the lease of land ends.
+func (doc BCLandData) LeaseEnd() base.Height {
+	return doc.leaseEnd
+}
+
+// IsLeased checks whether the land is leased at the given block height. The
+// expired lease does not need EndLease; the land can be rented again.
+func (doc BCLandData) IsLeased(height base.Height) bool {
+	return height < doc.leaseEnd
+}
+
+// WithGeometry returns new BCLandData with the location of land.
+func (doc BCLandData) WithGeometry(geometry LandGeometry) BCLandData {
+	doc.geometry = geometry
+
+	return doc
+}
+
+func (doc BCLandData) Geometry() LandGeometry {
+	return doc.geometry
+}
+
+func (doc BCLandData) HasGeometry() bool {
+	return !doc.geometry.IsEmpty()
+}
+
+// lease returns new BCLandData, which is leased to the renter until the lease
+// end height.
+func (doc BCLandData) lease(renter base.Address, name string, end base.Height) BCLandData {
+	doc.account = renter
+	doc.renter = name
+	doc.leaseEnd = end
+
+	return doc
+}
+
+// endLease returns new BCLandData, which lease is ended; the account of land
+// becomes the owner again.
+func (doc BCLandData) endLease() BCLandData {
+	doc.account = doc.owner
+	doc.renter = ""
+	doc.leaseEnd = base.Height(0)
+
+	return doc
+}
+
 func (doc BCLandData) Equal(b BCLandData) bool {
 
 	if !doc.info.Equal(b.info) {
@@ -541,7 +826,7 @@ func (doc BCLandData) Equal(b BCLandData) bool {
 		return false
 	}
 
-	if doc.rentdate != b.rentdate {
+	if !doc.rentdate.Equal(b.rentdate) {
 		return false
 	}
 
@@ -549,13 +834,37 @@ func (doc BCLandData) Equal(b BCLandData) bool {
 		return false
 	}
 
+	if doc.leaseEnd != b.leaseEnd {
+		return false
+	}
+
+	if !doc.geometry.Equal(b.geometry) {
+		return false
+	}
+
 	return true
 }
 
+type VotingPhase string
+
+const (
+	VotingPhaseNone   VotingPhase = "none"   // no vote deadline
+	VotingPhaseVoting VotingPhase = "voting" // votes are cast in public
+	VotingPhaseCommit VotingPhase = "commit" // votes are committed in secret
+	VotingPhaseReveal VotingPhase = "reveal" // committed votes are revealed
+	VotingPhaseEnded  VotingPhase = "ended"  // waiting CloseVoting
+	VotingPhaseClosed VotingPhase = "closed" // closed by CloseVoting
+)
+
 var (
 	BCVotingDataType   = hint.Type("mitum-blockcity-document-voting-data")
-	BCVotingDataHint   = hint.NewHint(BCVotingDataType, "v0.0.1")
+	BCVotingDataHint   = hint.NewHint(BCVotingDataType, "v0.0.2")
 	BCVotingDataHinter = BCVotingData{BaseHinter: hint.NewBaseHinter(BCVotingDataHint)}
+	// BCVotingDataLegacyHint is the hint of voting document with the free-form
+	// endVoteTime and termofoffice; it is still decoded, but not accepted as
+	// new one.
+	BCVotingDataLegacyHint   = hint.NewHint(BCVotingDataType, "v0.0.1")
+	BCVotingDataLegacyHinter = BCVotingData{BaseHinter: hint.NewBaseHinter(BCVotingDataLegacyHint)}
 )
 
 type BCVotingData struct {
@@ -563,21 +872,27 @@ type BCVotingData struct {
 	info         DocInfo
 	owner        base.Address
 	round        uint
-	endVoteTime  string
+	endVoteTime  DocumentTime
 	candidates   []VotingCandidate
 	bossname     string
 	account      base.Address
-	termofoffice string
+	termofoffice DocumentTerm
+	deadline     base.Height // no vote deadline when not over zero
+	closed       bool        // closed by CloseVoting
+	reveal       base.Height // secret ballot with reveal deadline when over zero
+	weight       VotingWeight
+	weightcid    currency.CurrencyID // currency of balance weight
+	snapshot     base.Height         // height of block, in which the round opened
 }
 
 func NewBCVotingData(info DocInfo,
 	owner base.Address,
 	round uint,
-	endVoteTime string,
+	endVoteTime DocumentTime,
 	candidates []VotingCandidate,
 	bossname string,
 	account base.Address,
-	termofoffice string,
+	termofoffice DocumentTerm,
 ) BCVotingData {
 	doc := BCVotingData{
 		BaseHinter:   hint.NewBaseHinter(BCVotingDataHint),
@@ -597,11 +912,11 @@ func MustNewBCVotingData(
 	info DocInfo,
 	owner base.Address,
 	round uint,
-	endVoteTime string,
+	endVoteTime DocumentTime,
 	candidates []VotingCandidate,
 	bossname string,
 	account base.Address,
-	termofoffice string,
+	termofoffice DocumentTerm,
 ) BCVotingData {
 	doc := NewBCVotingData(info, owner, round, endVoteTime, candidates, bossname, account, termofoffice)
 	if err := doc.IsValid(nil); err != nil {
@@ -628,13 +943,30 @@ func (doc BCVotingData) Bytes() []byte {
 	bs[0] = doc.info.Bytes()
 	bs[1] = doc.owner.Bytes()
 	bs[2] = util.UintToBytes(doc.round)
-	bs[3] = []byte(doc.endVoteTime)
+	bs[3] = doc.endVoteTime.Bytes()
 	bs[4] = []byte(doc.bossname)
 	bs[5] = doc.account.Bytes()
-	bs[6] = []byte(doc.termofoffice)
+	bs[6] = doc.termofoffice.Bytes()
 	for i := range doc.candidates {
 		bs[i+7] = doc.candidates[i].Bytes()
 	}
+
+	if doc.HasDeadline() {
+		bs = append(bs, doc.deadline.Bytes())
+	}
+
+	if doc.closed {
+		bs = append(bs, []byte{1})
+	}
+
+	if doc.IsSecret() {
+		bs = append(bs, doc.reveal.Bytes())
+	}
+
+	if doc.IsWeighted() {
+		bs = append(bs, doc.weight.Bytes(), doc.weightcid.Bytes(), doc.snapshot.Bytes())
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -660,12 +992,39 @@ func (doc BCVotingData) IsValid([]byte) error {
 		return errors.Wrap(err, "Invalid Voting document data")
 	}
 
+	if !doc.IsLegacy() {
+		if err := isvalid.Check(nil, false, doc.endVoteTime, doc.termofoffice); err != nil {
+			return errors.Wrap(err, "Invalid Voting document data")
+		}
+	}
+
 	for i := range doc.candidates {
 		if err := doc.candidates[i].IsValid(nil); err != nil {
 			return err
 		}
 	}
 
+	if doc.IsSecret() && doc.reveal <= doc.deadline {
+		return isvalid.InvalidError.Errorf("reveal deadline should be over vote deadline, %v <= %v", doc.reveal, doc.deadline)
+	}
+
+	if err := doc.weight.IsValid(nil); err != nil {
+		return err
+	}
+
+	switch {
+	case doc.weight == VotingWeightBalance:
+		if err := doc.weightcid.IsValid(nil); err != nil {
+			return errors.Wrap(err, "invalid currency of balance weight")
+		}
+	case len(doc.weightcid) > 0:
+		return isvalid.InvalidError.Errorf("currency of weight only for balance weight, %q", doc.weightcid)
+	}
+
+	if doc.IsWeighted() && !doc.HasDeadline() {
+		return isvalid.InvalidError.Errorf("weighted voting should have vote deadline")
+	}
+
 	return nil
 }
 
@@ -693,6 +1052,227 @@ func (doc BCVotingData) Candidates() []VotingCandidate {
 	return doc.candidates
 }
 
+func (doc BCVotingData) Candidate(a base.Address) (VotingCandidate, bool) {
+	for i := range doc.candidates {
+		if doc.candidates[i].address.Equal(a) {
+			return doc.candidates[i], true
+		}
+	}
+
+	return VotingCandidate{}, false
+}
+
+func (doc BCVotingData) Round() uint {
+	return doc.round
+}
+
+func (doc BCVotingData) EndVoteTime() DocumentTime {
+	return doc.endVoteTime
+}
+
+func (doc BCVotingData) Bossname() string {
+	return doc.bossname
+}
+
+func (doc BCVotingData) Account() base.Address {
+	return doc.account
+}
+
+func (doc BCVotingData) TermOfOffice() DocumentTerm {
+	return doc.termofoffice
+}
+
+// IsLegacy checks whether the voting document is of the v0.0.1 hint.
+func (doc BCVotingData) IsLegacy() bool {
+	return doc.Hint().Equal(BCVotingDataLegacyHint)
+}
+
+// WithDeadline sets the block height, after which the votes are not accepted.
+func (doc BCVotingData) WithDeadline(height base.Height) BCVotingData {
+	doc.deadline = height
+
+	return doc
+}
+
+func (doc BCVotingData) Deadline() base.Height {
+	return doc.deadline
+}
+
+func (doc BCVotingData) HasDeadline() bool {
+	return doc.deadline > base.Height(0)
+}
+
+// IsExpired checks whether the vote deadline is passed at the given block
+// height.
+func (doc BCVotingData) IsExpired(height base.Height) bool {
+	return doc.HasDeadline() && height > doc.deadline
+}
+
+// addVote returns new BCVotingData, which count of the candidate is
+// increased by the weight of vote.
+func (doc BCVotingData) addVote(a base.Address, weight uint) (BCVotingData, error) {
+	candidates := make([]VotingCandidate, len(doc.candidates))
+	copy(candidates, doc.candidates)
+
+	for i := range candidates {
+		if candidates[i].address.Equal(a) {
+			if candidates[i].count+weight < candidates[i].count {
+				return BCVotingData{}, errors.Errorf("count of candidate overflowed, %q", a)
+			}
+			candidates[i].count += weight
+			doc.candidates = candidates
+
+			return doc, nil
+		}
+	}
+
+	return BCVotingData{}, errors.Errorf("candidate not found, %q", a)
+}
+
+// WithRevealDeadline sets the block height, until which the committed votes
+// are revealed. With the reveal deadline, the voting becomes secret ballot;
+// the votes are committed until the vote deadline and revealed after that.
+func (doc BCVotingData) WithRevealDeadline(height base.Height) BCVotingData {
+	doc.reveal = height
+
+	return doc
+}
+
+func (doc BCVotingData) RevealDeadline() base.Height {
+	return doc.reveal
+}
+
+// IsSecret checks whether the voting is secret ballot by commit and reveal.
+func (doc BCVotingData) IsSecret() bool {
+	return doc.reveal > base.Height(0)
+}
+
+// WithWeight sets the weight of votes. With VotingWeightBalance, the balance
+// of voter in the given currency becomes the weight; with VotingWeightGold, the
+// gold of the blockcity user documents of voter.
+func (doc BCVotingData) WithWeight(weight VotingWeight, cid currency.CurrencyID) BCVotingData {
+	doc.weight = weight
+	doc.weightcid = cid
+
+	return doc
+}
+
+func (doc BCVotingData) Weight() VotingWeight {
+	return doc.weight
+}
+
+func (doc BCVotingData) WeightCurrency() currency.CurrencyID {
+	return doc.weightcid
+}
+
+// IsWeighted checks whether the count of vote is weighted by the stake of
+// voter instead of one vote per account.
+func (doc BCVotingData) IsWeighted() bool {
+	return doc.weight != VotingWeightNone
+}
+
+// Snapshot returns the height of block, in which the current round opened.
+// The weights of votes are taken from the states before the block.
+func (doc BCVotingData) Snapshot() base.Height {
+	return doc.snapshot
+}
+
+// withSnapshot sets the snapshot height of weights; it is set only by the
+// operation processors, which open the round.
+func (doc BCVotingData) withSnapshot(height base.Height) BCVotingData {
+	doc.snapshot = base.Height(0)
+	if doc.IsWeighted() {
+		doc.snapshot = height
+	}
+
+	return doc
+}
+
+// Phase returns the voting phase at the given block height.
+func (doc BCVotingData) Phase(height base.Height) VotingPhase {
+	switch {
+	case doc.closed:
+		return VotingPhaseClosed
+	case !doc.HasDeadline():
+		return VotingPhaseNone
+	case height <= doc.deadline && doc.IsSecret():
+		return VotingPhaseCommit
+	case height <= doc.deadline:
+		return VotingPhaseVoting
+	case doc.IsSecret() && height <= doc.reveal:
+		return VotingPhaseReveal
+	default:
+		return VotingPhaseEnded
+	}
+}
+
+// Closed checks whether the current round is closed by CloseVoting.
+func (doc BCVotingData) Closed() bool {
+	return doc.closed
+}
+
+// Winner returns the candidate with the most votes in the current round.
+func (doc BCVotingData) Winner() (VotingCandidate, bool) {
+	return electCandidate(doc.candidates)
+}
+
+// electCandidate returns the candidate with the most votes. The tie is broken
+// by the address; among the candidates with the same count, the candidate with
+// the lowest address string wins. electCandidate returns false when nobody
+// voted.
+func electCandidate(candidates []VotingCandidate) (VotingCandidate, bool) {
+	var winner VotingCandidate
+	var found bool
+	for i := range candidates {
+		vc := candidates[i]
+		switch {
+		case vc.count < 1:
+			continue
+		case !found,
+			vc.count > winner.count,
+			vc.count == winner.count && vc.address.String() < winner.address.String():
+			winner = vc
+			found = true
+		}
+	}
+
+	return winner, found
+}
+
+// closeRound returns new BCVotingData, which current round is closed. The
+// winner becomes the boss; without votes, the boss is not changed.
+func (doc BCVotingData) closeRound() BCVotingData {
+	if winner, found := doc.Winner(); found {
+		doc.bossname = winner.nickname
+		doc.account = winner.address
+	}
+	doc.closed = true
+
+	return doc
+}
+
+// openRound returns new BCVotingData of the next round, which opens at the
+// given block height; the counts of the candidates are reset. In secret ballot,
+// the length of reveal window is kept.
+func (doc BCVotingData) openRound(deadline, height base.Height) BCVotingData {
+	candidates := make([]VotingCandidate, len(doc.candidates))
+	for i := range doc.candidates {
+		candidates[i] = doc.candidates[i]
+		candidates[i].count = 0
+	}
+
+	if doc.IsSecret() {
+		doc.reveal = deadline + doc.reveal - doc.deadline
+	}
+
+	doc.round++
+	doc.candidates = candidates
+	doc.deadline = deadline
+	doc.closed = false
+
+	return doc.withSnapshot(height)
+}
+
 func (doc BCVotingData) Equal(b BCVotingData) bool {
 
 	if !doc.info.Equal(b.info) {
@@ -703,6 +1283,14 @@ func (doc BCVotingData) Equal(b BCVotingData) bool {
 		return false
 	}
 
+	if doc.deadline != b.deadline || doc.closed != b.closed || doc.reveal != b.reveal {
+		return false
+	}
+
+	if doc.weight != b.weight || doc.weightcid != b.weightcid || doc.snapshot != b.snapshot {
+		return false
+	}
+
 	sort.Slice(doc.candidates, func(i, j int) bool {
 		return bytes.Compare(doc.candidates[i].Bytes(), doc.candidates[j].Bytes()) < 0
 	})
@@ -721,8 +1309,12 @@ func (doc BCVotingData) Equal(b BCVotingData) bool {
 
 var (
 	BCHistoryDataType   = hint.Type("mitum-blockcity-document-history-data")
-	BCHistoryDataHint   = hint.NewHint(BCHistoryDataType, "v0.0.1")
+	BCHistoryDataHint   = hint.NewHint(BCHistoryDataType, "v0.0.2")
 	BCHistoryDataHinter = BCHistoryData{BaseHinter: hint.NewBaseHinter(BCHistoryDataHint)}
+	// BCHistoryDataLegacyHint is the hint of history document with the free-form
+	// date; it is still decoded, but not accepted as new one.
+	BCHistoryDataLegacyHint   = hint.NewHint(BCHistoryDataType, "v0.0.1")
+	BCHistoryDataLegacyHinter = BCHistoryData{BaseHinter: hint.NewBaseHinter(BCHistoryDataLegacyHint)}
 )
 
 type BCHistoryData struct {
@@ -731,7 +1323,7 @@ type BCHistoryData struct {
 	owner       base.Address
 	name        string
 	account     base.Address
-	date        string
+	date        DocumentTime
 	usage       string
 	application string
 }
@@ -740,7 +1332,8 @@ func NewBCHistoryData(info DocInfo,
 	owner base.Address,
 	name string,
 	account base.Address,
-	date, usage, application string,
+	date DocumentTime,
+	usage, application string,
 ) BCHistoryData {
 	doc := BCHistoryData{
 		BaseHinter:  hint.NewBaseHinter(BCHistoryDataHint),
@@ -759,7 +1352,8 @@ func MustNewBCHistoryData(info DocInfo,
 	owner base.Address,
 	name string,
 	account base.Address,
-	date, usage, application string,
+	date DocumentTime,
+	usage, application string,
 ) BCHistoryData {
 	doc := NewBCHistoryData(info, owner, name, account, date, usage, application)
 	if err := doc.IsValid(nil); err != nil {
@@ -783,7 +1377,7 @@ func (doc BCHistoryData) Bytes() []byte {
 	bs[1] = doc.owner.Bytes()
 	bs[2] = []byte(doc.name)
 	bs[3] = doc.account.Bytes()
-	bs[4] = []byte(doc.date)
+	bs[4] = doc.date.Bytes()
 	bs[5] = []byte(doc.usage)
 	bs[6] = []byte(doc.application)
 
@@ -812,6 +1406,13 @@ func (doc BCHistoryData) IsValid([]byte) error {
 	); err != nil {
 		return errors.Wrap(err, "Invalid history document data")
 	}
+
+	if !doc.IsLegacy() {
+		if err := doc.date.IsValid(nil); err != nil {
+			return errors.Wrap(err, "Invalid history document data")
+		}
+	}
+
 	return nil
 }
 
@@ -827,6 +1428,15 @@ func (doc BCHistoryData) Owner() base.Address {
 	return doc.owner
 }
 
+func (doc BCHistoryData) Date() DocumentTime {
+	return doc.date
+}
+
+// IsLegacy checks whether the history document is of the v0.0.1 hint.
+func (doc BCHistoryData) IsLegacy() bool {
+	return doc.Hint().Equal(BCHistoryDataLegacyHint)
+}
+
 func (doc BCHistoryData) Equal(b BCHistoryData) bool {
 
 	if !doc.info.Equal(b.info) {
@@ -841,7 +1451,7 @@ func (doc BCHistoryData) Equal(b BCHistoryData) bool {
 		return false
 	}
 
-	if doc.date != b.date {
+	if !doc.date.Equal(b.date) {
 		return false
 	}
 
@@ -855,3 +1465,126 @@ func (doc BCHistoryData) Equal(b BCHistoryData) bool {
 
 	return true
 }
+
+var (
+	ArchivedDocumentDataType   = hint.Type("mitum-document-archived-data")
+	ArchivedDocumentDataHint   = hint.NewHint(ArchivedDocumentDataType, "v0.0.1")
+	ArchivedDocumentDataHinter = ArchivedDocumentData{BaseHinter: hint.NewBaseHinter(ArchivedDocumentDataHint)}
+)
+
+// ArchivedDocumentData keeps the last DocumentData of a removed document. The
+// document id stays registered, so it can not be created again.
+type ArchivedDocumentData struct {
+	hint.BaseHinter
+	data DocumentData
+}
+
+func NewArchivedDocumentData(data DocumentData) ArchivedDocumentData {
+	return ArchivedDocumentData{
+		BaseHinter: hint.NewBaseHinter(ArchivedDocumentDataHint),
+		data:       data,
+	}
+}
+
+func (doc ArchivedDocumentData) DocumentData() DocumentData {
+	return doc.data
+}
+
+func (doc ArchivedDocumentData) DocumentId() string {
+	return doc.data.DocumentId()
+}
+
+func (doc ArchivedDocumentData) DocumentType() hint.Type {
+	return doc.data.DocumentType()
+}
+
+func (doc ArchivedDocumentData) Bytes() []byte {
+	return util.ConcatBytesSlice(doc.Hint().Type().Bytes(), doc.data.Bytes())
+}
+
+func (doc ArchivedDocumentData) Hash() valuehash.Hash {
+	return doc.GenerateHash()
+}
+
+func (doc ArchivedDocumentData) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(doc.Bytes())
+}
+
+func (doc ArchivedDocumentData) IsValid([]byte) error {
+	if doc.data == nil {
+		return isvalid.InvalidError.Errorf("empty DocumentData in archived document")
+	}
+
+	if _, ok := doc.data.(ArchivedDocumentData); ok {
+		return isvalid.InvalidError.Errorf("archived document already archived")
+	}
+
+	if err := isvalid.Check(
+		nil, false,
+		doc.BaseHinter,
+		doc.data,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid archived document data: %w", err)
+	}
+
+	return nil
+}
+
+func (doc ArchivedDocumentData) Owner() base.Address {
+	return doc.data.Owner()
+}
+
+func (doc ArchivedDocumentData) Accounts() []base.Address {
+	return doc.data.Accounts()
+}
+
+func (doc ArchivedDocumentData) Info() DocInfo {
+	return doc.data.Info()
+}
+
+func IsArchivedDocumentData(doc DocumentData) bool {
+	_, ok := doc.(ArchivedDocumentData)
+
+	return ok
+}
+
+// IsLegacyDocumentData checks whether the blockcity document data is of the
+// v0.0.1 hint, which has the free-form date strings.
+func IsLegacyDocumentData(doc DocumentData) bool {
+	switch t := doc.(type) {
+	case BCLandData:
+		return t.IsLegacy()
+	case BCVotingData:
+		return t.IsLegacy()
+	case BCHistoryData:
+		return t.IsLegacy()
+	default:
+		return false
+	}
+}
+
+// DocumentDataWithOwner returns copy of DocumentData with the new owner.
+func DocumentDataWithOwner(doc DocumentData, owner base.Address) (DocumentData, error) {
+	switch t := doc.(type) {
+	case BSDocData:
+		t.owner = owner
+		return t, nil
+	case BCUserData:
+		t.owner = owner
+		return t, nil
+	case BCLandData:
+		t.owner = owner
+		return t, nil
+	case BCVotingData:
+		t.owner = owner
+		return t, nil
+	case BCHistoryData:
+		t.owner = owner
+		return t, nil
+	case GeneralDocData:
+		t.owner = owner
+		return t, nil
+	default:
+		return nil, errors.Errorf("owner can not be changed, unknown DocumentData type, %T", doc)
+	}
+}
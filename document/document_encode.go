@@ -34,6 +34,8 @@ func (doc *BSDocData) unpack(
 	stl string,
 	sz currency.Big,
 	bsg []byte, // signers
+	dl base.Height, // deadline
+	pl string, // signing policy
 ) error {
 
 	// unpack document info
@@ -90,6 +92,14 @@ func (doc *BSDocData) unpack(
 		signers[i] = s
 	}
 	doc.signers = signers
+	doc.deadline = dl
+
+	// NOTE document without signing policy is signed in parallel
+	if len(pl) > 0 {
+		doc.policy = SigningPolicy(pl)
+	} else {
+		doc.policy = SigningPolicyParallel
+	}
 
 	return nil
 }
@@ -141,8 +151,10 @@ func (doc *BCLandData) unpack(
 	ar string, // land area
 	rt string, // renter nickname
 	ac base.AddressDecoder, //renter account address
-	rd string, // rentdate
+	rd DocumentTime, // rentdate
 	pd uint, // period day
+	le base.Height, // lease end
+	bgm []byte, // land geometry
 ) error {
 
 	// unpack document info
@@ -171,6 +183,20 @@ func (doc *BCLandData) unpack(
 	doc.renter = rt
 	doc.rentdate = rd
 	doc.periodday = pd
+	doc.leaseEnd = le
+
+	if len(bgm) > 0 {
+		hinter, err := enc.Decode(bgm)
+		if err != nil {
+			return err
+		}
+
+		i, ok := hinter.(LandGeometry)
+		if !ok {
+			return errors.Errorf("not LandGeometry: %T", hinter)
+		}
+		doc.geometry = i
+	}
 
 	return nil
 }
@@ -180,11 +206,17 @@ func (doc *BCVotingData) unpack(
 	bdi []byte,
 	ow base.AddressDecoder,
 	rd uint,
-	vt string,
+	vt DocumentTime, // end vote time
 	bcd []byte,
 	bn string,
 	ac base.AddressDecoder,
-	tm string,
+	tm DocumentTerm, // term of office
+	dl base.Height, // vote deadline
+	cl bool,
+	rv base.Height, // reveal deadline
+	wg string,
+	wc string,
+	sn base.Height, // snapshot of weights
 ) error {
 
 	// unpack document info
@@ -232,6 +264,12 @@ func (doc *BCVotingData) unpack(
 
 	doc.bossname = bn
 	doc.termofoffice = tm
+	doc.deadline = dl
+	doc.closed = cl
+	doc.reveal = rv
+	doc.weight = VotingWeight(wg)
+	doc.weightcid = currency.CurrencyID(wc)
+	doc.snapshot = sn
 
 	return nil
 }
@@ -242,7 +280,7 @@ func (doc *BCHistoryData) unpack(
 	ow base.AddressDecoder,
 	snm string, // name
 	ac base.AddressDecoder, // account address
-	sdt string, // date
+	sdt DocumentTime, // date
 	sus string, // usage
 	sap string, // application
 ) error {
@@ -276,6 +314,23 @@ func (doc *BCHistoryData) unpack(
 	return nil
 }
 
+func (doc *ArchivedDocumentData) unpack(
+	enc encoder.Encoder,
+	bdd []byte,
+) error {
+
+	// unpack archived document data
+	if hinter, err := enc.Decode(bdd); err != nil {
+		return err
+	} else if i, ok := hinter.(DocumentData); !ok {
+		return errors.Errorf("not DocumentData: %T", hinter)
+	} else {
+		doc.data = i
+	}
+
+	return nil
+}
+
 func (us *UserStatistics) unpack(
 	enc encoder.Encoder,
 	hp,
@@ -323,6 +378,7 @@ func (ds *DocSign) unpack(
 	ad base.AddressDecoder, // address
 	sc string,
 	sg bool, // signed
+	st string, // status
 ) error {
 
 	a, err := ad.Encode(enc)
@@ -331,7 +387,16 @@ func (ds *DocSign) unpack(
 	}
 	ds.address = a
 	ds.signcode = sc
-	ds.signed = sg
+
+	// NOTE DocSign without status has only signed
+	switch {
+	case len(st) > 0:
+		ds.status = DocSignStatus(st)
+	case sg:
+		ds.status = DocSignStatusSigned
+	default:
+		ds.status = DocSignStatusPending
+	}
 
 	return nil
 }
@@ -379,6 +444,17 @@ func (di *UserDocId) unpack(
 	return nil
 }
 
+func (di *GeneralDocId) unpack(
+	enc encoder.Encoder,
+	si string,
+) error {
+
+	// unpack document id
+	di.s = si
+
+	return nil
+}
+
 func (di *LandDocId) unpack(
 	enc encoder.Encoder,
 	si string,
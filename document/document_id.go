@@ -29,6 +29,8 @@ func NewDocId(id string) (did DocId) {
 		did = NewVotingDocId(id)
 	case HistoryDocIdType:
 		did = NewHistoryDocId(id)
+	case GeneralDocIdType:
+		did = NewGeneralDocId(id)
 	default:
 		did = nil
 	}
@@ -376,10 +378,68 @@ func ParseDocId(s string) (string, hint.Type, error) {
 		return "", hint.Type(""), isvalid.InvalidError.Errorf("invalid ShortType for DocId, %q", shortType)
 	}
 
-	v, ok := DocIdShortTypeMap[shortType]
-	if !ok {
-		return "", hint.Type(""), isvalid.InvalidError.Errorf("wrong ShortType of DocId : %q", shortType)
+	if v, ok := DocIdShortTypeMap[shortType]; ok {
+		return s[:len(s)-DocIdShortTypeSize], v, nil
 	}
 
-	return s[:len(s)-DocIdShortTypeSize], v, nil
+	// NOTE whether the short type is registered is checked by the operation
+	// processors with the registry state.
+	if reDocTypeShortType.MatchString(shortType) {
+		return s[:len(s)-DocIdShortTypeSize], GeneralDocIdType, nil
+	}
+
+	return "", hint.Type(""), isvalid.InvalidError.Errorf("wrong ShortType of DocId : %q", shortType)
+}
+
+var (
+	GeneralDocIdType   = hint.Type("mitum-document-general-document-id")
+	GeneralDocIdHint   = hint.NewHint(GeneralDocIdType, "v0.0.1")
+	GeneralDocIdHinter = GeneralDocId{BaseHinter: hint.NewBaseHinter(GeneralDocIdHint)}
+)
+
+// GeneralDocId is the document id of the document types registered by
+// RegisterDocumentType.
+type GeneralDocId struct {
+	hint.BaseHinter
+	s string
+}
+
+func NewGeneralDocId(id string) GeneralDocId {
+	return GeneralDocId{BaseHinter: hint.NewBaseHinter(GeneralDocIdHint), s: id}
+}
+
+// IsValid does not check the short type is registered; the registry state is
+// checked by the operation processors.
+func (ui GeneralDocId) IsValid([]byte) error {
+	if len(ui.s) <= DocIdShortTypeSize {
+		return isvalid.InvalidError.Errorf("invalid DocId, %q", ui.s)
+	}
+
+	shortType := ui.ShortType()
+	if !reDocTypeShortType.MatchString(shortType) {
+		return isvalid.InvalidError.Errorf("invalid ShortType for DocId, %q", shortType)
+	}
+
+	if _, found := DocIdShortTypeMap[shortType]; found {
+		return isvalid.InvalidError.Errorf("builtin ShortType for general DocId, %q", shortType)
+	}
+
+	return nil
+}
+
+func (ui GeneralDocId) String() string {
+	return ui.s
+}
+
+func (ui GeneralDocId) Hint() hint.Hint {
+	return ui.BaseHinter.Hint()
+}
+
+func (ui GeneralDocId) Bytes() []byte {
+	return []byte(ui.s)
+}
+
+// ShortType returns the short type of registered document type.
+func (ui GeneralDocId) ShortType() string {
+	return ui.s[len(ui.s)-DocIdShortTypeSize:]
 }
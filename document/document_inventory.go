@@ -119,18 +119,22 @@ func (div *DocumentInventory) Append(d DocInfo) error {
 	return nil
 }
 
-func (div *DocumentInventory) Romove(d DocInfo) error {
+func (div *DocumentInventory) Remove(d DocInfo) error {
 	if !div.Exists(d.id.String()) {
 		return errors.Errorf("document id %v not found in document inventory", d.id.String())
 	}
+
+	// NOTE build new slice; docInfos may share the backing array with the
+	// value of the previous state.
+	docInfos := make([]DocInfo, 0, len(div.docInfos)-1)
 	for i := range div.docInfos {
 		if d.id.String() == div.docInfos[i].id.String() {
-			div.docInfos[i] = div.docInfos[len(div.docInfos)-1]
-			div.docInfos[len(div.docInfos)-1] = DocInfo{}
-			div.docInfos = div.docInfos[:len(div.docInfos)-1]
-			return nil
+			continue
 		}
+		docInfos = append(docInfos, div.docInfos[i])
 	}
+	div.docInfos = docInfos
+
 	return nil
 }
 
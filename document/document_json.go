@@ -36,13 +36,15 @@ func (doc *Document) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 type BSDocDataJSONPacker struct {
 	jsonenc.HintedHead
-	DI DocInfo      `json:"info"`
-	OW base.Address `json:"owner"`
-	FH FileHash     `json:"filehash"`
-	CR DocSign      `json:"creator"`
-	TL string       `json:"title"`
-	SZ currency.Big `json:"size"`
-	SG []DocSign    `json:"signers"`
+	DI DocInfo       `json:"info"`
+	OW base.Address  `json:"owner"`
+	FH FileHash      `json:"filehash"`
+	CR DocSign       `json:"creator"`
+	TL string        `json:"title"`
+	SZ currency.Big  `json:"size"`
+	SG []DocSign     `json:"signers"`
+	DL base.Height   `json:"deadline,omitempty"`
+	PL SigningPolicy `json:"signing_policy"`
 }
 
 func (doc BSDocData) MarshalJSON() ([]byte, error) {
@@ -55,6 +57,8 @@ func (doc BSDocData) MarshalJSON() ([]byte, error) {
 		TL:         doc.title,
 		SZ:         doc.size,
 		SG:         doc.signers,
+		DL:         doc.deadline,
+		PL:         doc.policy,
 	})
 }
 
@@ -66,6 +70,8 @@ type BSDocDataJSONUnpacker struct {
 	TL string              `json:"title"`
 	SZ currency.Big        `json:"size"`
 	SG json.RawMessage     `json:"signers"`
+	DL base.Height         `json:"deadline,omitempty"`
+	PL string              `json:"signing_policy"`
 }
 
 func (doc *BSDocData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -74,7 +80,7 @@ func (doc *BSDocData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, udoc.DI, udoc.OW, udoc.FH, udoc.CR, udoc.TL, udoc.SZ, udoc.SG)
+	return doc.unpack(enc, udoc.DI, udoc.OW, udoc.FH, udoc.CR, udoc.TL, udoc.SZ, udoc.SG, udoc.DL, udoc.PL)
 }
 
 type UserDataJSONPacker struct {
@@ -116,17 +122,24 @@ func (doc *BCUserData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 type LandDataJSONPacker struct {
 	jsonenc.HintedHead
-	DI DocInfo      `json:"info"`
-	OW base.Address `json:"owner"`
-	AD string       `json:"address"`
-	AR string       `json:"area"`
-	RT string       `json:"renter"`
-	AC base.Address `json:"account"`
-	RD string       `json:"rentdate"`
-	PD uint         `json:"periodday"`
+	DI DocInfo       `json:"info"`
+	OW base.Address  `json:"owner"`
+	AD string        `json:"address"`
+	AR string        `json:"area"`
+	RT string        `json:"renter"`
+	AC base.Address  `json:"account"`
+	RD DocumentTime  `json:"rentdate"`
+	PD uint          `json:"periodday"`
+	LE base.Height   `json:"lease_end,omitempty"`
+	GM *LandGeometry `json:"geometry,omitempty"`
 }
 
 func (doc BCLandData) MarshalJSON() ([]byte, error) {
+	var gm *LandGeometry
+	if doc.HasGeometry() {
+		gm = &doc.geometry
+	}
+
 	return jsonenc.Marshal(LandDataJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(doc.Hint()),
 		DI:         doc.info,
@@ -137,10 +150,13 @@ func (doc BCLandData) MarshalJSON() ([]byte, error) {
 		AC:         doc.account,
 		RD:         doc.rentdate,
 		PD:         doc.periodday,
+		LE:         doc.leaseEnd,
+		GM:         gm,
 	})
 }
 
 type LandDataJSONUnpacker struct {
+	HT string              `json:"_hint"`
 	DI json.RawMessage     `json:"info"`
 	OW base.AddressDecoder `json:"owner"`
 	AD string              `json:"address"`
@@ -149,6 +165,8 @@ type LandDataJSONUnpacker struct {
 	AC base.AddressDecoder `json:"account"`
 	RD string              `json:"rentdate"`
 	PD uint                `json:"periodday"`
+	LE base.Height         `json:"lease_end,omitempty"`
+	GM json.RawMessage     `json:"geometry,omitempty"`
 }
 
 func (doc *BCLandData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -157,7 +175,12 @@ func (doc *BCLandData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, uld.DI, uld.OW, uld.AD, uld.AR, uld.RT, uld.AC, uld.RD, uld.PD)
+	rd, err := decodeDocumentTime(uld.RD, uld.HT == BCLandDataLegacyHint.String())
+	if err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uld.DI, uld.OW, uld.AD, uld.AR, uld.RT, uld.AC, rd, uld.PD, uld.LE, uld.GM)
 }
 
 type VotingDataJSONPacker struct {
@@ -165,11 +188,17 @@ type VotingDataJSONPacker struct {
 	DI DocInfo           `json:"info"`
 	OW base.Address      `json:"owner"`
 	RD uint              `json:"round"`
-	VT string            `json:"endvotetime"`
+	VT DocumentTime      `json:"endvotetime"`
 	CD []VotingCandidate `json:"candidates"`
 	BN string            `json:"bossname"`
 	AC base.Address      `json:"account"`
-	TM string            `json:"termofoffice"`
+	TM DocumentTerm      `json:"termofoffice"`
+	DL base.Height       `json:"deadline,omitempty"`
+	CL bool              `json:"closed,omitempty"`
+	RV base.Height       `json:"reveal_deadline,omitempty"`
+	WG VotingWeight      `json:"weight,omitempty"`
+	WC string            `json:"weight_currency,omitempty"`
+	SN base.Height       `json:"snapshot,omitempty"`
 }
 
 func (doc BCVotingData) MarshalJSON() ([]byte, error) {
@@ -183,10 +212,17 @@ func (doc BCVotingData) MarshalJSON() ([]byte, error) {
 		BN:         doc.bossname,
 		AC:         doc.account,
 		TM:         doc.termofoffice,
+		DL:         doc.deadline,
+		CL:         doc.closed,
+		RV:         doc.reveal,
+		WG:         doc.weight,
+		WC:         doc.weightcid.String(),
+		SN:         doc.snapshot,
 	})
 }
 
 type VotingDataJSONUnpacker struct {
+	HT string              `json:"_hint"`
 	DI json.RawMessage     `json:"info"`
 	OW base.AddressDecoder `json:"owner"`
 	RD uint                `json:"round"`
@@ -195,6 +231,12 @@ type VotingDataJSONUnpacker struct {
 	BN string              `json:"bossname"`
 	AC base.AddressDecoder `json:"account"`
 	TM string              `json:"termofoffice"`
+	DL base.Height         `json:"deadline,omitempty"`
+	CL bool                `json:"closed,omitempty"`
+	RV base.Height         `json:"reveal_deadline,omitempty"`
+	WG string              `json:"weight,omitempty"`
+	WC string              `json:"weight_currency,omitempty"`
+	SN base.Height         `json:"snapshot,omitempty"`
 }
 
 func (doc *BCVotingData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -203,7 +245,18 @@ func (doc *BCVotingData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, uvd.DI, uvd.OW, uvd.RD, uvd.VT, uvd.CD, uvd.BN, uvd.AC, uvd.TM)
+	legacy := uvd.HT == BCVotingDataLegacyHint.String()
+	vt, err := decodeDocumentTime(uvd.VT, legacy)
+	if err != nil {
+		return err
+	}
+
+	tm, err := decodeDocumentTerm(uvd.TM, legacy)
+	if err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uvd.DI, uvd.OW, uvd.RD, vt, uvd.CD, uvd.BN, uvd.AC, tm, uvd.DL, uvd.CL, uvd.RV, uvd.WG, uvd.WC, uvd.SN)
 }
 
 type HistoryDataJSONPacker struct {
@@ -212,7 +265,7 @@ type HistoryDataJSONPacker struct {
 	OW base.Address `json:"owner"`
 	NM string       `json:"name"`
 	AC base.Address `json:"account"`
-	DT string       `json:"date"`
+	DT DocumentTime `json:"date"`
 	US string       `json:"usage"`
 	AP string       `json:"application"`
 }
@@ -231,6 +284,7 @@ func (doc BCHistoryData) MarshalJSON() ([]byte, error) {
 }
 
 type HistoryDataJSONUnpacker struct {
+	HT string              `json:"_hint"`
 	DI json.RawMessage     `json:"info"`
 	OW base.AddressDecoder `json:"owner"`
 	NM string              `json:"name"`
@@ -246,7 +300,37 @@ func (doc *BCHistoryData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return doc.unpack(enc, uhd.DI, uhd.OW, uhd.NM, uhd.AC, uhd.DT, uhd.US, uhd.AP)
+	dt, err := decodeDocumentTime(uhd.DT, uhd.HT == BCHistoryDataLegacyHint.String())
+	if err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uhd.DI, uhd.OW, uhd.NM, uhd.AC, dt, uhd.US, uhd.AP)
+}
+
+type ArchivedDocumentDataJSONPacker struct {
+	jsonenc.HintedHead
+	DD DocumentData `json:"documentdata"`
+}
+
+func (doc ArchivedDocumentData) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ArchivedDocumentDataJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(doc.Hint()),
+		DD:         doc.data,
+	})
+}
+
+type ArchivedDocumentDataJSONUnpacker struct {
+	DD json.RawMessage `json:"documentdata"`
+}
+
+func (doc *ArchivedDocumentData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uad ArchivedDocumentDataJSONUnpacker
+	if err := enc.Unmarshal(b, &uad); err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, uad.DD)
 }
 
 type UserStatisticsJSONPacker struct {
@@ -322,9 +406,10 @@ func (di *DocInfo) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 type DocSignJSONPacker struct {
 	jsonenc.HintedHead
-	AD base.Address `json:"address"`
-	SC string       `json:"signcode"`
-	SG bool         `json:"signed"`
+	AD base.Address  `json:"address"`
+	SC string        `json:"signcode"`
+	SG bool          `json:"signed"`
+	ST DocSignStatus `json:"status"`
 }
 
 func (ds DocSign) MarshalJSON() ([]byte, error) {
@@ -332,7 +417,8 @@ func (ds DocSign) MarshalJSON() ([]byte, error) {
 		HintedHead: jsonenc.NewHintedHead(ds.Hint()),
 		AD:         ds.address,
 		SC:         ds.signcode,
-		SG:         ds.signed,
+		SG:         ds.Signed(),
+		ST:         ds.status,
 	})
 }
 
@@ -340,6 +426,7 @@ type DocSignJSONUnpacker struct {
 	AD base.AddressDecoder `json:"address"`
 	SC string              `json:"signcode"`
 	SG bool                `json:"signed"`
+	ST string              `json:"status"`
 }
 
 func (ds *DocSign) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -348,7 +435,7 @@ func (ds *DocSign) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return ds.unpack(enc, uds.AD, uds.SC, uds.SG)
+	return ds.unpack(enc, uds.AD, uds.SC, uds.SG, uds.ST)
 }
 
 type VotingCandidatesJSONPacker struct {
@@ -473,3 +560,19 @@ func (di *HistoryDocId) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return di.unpack(enc, udi.SI)
 }
+
+func (di GeneralDocId) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DocIdJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(di.Hint()),
+		SI:         di.s,
+	})
+}
+
+func (di *GeneralDocId) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var udi DocIdJSONUnpacker
+	if err := enc.Unmarshal(b, &udi); err != nil {
+		return err
+	}
+
+	return di.unpack(enc, udi.SI)
+}
@@ -0,0 +1,460 @@
+package document
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	DocumentPatchType   = hint.Type("mitum-document-patch")
+	DocumentPatchHint   = hint.NewHint(DocumentPatchType, "v0.0.1")
+	DocumentPatchHinter = DocumentPatch{BaseHinter: hint.NewBaseHinter(DocumentPatchHint)}
+)
+
+// PatchOp is the operation of DocumentPatch.
+type PatchOp string
+
+const (
+	PatchSet       PatchOp = "set"       // replace the field with value
+	PatchIncrement PatchOp = "increment" // add the signed integer value to the number field
+	PatchAppend    PatchOp = "append"    // append the element to the list field
+)
+
+func (po PatchOp) Bytes() []byte {
+	return []byte(po)
+}
+
+func (po PatchOp) String() string {
+	return string(po)
+}
+
+func (po PatchOp) IsValid([]byte) error {
+	switch po {
+	case PatchSet, PatchIncrement, PatchAppend:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown patch operation, %q", po)
+	}
+}
+
+// PatchElement is the element appended to the list field of document data,
+// like VotingCandidate or DocSign.
+type PatchElement interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+}
+
+// DocumentPatch changes one field of document data. The path is the name of
+// field; the fields of UserStatistics are prefixed by "statistics." and the
+// payload fields of general document by "payload.".
+type DocumentPatch struct {
+	hint.BaseHinter
+	op      PatchOp
+	path    string
+	value   string
+	element PatchElement
+}
+
+func NewSetPatch(path, value string) DocumentPatch {
+	return DocumentPatch{
+		BaseHinter: hint.NewBaseHinter(DocumentPatchHint),
+		op:         PatchSet,
+		path:       path,
+		value:      value,
+	}
+}
+
+func NewIncrementPatch(path string, delta int64) DocumentPatch {
+	return DocumentPatch{
+		BaseHinter: hint.NewBaseHinter(DocumentPatchHint),
+		op:         PatchIncrement,
+		path:       path,
+		value:      strconv.FormatInt(delta, 10),
+	}
+}
+
+func NewAppendPatch(path string, element PatchElement) DocumentPatch {
+	return DocumentPatch{
+		BaseHinter: hint.NewBaseHinter(DocumentPatchHint),
+		op:         PatchAppend,
+		path:       path,
+		element:    element,
+	}
+}
+
+func (dp DocumentPatch) Bytes() []byte {
+	bs := [][]byte{
+		dp.op.Bytes(),
+		util.UintToBytes(uint(len(dp.path))),
+		[]byte(dp.path),
+		util.UintToBytes(uint(len(dp.value))),
+		[]byte(dp.value),
+	}
+
+	if dp.element != nil {
+		bs = append(bs, dp.element.Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (dp DocumentPatch) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, dp.BaseHinter, dp.op); err != nil {
+		return isvalid.InvalidError.Errorf("invalid DocumentPatch: %w", err)
+	}
+
+	if len(strings.TrimSpace(dp.path)) < 1 {
+		return isvalid.InvalidError.Errorf("invalid DocumentPatch: empty path")
+	}
+
+	switch dp.op {
+	case PatchAppend:
+		if dp.element == nil {
+			return isvalid.InvalidError.Errorf("invalid DocumentPatch: empty element for append, %q", dp.path)
+		}
+
+		if err := dp.element.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid DocumentPatch: %w", err)
+		}
+	case PatchIncrement:
+		if _, err := strconv.ParseInt(dp.value, 10, 64); err != nil {
+			return isvalid.InvalidError.Errorf("invalid DocumentPatch: invalid increment, %q", dp.value)
+		}
+	}
+
+	if dp.op != PatchAppend && dp.element != nil {
+		return isvalid.InvalidError.Errorf("invalid DocumentPatch: element only for append, %q", dp.path)
+	}
+
+	return nil
+}
+
+func (dp DocumentPatch) Op() PatchOp {
+	return dp.op
+}
+
+func (dp DocumentPatch) Path() string {
+	return dp.path
+}
+
+func (dp DocumentPatch) Value() string {
+	return dp.value
+}
+
+func (dp DocumentPatch) Element() PatchElement {
+	return dp.element
+}
+
+// ApplyDocumentPatches applies the patches in order to the document data; the
+// fields changed only by the other operations, like gold or lease, can not be
+// patched.
+func ApplyDocumentPatches(doc DocumentData, patches []DocumentPatch) (DocumentData, error) {
+	for i := range patches {
+		p := patches[i]
+
+		dt := doc.DocumentType()
+
+		var err error
+		switch t := doc.(type) {
+		case BSDocData:
+			doc, err = patchBSDocData(t, p)
+		case BCUserData:
+			doc, err = patchBCUserData(t, p)
+		case BCLandData:
+			doc, err = patchBCLandData(t, p)
+		case BCVotingData:
+			doc, err = patchBCVotingData(t, p)
+		case BCHistoryData:
+			doc, err = patchBCHistoryData(t, p)
+		case GeneralDocData:
+			doc, err = patchGeneralDocData(t, p)
+		default:
+			err = isvalid.InvalidError.Errorf("document can not be patched, %T", doc)
+		}
+
+		if err != nil {
+			return nil, isvalid.InvalidError.Errorf("failed to patch %s %q of %s: %w", p.op, p.path, dt, err)
+		}
+	}
+
+	return doc, nil
+}
+
+func patchBSDocData(doc BSDocData, p DocumentPatch) (DocumentData, error) {
+	switch {
+	case p.path == "title" && p.op == PatchSet:
+		doc.title = p.value
+	case p.path == "signers" && p.op == PatchAppend:
+		ds, ok := p.element.(DocSign)
+		if !ok {
+			return nil, isvalid.InvalidError.Errorf("element should be DocSign, not %T", p.element)
+		}
+
+		signers := make([]DocSign, len(doc.signers)+1)
+		copy(signers, doc.signers)
+		signers[len(doc.signers)] = ds
+		doc.signers = signers
+	default:
+		return nil, unknownPatchError(p)
+	}
+
+	return doc, nil
+}
+
+func patchBCUserData(doc BCUserData, p DocumentPatch) (DocumentData, error) {
+	var f *uint
+	switch p.path {
+	case "statistics.hp":
+		f = &doc.statistics.hp
+	case "statistics.strength":
+		f = &doc.statistics.strength
+	case "statistics.agility":
+		f = &doc.statistics.agility
+	case "statistics.dexterity":
+		f = &doc.statistics.dexterity
+	case "statistics.charisma":
+		f = &doc.statistics.charisma
+	case "statistics.intelligence":
+		f = &doc.statistics.intelligence
+	case "statistics.vital":
+		f = &doc.statistics.vital
+	default:
+		return nil, unknownPatchError(p)
+	}
+
+	if err := patchUint(f, p); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func patchBCLandData(doc BCLandData, p DocumentPatch) (DocumentData, error) {
+	var f *string
+	switch p.path {
+	case "address":
+		f = &doc.address
+	case "area":
+		f = &doc.area
+	case "renter":
+		f = &doc.renter
+	case "rentdate":
+		if err := patchTime(&doc.rentdate, p); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	case "periodday":
+		if err := patchUint(&doc.periodday, p); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	default:
+		return nil, unknownPatchError(p)
+	}
+
+	if err := patchString(f, p); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func patchBCVotingData(doc BCVotingData, p DocumentPatch) (DocumentData, error) {
+	var f *string
+	switch p.path {
+	case "endVoteTime":
+		if err := patchTime(&doc.endVoteTime, p); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	case "bossname":
+		f = &doc.bossname
+	case "termofoffice":
+		if err := patchTerm(&doc.termofoffice, p); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	case "candidates":
+		if p.op != PatchAppend {
+			return nil, unknownPatchError(p)
+		}
+
+		vc, ok := p.element.(VotingCandidate)
+		if !ok {
+			return nil, isvalid.InvalidError.Errorf("element should be VotingCandidate, not %T", p.element)
+		}
+
+		candidates := make([]VotingCandidate, len(doc.candidates)+1)
+		copy(candidates, doc.candidates)
+		candidates[len(doc.candidates)] = vc
+		doc.candidates = candidates
+
+		return doc, nil
+	default:
+		return nil, unknownPatchError(p)
+	}
+
+	if err := patchString(f, p); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func patchBCHistoryData(doc BCHistoryData, p DocumentPatch) (DocumentData, error) {
+	var f *string
+	switch p.path {
+	case "name":
+		f = &doc.name
+	case "date":
+		if err := patchTime(&doc.date, p); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	case "usage":
+		f = &doc.usage
+	case "application":
+		f = &doc.application
+	default:
+		return nil, unknownPatchError(p)
+	}
+
+	if err := patchString(f, p); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// patchGeneralDocData patches the payload field; the result is checked against
+// the registered design by the processor.
+func patchGeneralDocData(doc GeneralDocData, p DocumentPatch) (DocumentData, error) {
+	if !strings.HasPrefix(p.path, "payload.") {
+		return nil, unknownPatchError(p)
+	}
+	name := strings.TrimPrefix(p.path, "payload.")
+
+	payload := doc.Payload()
+	v := payload[name]
+
+	switch p.op {
+	case PatchSet:
+		v = p.value
+	case PatchIncrement:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, isvalid.InvalidError.Errorf("field is not number, %q", v)
+		}
+
+		d, err := parsePatchIncrement(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if (d > 0 && i > math.MaxInt64-d) || (d < 0 && i < math.MinInt64-d) {
+			return nil, isvalid.InvalidError.Errorf("field overflows, %d + %d", i, d)
+		}
+		v = strconv.FormatInt(i+d, 10)
+	default:
+		return nil, unknownPatchError(p)
+	}
+
+	payload[name] = v
+
+	return NewGeneralDocData(doc.info, doc.owner, payload), nil
+}
+
+func patchString(f *string, p DocumentPatch) error {
+	if p.op != PatchSet {
+		return unknownPatchError(p)
+	}
+
+	*f = p.value
+
+	return nil
+}
+
+func patchTime(f *DocumentTime, p DocumentPatch) error {
+	if p.op != PatchSet {
+		return unknownPatchError(p)
+	}
+
+	dt, err := ParseDocumentTime(p.value)
+	if err != nil {
+		return isvalid.InvalidError.Errorf("invalid value for time field, %q: %w", p.value, err)
+	}
+	*f = dt
+
+	return nil
+}
+
+func patchTerm(f *DocumentTerm, p DocumentPatch) error {
+	if p.op != PatchSet {
+		return unknownPatchError(p)
+	}
+
+	tm, err := ParseDocumentTerm(p.value)
+	if err != nil {
+		return isvalid.InvalidError.Errorf("invalid value for term field, %q: %w", p.value, err)
+	}
+	*f = tm
+
+	return nil
+}
+
+func patchUint(f *uint, p DocumentPatch) error {
+	switch p.op {
+	case PatchSet:
+		i, err := strconv.ParseUint(p.value, 10, strconv.IntSize)
+		if err != nil {
+			return isvalid.InvalidError.Errorf("invalid value for number field, %q", p.value)
+		}
+		*f = uint(i)
+	case PatchIncrement:
+		d, err := parsePatchIncrement(p)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d < 0 && uint64(-d) > uint64(*f):
+			return isvalid.InvalidError.Errorf("field under zero, %d - %d", *f, -d)
+		case d < 0:
+			*f -= uint(-d)
+		case *f+uint(d) < *f:
+			return isvalid.InvalidError.Errorf("field overflows, %d + %d", *f, d)
+		default:
+			*f += uint(d)
+		}
+	default:
+		return unknownPatchError(p)
+	}
+
+	return nil
+}
+
+func parsePatchIncrement(p DocumentPatch) (int64, error) {
+	d, err := strconv.ParseInt(p.value, 10, 64)
+	if err != nil {
+		return 0, isvalid.InvalidError.Errorf("invalid increment, %q: %w", p.value, err)
+	}
+
+	return d, nil
+}
+
+// unknownPatchError is returned when the op of patch is not allowed for the
+// path; ApplyDocumentPatches adds the document type to it.
+func unknownPatchError(p DocumentPatch) error {
+	return isvalid.InvalidError.Errorf("unknown patch, %s %q", p.op, p.path)
+}
@@ -0,0 +1,39 @@
+package document
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (dp DocumentPatch) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"op":   dp.op,
+		"path": dp.path,
+	}
+
+	if len(dp.value) > 0 {
+		m["value"] = dp.value
+	}
+
+	if dp.element != nil {
+		m["element"] = dp.element
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(dp.Hint()), m))
+}
+
+type DocumentPatchBSONUnpacker struct {
+	OP string   `bson:"op"`
+	PT string   `bson:"path"`
+	VL string   `bson:"value,omitempty"`
+	EL bson.Raw `bson:"element,omitempty"`
+}
+
+func (dp *DocumentPatch) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var udp DocumentPatchBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &udp); err != nil {
+		return err
+	}
+
+	return dp.unpack(enc, udp.OP, udp.PT, udp.VL, udp.EL)
+}
@@ -0,0 +1,36 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (dp *DocumentPatch) unpack(
+	enc encoder.Encoder,
+	op, pt, vl string,
+	bel []byte,
+) error {
+	dp.op = PatchOp(op)
+	dp.path = pt
+	dp.value = vl
+
+	if len(bel) < 1 {
+		return nil
+	}
+
+	hinter, err := enc.Decode(bel)
+	switch {
+	case err != nil:
+		return err
+	case hinter == nil:
+		return nil
+	}
+
+	i, ok := hinter.(PatchElement)
+	if !ok {
+		return errors.Errorf("not PatchElement: %T", hinter)
+	}
+	dp.element = i
+
+	return nil
+}
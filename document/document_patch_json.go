@@ -0,0 +1,41 @@
+package document
+
+import (
+	"encoding/json"
+
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type DocumentPatchJSONPacker struct {
+	jsonenc.HintedHead
+	OP PatchOp      `json:"op"`
+	PT string       `json:"path"`
+	VL string       `json:"value,omitempty"`
+	EL PatchElement `json:"element,omitempty"`
+}
+
+func (dp DocumentPatch) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DocumentPatchJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(dp.Hint()),
+		OP:         dp.op,
+		PT:         dp.path,
+		VL:         dp.value,
+		EL:         dp.element,
+	})
+}
+
+type DocumentPatchJSONUnpacker struct {
+	OP string          `json:"op"`
+	PT string          `json:"path"`
+	VL string          `json:"value,omitempty"`
+	EL json.RawMessage `json:"element,omitempty"`
+}
+
+func (dp *DocumentPatch) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var udp DocumentPatchJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &udp); err != nil {
+		return err
+	}
+
+	return dp.unpack(enc, udp.OP, udp.PT, udp.VL, udp.EL)
+}
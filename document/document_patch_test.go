@@ -0,0 +1,169 @@
+package document
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/stretchr/testify/suite"
+)
+
+type testDocumentPatch struct {
+	suite.Suite
+	owner currency.Address
+}
+
+func (t *testDocumentPatch) SetupSuite() {
+	t.owner = currency.NewAddress("owner")
+}
+
+func (t *testDocumentPatch) newBCUserData(hp uint) BCUserData {
+	return MustNewBCUserData(
+		MustNewDocInfo("1cui", BCUserDataType),
+		t.owner,
+		0,
+		0,
+		MustNewUserStatistics(hp, 1, 1, 1, 1, 1, 1),
+	)
+}
+
+func (t *testDocumentPatch) newGeneralDocData(v string) GeneralDocData {
+	return NewGeneralDocData(
+		NewGeneralDocInfo("1abc", hint.Type("showme-abc")),
+		t.owner,
+		map[string]string{"count": v},
+	)
+}
+
+func (t *testDocumentPatch) TestPatchStatistics() {
+	doc, err := ApplyDocumentPatches(t.newBCUserData(10), []DocumentPatch{
+		NewIncrementPatch("statistics.hp", -3),
+		NewIncrementPatch("statistics.vital", 2),
+		NewSetPatch("statistics.strength", "5"),
+	})
+	t.NoError(err)
+
+	t.True(MustNewUserStatistics(7, 5, 1, 1, 1, 1, 3).Equal(doc.(BCUserData).Statistics()))
+}
+
+func (t *testDocumentPatch) TestPatchGeneralDocData() {
+	old := t.newGeneralDocData("10")
+
+	doc, err := ApplyDocumentPatches(old, []DocumentPatch{
+		NewIncrementPatch("payload.count", -11),
+		NewSetPatch("payload.title", "title"),
+	})
+	t.NoError(err)
+
+	v, _ := doc.(GeneralDocData).Value("count")
+	t.Equal("-1", v)
+	v, _ = doc.(GeneralDocData).Value("title")
+	t.Equal("title", v)
+
+	// the stored document is not changed
+	v, _ = old.Value("count")
+	t.Equal("10", v)
+	_, found := old.Value("title")
+	t.False(found)
+}
+
+func (t *testDocumentPatch) TestPatchErrors() {
+	cases := []struct {
+		name  string
+		doc   DocumentData
+		patch DocumentPatch
+		err   string
+	}{
+		{
+			name:  "unknown path",
+			doc:   t.newBCUserData(10),
+			patch: NewSetPatch("gold", "10"),
+			err:   `failed to patch set "gold" of mitum-blockcity-document-user-data`,
+		},
+		{
+			name:  "unknown op",
+			doc:   t.newBCUserData(10),
+			patch: NewAppendPatch("statistics.hp", NewDocSign(t.owner, "signcode", false)),
+			err:   `failed to patch append "statistics.hp"`,
+		},
+		{
+			name:  "not number",
+			doc:   t.newBCUserData(10),
+			patch: NewSetPatch("statistics.hp", "ten"),
+			err:   `invalid value for number field, "ten"`,
+		},
+		{
+			name:  "under zero",
+			doc:   t.newBCUserData(10),
+			patch: NewIncrementPatch("statistics.hp", -11),
+			err:   "field under zero, 10 - 11",
+		},
+		{
+			name:  "overflow",
+			doc:   t.newBCUserData(^uint(0)),
+			patch: NewIncrementPatch("statistics.hp", 1),
+			err:   "field overflows",
+		},
+		{
+			name:  "general overflow",
+			doc:   t.newGeneralDocData(strconv.FormatInt(math.MaxInt64, 10)),
+			patch: NewIncrementPatch("payload.count", 1),
+			err:   `failed to patch increment "payload.count" of showme-abc`,
+		},
+		{
+			name:  "general underflow",
+			doc:   t.newGeneralDocData(strconv.FormatInt(math.MinInt64, 10)),
+			patch: NewIncrementPatch("payload.count", -1),
+			err:   "field overflows",
+		},
+		{
+			name:  "general not number",
+			doc:   t.newGeneralDocData("ten"),
+			patch: NewIncrementPatch("payload.count", 1),
+			err:   `field is not number, "ten"`,
+		},
+		{
+			name:  "general without prefix",
+			doc:   t.newGeneralDocData("10"),
+			patch: NewSetPatch("count", "11"),
+			err:   `unknown patch, set "count"`,
+		},
+		{
+			name: "invalid increment",
+			doc:  t.newBCUserData(10),
+			patch: DocumentPatch{
+				BaseHinter: hint.NewBaseHinter(DocumentPatchHint),
+				op:         PatchIncrement,
+				path:       "statistics.hp",
+				value:      "one",
+			},
+			err: `invalid increment, "one"`,
+		},
+	}
+
+	for i, c := range cases {
+		_, err := ApplyDocumentPatches(c.doc, []DocumentPatch{c.patch})
+		t.Error(err, "%d: %v", i, c.name)
+		t.True(isvalid.InvalidError.Is(err), "%d: %v", i, c.name)
+		t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+	}
+}
+
+func (t *testDocumentPatch) TestIsValid() {
+	t.NoError(NewIncrementPatch("statistics.hp", -1).IsValid(nil))
+
+	err := NewSetPatch(" ", "1").IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "empty path")
+
+	err = NewAppendPatch("signers", nil).IsValid(nil)
+	t.Error(err)
+	t.Contains(err.Error(), "empty element for append")
+}
+
+func TestDocumentPatch(t *testing.T) {
+	suite.Run(t, new(testDocumentPatch))
+}
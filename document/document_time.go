@@ -0,0 +1,187 @@
+package document
+
+import (
+	"time"
+
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+// DocumentTimeLayouts are the accepted layouts of DocumentTime string; the
+// date without time is the midnight of UTC.
+var DocumentTimeLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
+// DocumentTime is the timestamp of blockcity document. It is normalized to UTC
+// in milliseconds, same with the datetime of BSON. The documents of v0.0.1 hint
+// kept the free-form date string; it is decoded as the raw DocumentTime, which
+// keeps the string as it was and can not be compared.
+type DocumentTime struct {
+	t   time.Time
+	raw string
+}
+
+func NewDocumentTime(t time.Time) DocumentTime {
+	if t.IsZero() {
+		return DocumentTime{}
+	}
+
+	return DocumentTime{t: t.UTC().Truncate(time.Millisecond)}
+}
+
+// ParseDocumentTime parses the timestamp in DocumentTimeLayouts; the empty
+// string is the zero DocumentTime.
+func ParseDocumentTime(s string) (DocumentTime, error) {
+	if len(s) < 1 {
+		return DocumentTime{}, nil
+	}
+
+	for i := range DocumentTimeLayouts {
+		if t, err := time.Parse(DocumentTimeLayouts[i], s); err == nil {
+			return NewDocumentTime(t), nil
+		}
+	}
+
+	return DocumentTime{}, isvalid.InvalidError.Errorf("invalid timestamp, %q; RFC3339 or yyyy-mm-dd", s)
+}
+
+func rawDocumentTime(s string) DocumentTime {
+	return DocumentTime{raw: s}
+}
+
+func (dt DocumentTime) Time() time.Time {
+	return dt.t
+}
+
+func (dt DocumentTime) IsZero() bool {
+	return dt.t.IsZero() && len(dt.raw) < 1
+}
+
+// IsRaw checks whether DocumentTime is the free-form date string of v0.0.1
+// document.
+func (dt DocumentTime) IsRaw() bool {
+	return len(dt.raw) > 0
+}
+
+func (dt DocumentTime) String() string {
+	switch {
+	case dt.IsRaw():
+		return dt.raw
+	case dt.t.IsZero():
+		return ""
+	default:
+		return dt.t.Format(time.RFC3339Nano)
+	}
+}
+
+func (dt DocumentTime) Bytes() []byte {
+	return []byte(dt.String())
+}
+
+func (dt DocumentTime) IsValid([]byte) error {
+	if dt.IsRaw() {
+		return isvalid.InvalidError.Errorf("free-form date string not allowed, %q", dt.raw)
+	}
+
+	return nil
+}
+
+func (dt DocumentTime) Equal(b DocumentTime) bool {
+	return dt.raw == b.raw && dt.t.Equal(b.t)
+}
+
+// Before checks whether dt is before b; the raw DocumentTime is not before
+// anything.
+func (dt DocumentTime) Before(b DocumentTime) bool {
+	if dt.IsRaw() || b.IsRaw() {
+		return false
+	}
+
+	return dt.t.Before(b.t)
+}
+
+func decodeDocumentTime(s string, legacy bool) (DocumentTime, error) {
+	if legacy {
+		return rawDocumentTime(s), nil
+	}
+
+	return ParseDocumentTime(s)
+}
+
+// DocumentTerm is the duration of blockcity document like the term of office.
+// Like DocumentTime, the v0.0.1 document keeps the raw string.
+type DocumentTerm struct {
+	d   time.Duration
+	raw string
+}
+
+func NewDocumentTerm(d time.Duration) DocumentTerm {
+	return DocumentTerm{d: d}
+}
+
+// ParseDocumentTerm parses the duration string like "720h"; the empty string
+// is the zero DocumentTerm.
+func ParseDocumentTerm(s string) (DocumentTerm, error) {
+	if len(s) < 1 {
+		return DocumentTerm{}, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return DocumentTerm{}, isvalid.InvalidError.Errorf("invalid duration, %q", s)
+	}
+
+	return NewDocumentTerm(d), nil
+}
+
+func rawDocumentTerm(s string) DocumentTerm {
+	return DocumentTerm{raw: s}
+}
+
+func (dr DocumentTerm) Duration() time.Duration {
+	return dr.d
+}
+
+func (dr DocumentTerm) IsZero() bool {
+	return dr.d == 0 && len(dr.raw) < 1
+}
+
+func (dr DocumentTerm) IsRaw() bool {
+	return len(dr.raw) > 0
+}
+
+func (dr DocumentTerm) String() string {
+	switch {
+	case dr.IsRaw():
+		return dr.raw
+	case dr.d == 0:
+		return ""
+	default:
+		return dr.d.String()
+	}
+}
+
+func (dr DocumentTerm) Bytes() []byte {
+	return []byte(dr.String())
+}
+
+func (dr DocumentTerm) IsValid([]byte) error {
+	switch {
+	case dr.IsRaw():
+		return isvalid.InvalidError.Errorf("free-form duration string not allowed, %q", dr.raw)
+	case dr.d < 0:
+		return isvalid.InvalidError.Errorf("negative duration, %v", dr.d)
+	default:
+		return nil
+	}
+}
+
+func (dr DocumentTerm) Equal(b DocumentTerm) bool {
+	return dr.raw == b.raw && dr.d == b.d
+}
+
+func decodeDocumentTerm(s string, legacy bool) (DocumentTerm, error) {
+	if legacy {
+		return rawDocumentTerm(s), nil
+	}
+
+	return ParseDocumentTerm(s)
+}
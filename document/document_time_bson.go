@@ -0,0 +1,58 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// MarshalBSONValue encodes DocumentTime to the datetime of BSON, which can be
+// queried by range; the raw DocumentTime is kept as string.
+func (dt DocumentTime) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	switch {
+	case dt.IsRaw():
+		return bson.MarshalValue(dt.raw)
+	case dt.t.IsZero():
+		return bson.MarshalValue(nil)
+	default:
+		return bson.MarshalValue(dt.t)
+	}
+}
+
+func (dr DocumentTerm) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(dr.String())
+}
+
+func decodeDocumentTimeBSON(rv bson.RawValue, legacy bool) (DocumentTime, error) {
+	switch {
+	case rv.Type == 0, rv.Type == bsontype.Null:
+		return DocumentTime{}, nil
+	case legacy:
+		s, ok := rv.StringValueOK()
+		if !ok {
+			return DocumentTime{}, errors.Errorf("date string expected, not %v", rv.Type)
+		}
+
+		return rawDocumentTime(s), nil
+	default:
+		t, ok := rv.TimeOK()
+		if !ok {
+			return DocumentTime{}, errors.Errorf("datetime expected, not %v", rv.Type)
+		}
+
+		return NewDocumentTime(t), nil
+	}
+}
+
+func decodeDocumentTermBSON(rv bson.RawValue, legacy bool) (DocumentTerm, error) {
+	if rv.Type == 0 || rv.Type == bsontype.Null {
+		return DocumentTerm{}, nil
+	}
+
+	s, ok := rv.StringValueOK()
+	if !ok {
+		return DocumentTerm{}, errors.Errorf("duration string expected, not %v", rv.Type)
+	}
+
+	return decodeDocumentTerm(s, legacy)
+}
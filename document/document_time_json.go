@@ -0,0 +1,13 @@
+package document
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+func (dt DocumentTime) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(dt.String())
+}
+
+func (dr DocumentTerm) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(dr.String())
+}
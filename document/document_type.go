@@ -0,0 +1,378 @@
+package document
+
+import (
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var reDocTypeShortType = regexp.MustCompile(`^[a-z0-9]+$`)
+
+type DocFieldKind string
+
+const (
+	DocFieldKindString  DocFieldKind = "string"
+	DocFieldKindInt     DocFieldKind = "int"
+	DocFieldKindBool    DocFieldKind = "bool"
+	DocFieldKindAddress DocFieldKind = "address"
+	DocFieldKindBig     DocFieldKind = "big"
+)
+
+func (k DocFieldKind) Bytes() []byte {
+	return []byte(k)
+}
+
+func (k DocFieldKind) IsValid([]byte) error {
+	switch k {
+	case DocFieldKindString, DocFieldKindInt, DocFieldKindBool, DocFieldKindAddress, DocFieldKindBig:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown document field kind, %q", k)
+	}
+}
+
+var (
+	DocFieldType   = hint.Type("mitum-document-field")
+	DocFieldHint   = hint.NewHint(DocFieldType, "v0.0.1")
+	DocFieldHinter = DocField{BaseHinter: hint.NewBaseHinter(DocFieldHint)}
+)
+
+// DocField describes one field of the document type; maxLength 0 means no
+// limit.
+type DocField struct {
+	hint.BaseHinter
+	name      string
+	kind      DocFieldKind
+	required  bool
+	maxLength uint
+}
+
+func NewDocField(name string, kind DocFieldKind, required bool, maxLength uint) DocField {
+	return DocField{
+		BaseHinter: hint.NewBaseHinter(DocFieldHint),
+		name:       name,
+		kind:       kind,
+		required:   required,
+		maxLength:  maxLength,
+	}
+}
+
+func (df DocField) Bytes() []byte {
+	var rb byte
+	if df.required {
+		rb = 1
+	}
+
+	return util.ConcatBytesSlice(
+		[]byte(df.name),
+		df.kind.Bytes(),
+		[]byte{rb},
+		util.UintToBytes(df.maxLength),
+	)
+}
+
+func (df DocField) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, df.BaseHinter, df.kind); err != nil {
+		return isvalid.InvalidError.Errorf("invalid DocField: %w", err)
+	}
+
+	if len(df.name) < 1 {
+		return isvalid.InvalidError.Errorf("invalid DocField: empty name")
+	}
+
+	return nil
+}
+
+// CheckValue checks the payload value of the field. The value should be in
+// the canonical text form of the kind, so the same value always has the same
+// bytes.
+func (df DocField) CheckValue(v string) error {
+	if df.maxLength > 0 && uint(utf8.RuneCountInString(v)) > df.maxLength {
+		return errors.Errorf("value of %q too long; %d > %d", df.name, utf8.RuneCountInString(v), df.maxLength)
+	}
+
+	switch df.kind {
+	case DocFieldKindString:
+		return nil
+	case DocFieldKindInt:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || strconv.FormatInt(i, 10) != v {
+			return errors.Errorf("value of %q is not int, %q", df.name, v)
+		}
+	case DocFieldKindBool:
+		if v != "true" && v != "false" {
+			return errors.Errorf("value of %q is not bool, %q", df.name, v)
+		}
+	case DocFieldKindAddress:
+		if err := checkAddressValue(v); err != nil {
+			return errors.Errorf("value of %q is not address, %q: %w", df.name, v, err)
+		}
+	case DocFieldKindBig:
+		i, ok := new(big.Int).SetString(v, 10)
+		if !ok || i.String() != v {
+			return errors.Errorf("value of %q is not big, %q", df.name, v)
+		}
+	default:
+		return errors.Errorf("unknown document field kind, %q", df.kind)
+	}
+
+	return nil
+}
+
+// checkAddressValue checks the value is the string of currency address, like
+// base.DecodeAddressFromString does without encoder.
+func checkAddressValue(v string) error {
+	p, ty, err := hint.ParseFixedTypedString(v, base.AddressTypeSize)
+	if err != nil {
+		return err
+	}
+
+	if ty != currency.AddressType {
+		return errors.Errorf("not currency address type, %q", ty)
+	}
+
+	return currency.NewAddress(p).IsValid(nil)
+}
+
+func (df DocField) Name() string {
+	return df.name
+}
+
+func (df DocField) Kind() DocFieldKind {
+	return df.kind
+}
+
+func (df DocField) Required() bool {
+	return df.required
+}
+
+func (df DocField) MaxLength() uint {
+	return df.maxLength
+}
+
+var (
+	DocTypeDesignType   = hint.Type("mitum-document-type-design")
+	DocTypeDesignHint   = hint.NewHint(DocTypeDesignType, "v0.0.1")
+	DocTypeDesignHinter = DocTypeDesign{BaseHinter: hint.NewBaseHinter(DocTypeDesignHint)}
+)
+
+// DocTypeDesign defines the document type registered by RegisterDocumentType;
+// the document id of the type ends with the short type.
+type DocTypeDesign struct {
+	hint.BaseHinter
+	shortType string
+	docType   hint.Type
+	fields    []DocField
+}
+
+func NewDocTypeDesign(shortType string, docType hint.Type, fields []DocField) DocTypeDesign {
+	return DocTypeDesign{
+		BaseHinter: hint.NewBaseHinter(DocTypeDesignHint),
+		shortType:  shortType,
+		docType:    docType,
+		fields:     fields,
+	}
+}
+
+func (de DocTypeDesign) Bytes() []byte {
+	bs := make([][]byte, len(de.fields)+2)
+	bs[0] = []byte(de.shortType)
+	bs[1] = de.docType.Bytes()
+	for i := range de.fields {
+		bs[i+2] = de.fields[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (de DocTypeDesign) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(de.Bytes())
+}
+
+func (de DocTypeDesign) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, de.BaseHinter, de.docType); err != nil {
+		return isvalid.InvalidError.Errorf("invalid DocTypeDesign: %w", err)
+	}
+
+	if len(de.shortType) != DocIdShortTypeSize || !reDocTypeShortType.MatchString(de.shortType) {
+		return isvalid.InvalidError.Errorf("invalid DocTypeDesign: wrong short type, %q", de.shortType)
+	}
+
+	if _, found := DocIdShortTypeMap[de.shortType]; found {
+		return isvalid.InvalidError.Errorf("invalid DocTypeDesign: short type already used, %q", de.shortType)
+	}
+
+	switch de.docType {
+	case BSDocDataType, BCUserDataType, BCLandDataType, BCVotingDataType, BCHistoryDataType:
+		return isvalid.InvalidError.Errorf("invalid DocTypeDesign: document type already used, %q", de.docType)
+	}
+
+	if len(de.fields) < 1 {
+		return isvalid.InvalidError.Errorf("invalid DocTypeDesign: empty fields")
+	}
+
+	founds := map[string]struct{}{}
+	for i := range de.fields {
+		if err := de.fields[i].IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid DocTypeDesign: %w", err)
+		}
+
+		if _, found := founds[de.fields[i].Name()]; found {
+			return isvalid.InvalidError.Errorf("invalid DocTypeDesign: duplicated field, %q", de.fields[i].Name())
+		}
+		founds[de.fields[i].Name()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (de DocTypeDesign) ShortType() string {
+	return de.shortType
+}
+
+func (de DocTypeDesign) DocType() hint.Type {
+	return de.docType
+}
+
+func (de DocTypeDesign) Fields() []DocField {
+	return de.fields
+}
+
+func (de DocTypeDesign) Field(name string) (DocField, bool) {
+	for i := range de.fields {
+		if de.fields[i].Name() == name {
+			return de.fields[i], true
+		}
+	}
+
+	return DocField{}, false
+}
+
+// CheckPayload checks the payload of GeneralDocData by the fields; unknown
+// field and missing required field are not allowed.
+func (de DocTypeDesign) CheckPayload(payload map[string]string) error {
+	for k := range payload {
+		if _, found := de.Field(k); !found {
+			return errors.Errorf("unknown field, %q for document type, %q", k, de.docType)
+		}
+	}
+
+	for i := range de.fields {
+		f := de.fields[i]
+
+		v, found := payload[f.Name()]
+		if !found {
+			if f.Required() {
+				return errors.Errorf("required field, %q missing for document type, %q", f.Name(), de.docType)
+			}
+
+			continue
+		}
+
+		if err := f.CheckValue(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	DocTypeRegistryType   = hint.Type("mitum-document-type-registry")
+	DocTypeRegistryHint   = hint.NewHint(DocTypeRegistryType, "v0.0.1")
+	DocTypeRegistryHinter = DocTypeRegistry{BaseHinter: hint.NewBaseHinter(DocTypeRegistryHint)}
+)
+
+// DocTypeRegistry is the state value of the registered document types.
+type DocTypeRegistry struct {
+	hint.BaseHinter
+	designs []DocTypeDesign
+}
+
+func NewDocTypeRegistry(designs []DocTypeDesign) DocTypeRegistry {
+	return DocTypeRegistry{BaseHinter: hint.NewBaseHinter(DocTypeRegistryHint), designs: designs}
+}
+
+func (rg DocTypeRegistry) Bytes() []byte {
+	bs := make([][]byte, len(rg.designs))
+	for i := range rg.designs {
+		bs[i] = rg.designs[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (rg DocTypeRegistry) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(rg.Bytes())
+}
+
+func (rg DocTypeRegistry) IsValid([]byte) error {
+	if err := rg.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	for i := range rg.designs {
+		if err := rg.designs[i].IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rg DocTypeRegistry) Designs() []DocTypeDesign {
+	return rg.designs
+}
+
+func (rg DocTypeRegistry) GetByType(docType hint.Type) (DocTypeDesign, bool) {
+	for i := range rg.designs {
+		if rg.designs[i].DocType() == docType {
+			return rg.designs[i], true
+		}
+	}
+
+	return DocTypeDesign{}, false
+}
+
+func (rg DocTypeRegistry) Get(shortType string) (DocTypeDesign, bool) {
+	for i := range rg.designs {
+		if rg.designs[i].ShortType() == shortType {
+			return rg.designs[i], true
+		}
+	}
+
+	return DocTypeDesign{}, false
+}
+
+// Add returns new DocTypeRegistry with the given design; the short type and
+// document type should not be registered.
+func (rg DocTypeRegistry) Add(design DocTypeDesign) (DocTypeRegistry, error) {
+	for i := range rg.designs {
+		switch {
+		case rg.designs[i].ShortType() == design.ShortType():
+			return DocTypeRegistry{}, errors.Errorf("short type already registered, %q", design.ShortType())
+		case rg.designs[i].DocType() == design.DocType():
+			return DocTypeRegistry{}, errors.Errorf("document type already registered, %q", design.DocType())
+		}
+	}
+
+	designs := make([]DocTypeDesign, len(rg.designs)+1)
+	copy(designs, rg.designs)
+	designs[len(rg.designs)] = design
+
+	sort.Slice(designs, func(i, j int) bool {
+		return designs[i].ShortType() < designs[j].ShortType()
+	})
+
+	return NewDocTypeRegistry(designs), nil
+}
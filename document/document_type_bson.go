@@ -0,0 +1,82 @@
+package document
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (df DocField) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(df.Hint()),
+		bson.M{
+			"name":       df.name,
+			"kind":       df.kind,
+			"required":   df.required,
+			"max_length": df.maxLength,
+		}),
+	)
+}
+
+type DocFieldBSONUnpacker struct {
+	NM string `bson:"name"`
+	KD string `bson:"kind"`
+	RQ bool   `bson:"required"`
+	ML uint   `bson:"max_length"`
+}
+
+func (df *DocField) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var udf DocFieldBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &udf); err != nil {
+		return err
+	}
+
+	return df.unpack(enc, udf.NM, udf.KD, udf.RQ, udf.ML)
+}
+
+func (de DocTypeDesign) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(de.Hint()),
+		bson.M{
+			"short_type": de.shortType,
+			"doctype":    de.docType,
+			"fields":     de.fields,
+		}),
+	)
+}
+
+type DocTypeDesignBSONUnpacker struct {
+	ST string   `bson:"short_type"`
+	DT string   `bson:"doctype"`
+	FS bson.Raw `bson:"fields"`
+}
+
+func (de *DocTypeDesign) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ude DocTypeDesignBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &ude); err != nil {
+		return err
+	}
+
+	return de.unpack(enc, ude.ST, ude.DT, ude.FS)
+}
+
+func (rg DocTypeRegistry) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(rg.Hint()),
+		bson.M{
+			"designs": rg.designs,
+		}),
+	)
+}
+
+type DocTypeRegistryBSONUnpacker struct {
+	DS bson.Raw `bson:"designs"`
+}
+
+func (rg *DocTypeRegistry) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var urg DocTypeRegistryBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &urg); err != nil {
+		return err
+	}
+
+	return rg.unpack(enc, urg.DS)
+}
@@ -0,0 +1,74 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+func (df *DocField) unpack(
+	_ encoder.Encoder,
+	nm string,
+	kd string,
+	rq bool,
+	ml uint,
+) error {
+	df.name = nm
+	df.kind = DocFieldKind(kd)
+	df.required = rq
+	df.maxLength = ml
+
+	return nil
+}
+
+func (de *DocTypeDesign) unpack(
+	enc encoder.Encoder,
+	st string,
+	dt string,
+	bfs []byte,
+) error {
+	hfs, err := enc.DecodeSlice(bfs)
+	if err != nil {
+		return err
+	}
+
+	fields := make([]DocField, len(hfs))
+	for i := range hfs {
+		f, ok := hfs[i].(DocField)
+		if !ok {
+			return errors.Errorf("not DocField: %T", hfs[i])
+		}
+
+		fields[i] = f
+	}
+
+	de.shortType = st
+	de.docType = hint.Type(dt)
+	de.fields = fields
+
+	return nil
+}
+
+func (rg *DocTypeRegistry) unpack(
+	enc encoder.Encoder,
+	bds []byte,
+) error {
+	hds, err := enc.DecodeSlice(bds)
+	if err != nil {
+		return err
+	}
+
+	designs := make([]DocTypeDesign, len(hds))
+	for i := range hds {
+		de, ok := hds[i].(DocTypeDesign)
+		if !ok {
+			return errors.Errorf("not DocTypeDesign: %T", hds[i])
+		}
+
+		designs[i] = de
+	}
+
+	rg.designs = designs
+
+	return nil
+}
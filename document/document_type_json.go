@@ -0,0 +1,98 @@
+package document
+
+import (
+	"encoding/json"
+
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+type DocFieldJSONPacker struct {
+	jsonenc.HintedHead
+	NM string       `json:"name"`
+	KD DocFieldKind `json:"kind"`
+	RQ bool         `json:"required"`
+	ML uint         `json:"max_length"`
+}
+
+func (df DocField) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DocFieldJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(df.Hint()),
+		NM:         df.name,
+		KD:         df.kind,
+		RQ:         df.required,
+		ML:         df.maxLength,
+	})
+}
+
+type DocFieldJSONUnpacker struct {
+	NM string `json:"name"`
+	KD string `json:"kind"`
+	RQ bool   `json:"required"`
+	ML uint   `json:"max_length"`
+}
+
+func (df *DocField) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var udf DocFieldJSONUnpacker
+	if err := enc.Unmarshal(b, &udf); err != nil {
+		return err
+	}
+
+	return df.unpack(enc, udf.NM, udf.KD, udf.RQ, udf.ML)
+}
+
+type DocTypeDesignJSONPacker struct {
+	jsonenc.HintedHead
+	ST string     `json:"short_type"`
+	DT hint.Type  `json:"doctype"`
+	FS []DocField `json:"fields"`
+}
+
+func (de DocTypeDesign) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DocTypeDesignJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(de.Hint()),
+		ST:         de.shortType,
+		DT:         de.docType,
+		FS:         de.fields,
+	})
+}
+
+type DocTypeDesignJSONUnpacker struct {
+	ST string          `json:"short_type"`
+	DT string          `json:"doctype"`
+	FS json.RawMessage `json:"fields"`
+}
+
+func (de *DocTypeDesign) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ude DocTypeDesignJSONUnpacker
+	if err := enc.Unmarshal(b, &ude); err != nil {
+		return err
+	}
+
+	return de.unpack(enc, ude.ST, ude.DT, ude.FS)
+}
+
+type DocTypeRegistryJSONPacker struct {
+	jsonenc.HintedHead
+	DS []DocTypeDesign `json:"designs"`
+}
+
+func (rg DocTypeRegistry) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(DocTypeRegistryJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(rg.Hint()),
+		DS:         rg.designs,
+	})
+}
+
+type DocTypeRegistryJSONUnpacker struct {
+	DS json.RawMessage `json:"designs"`
+}
+
+func (rg *DocTypeRegistry) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var urg DocTypeRegistryJSONUnpacker
+	if err := enc.Unmarshal(b, &urg); err != nil {
+		return err
+	}
+
+	return rg.unpack(enc, urg.DS)
+}
@@ -0,0 +1,160 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	EndLeaseFactType   = hint.Type("mitum-end-lease-operation-fact")
+	EndLeaseFactHint   = hint.NewHint(EndLeaseFactType, "v0.0.1")
+	EndLeaseFactHinter = EndLeaseFact{BaseHinter: hint.NewBaseHinter(EndLeaseFactHint)}
+	EndLeaseType       = hint.Type("mitum-end-lease-operation")
+	EndLeaseHint       = hint.NewHint(EndLeaseType, "v0.0.1")
+	EndLeaseHinter     = EndLease{BaseOperation: operationHinter(EndLeaseHint)}
+)
+
+var MaxEndLeaseItems uint = 10
+
+type EndLeaseItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Currency() currency.CurrencyID
+	Rebuild() EndLeaseItem
+}
+
+type EndLeaseFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []EndLeaseItem
+}
+
+func NewEndLeaseFact(token []byte, sender base.Address, items []EndLeaseItem) EndLeaseFact {
+	fact := EndLeaseFact{
+		BaseHinter: hint.NewBaseHinter(EndLeaseFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact EndLeaseFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact EndLeaseFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact EndLeaseFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact EndLeaseFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for EndLeaseFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxEndLeaseItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxEndLeaseItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact EndLeaseFact) Token() []byte {
+	return fact.token
+}
+
+func (fact EndLeaseFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact EndLeaseFact) Items() []EndLeaseItem {
+	return fact.items
+}
+
+func (fact EndLeaseFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact EndLeaseFact) Rebuild() EndLeaseFact {
+	items := make([]EndLeaseItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type EndLease struct {
+	currency.BaseOperation
+}
+
+func NewEndLease(fact EndLeaseFact, fs []base.FactSign, memo string) (EndLease, error) {
+	bo, err := currency.NewBaseOperationFromFact(EndLeaseHint, fact, fs, memo)
+	if err != nil {
+		return EndLease{}, err
+	} else {
+
+		return EndLease{BaseOperation: bo}, nil
+	}
+}
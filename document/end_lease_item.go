@@ -0,0 +1,64 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	EndLeaseItemImplType   = hint.Type("mitum-end-lease-item")
+	EndLeaseItemImplHint   = hint.NewHint(EndLeaseItemImplType, "v0.0.1")
+	EndLeaseItemImplHinter = EndLeaseItemImpl{BaseHinter: hint.NewBaseHinter(EndLeaseItemImplHint)}
+)
+
+// EndLeaseItemImpl ends the lease of the blockcity land document.
+type EndLeaseItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	cid        currency.CurrencyID
+}
+
+func NewEndLeaseItemImpl(documentid string, cid currency.CurrencyID) EndLeaseItemImpl {
+	return EndLeaseItemImpl{
+		BaseHinter: hint.NewBaseHinter(EndLeaseItemImplHint),
+		documentid: documentid,
+		cid:        cid,
+	}
+}
+
+func (it EndLeaseItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.cid.Bytes(),
+	)
+}
+
+func (it EndLeaseItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid EndLeaseItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid EndLeaseItem: empty documentid")
+	}
+
+	return nil
+}
+
+func (it EndLeaseItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it EndLeaseItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it EndLeaseItemImpl) Rebuild() EndLeaseItem {
+	return it
+}
@@ -0,0 +1,30 @@
+package document // nolint:dupl
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it EndLeaseItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type EndLeaseItemImplBSONUnpacker struct {
+	DI string `bson:"documentid"`
+	CI string `bson:"currency"`
+}
+
+func (it *EndLeaseItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uel EndLeaseItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &uel); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uel.DI, uel.CI)
+}
@@ -0,0 +1,17 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *EndLeaseItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	cid string,
+) error {
+	it.documentid = di
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
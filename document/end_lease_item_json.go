@@ -0,0 +1,34 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type EndLeaseItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it EndLeaseItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(EndLeaseItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		CI:         it.cid,
+	})
+}
+
+type EndLeaseItemImplJSONUnpacker struct {
+	DI string `json:"documentid"`
+	CI string `json:"currency"`
+}
+
+func (it *EndLeaseItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uel EndLeaseItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uel); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, uel.DI, uel.CI)
+}
@@ -0,0 +1,182 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var EndLeaseProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EndLeaseProcessor)
+	},
+}
+
+func (op EndLease) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type EndLeaseProcessor struct {
+	cp *currency.CurrencyPool
+	EndLease
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+	nsts     []state.State                                // land documents of ended leases
+}
+
+func NewEndLeaseProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(EndLease)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not EndLease, %T", op)
+		}
+
+		opp := EndLeaseProcessorPool.Get().(*EndLeaseProcessor)
+
+		opp.cp = cp
+		opp.EndLease = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+		opp.nsts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *EndLeaseProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *EndLeaseProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(EndLeaseFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	sts, err := opp.endLease(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.nsts = sts
+
+	return opp, nil
+}
+
+// Process sets the land documents, which leases are ended in PreProcess; the
+// other operations on the same land are not allowed in the same proposal, see
+// OperationProcessor.checkDocumentDuplication.
+func (opp *EndLeaseProcessor) Process( // nolint:dupl
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(EndLeaseFact)
+
+	sts := make([]state.State, len(opp.nsts), len(opp.nsts)+len(opp.required))
+	copy(sts, opp.nsts)
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// endLease returns the land document states, which leases are ended. The
+// renter can end the lease at any time; the owner, only after the lease
+// expired.
+func (opp *EndLeaseProcessor) endLease(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(EndLeaseFact)
+
+	sts := make([]state.State, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+
+		dst, doc, err := loadLandDocument(it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		if doc.LeaseEnd() < base.Height(1) {
+			return nil, operation.NewBaseReasonError("land not leased, %q", it.DocumentId())
+		}
+
+		if !fact.sender.Equal(doc.Account()) {
+			// NOTE the other accounts than owner should be delegated by owner
+			if !fact.sender.Equal(doc.Owner()) {
+				if err := checkDelegation(doc.Owner(), fact.sender, doc, opp.height, getState); err != nil {
+					return nil, err
+				}
+			}
+
+			if doc.IsLeased(opp.height) {
+				return nil, operation.NewBaseReasonError("lease not expired until %v, %q", doc.LeaseEnd(), it.DocumentId())
+			}
+		}
+
+		nst, err := SetStateDocumentDataValue(dst, doc.endLease())
+		if err != nil {
+			return nil, err
+		}
+
+		sts[i] = nst
+	}
+
+	return sts, nil
+}
+
+func (opp *EndLeaseProcessor) Close() error {
+	opp.cp = nil
+	opp.EndLease = EndLease{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+	opp.nsts = nil
+
+	EndLeaseProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *EndLeaseProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(EndLeaseFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
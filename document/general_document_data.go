@@ -0,0 +1,245 @@
+package document
+
+import (
+	"sort"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	GeneralDocDataType   = hint.Type("mitum-document-general-data")
+	GeneralDocDataHint   = hint.NewHint(GeneralDocDataType, "v0.0.1")
+	GeneralDocDataHinter = GeneralDocData{BaseHinter: hint.NewBaseHinter(GeneralDocDataHint)}
+)
+
+// GeneralDocData is the DocumentData of the document types registered by
+// RegisterDocumentType. The payload is checked against the fields of the
+// registered DocTypeDesign; DocumentType() is the registered document type.
+type GeneralDocData struct {
+	hint.BaseHinter
+	info    DocInfo
+	owner   base.Address
+	payload map[string]string
+}
+
+func NewGeneralDocData(info DocInfo, owner base.Address, payload map[string]string) GeneralDocData {
+	p := map[string]string{}
+	for k := range payload {
+		p[k] = payload[k]
+	}
+
+	return GeneralDocData{
+		BaseHinter: hint.NewBaseHinter(GeneralDocDataHint),
+		info:       info,
+		owner:      owner,
+		payload:    p,
+	}
+}
+
+func MustNewGeneralDocData(info DocInfo, owner base.Address, payload map[string]string) GeneralDocData {
+	doc := NewGeneralDocData(info, owner, payload)
+	if err := doc.IsValid(nil); err != nil {
+		panic(err)
+	}
+	return doc
+}
+
+func (doc GeneralDocData) DocumentId() string {
+	return doc.info.DocumentId()
+}
+
+func (doc GeneralDocData) DocumentType() hint.Type {
+	return doc.info.docType
+}
+
+// Bytes is canonical; the payload fields are sorted by name and every name and
+// value is prefixed by its length.
+func (doc GeneralDocData) Bytes() []byte {
+	names := doc.fieldNames()
+
+	bs := make([][]byte, len(names)*4+2)
+	bs[0] = doc.info.Bytes()
+	bs[1] = doc.owner.Bytes()
+	for i := range names {
+		v := doc.payload[names[i]]
+
+		bs[i*4+2] = util.UintToBytes(uint(len(names[i])))
+		bs[i*4+3] = []byte(names[i])
+		bs[i*4+4] = util.UintToBytes(uint(len(v)))
+		bs[i*4+5] = []byte(v)
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (doc GeneralDocData) Hash() valuehash.Hash {
+	return doc.GenerateHash()
+}
+
+func (doc GeneralDocData) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(doc.Bytes())
+}
+
+func (doc GeneralDocData) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		doc.BaseHinter,
+		doc.info,
+		doc.owner,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid general document data: %w", err)
+	}
+
+	if _, ok := doc.info.id.(GeneralDocId); !ok {
+		return isvalid.InvalidError.Errorf("invalid general document data: not general document id, %T", doc.info.id)
+	}
+
+	switch doc.info.docType {
+	case BSDocDataType, BCUserDataType, BCLandDataType, BCVotingDataType, BCHistoryDataType, GeneralDocDataType:
+		return isvalid.InvalidError.Errorf("invalid general document data: builtin document type, %q", doc.info.docType)
+	}
+
+	if len(doc.payload) < 1 {
+		return isvalid.InvalidError.Errorf("invalid general document data: empty payload")
+	}
+
+	for k := range doc.payload {
+		if len(k) < 1 {
+			return isvalid.InvalidError.Errorf("invalid general document data: empty field name")
+		}
+	}
+
+	return nil
+}
+
+func (doc GeneralDocData) Info() DocInfo {
+	return doc.info
+}
+
+func (doc GeneralDocData) Accounts() []base.Address {
+	return []base.Address{}
+}
+
+func (doc GeneralDocData) Owner() base.Address {
+	return doc.owner
+}
+
+// Payload returns the copy of payload.
+func (doc GeneralDocData) Payload() map[string]string {
+	p := map[string]string{}
+	for k := range doc.payload {
+		p[k] = doc.payload[k]
+	}
+
+	return p
+}
+
+func (doc GeneralDocData) Value(name string) (string, bool) {
+	v, found := doc.payload[name]
+
+	return v, found
+}
+
+func (doc GeneralDocData) Equal(b GeneralDocData) bool {
+	if !doc.info.Equal(b.info) {
+		return false
+	}
+
+	if !doc.owner.Equal(b.owner) {
+		return false
+	}
+
+	if len(doc.payload) != len(b.payload) {
+		return false
+	}
+
+	for k := range doc.payload {
+		if v, found := b.payload[k]; !found || v != doc.payload[k] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (doc GeneralDocData) fieldNames() []string {
+	names := make([]string, len(doc.payload))
+
+	var i int
+	for k := range doc.payload {
+		names[i] = k
+		i++
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// checkGeneralDocData checks the payload of document against the design in the
+// document type registry state.
+func checkGeneralDocData(doc GeneralDocData, getState func(key string) (state.State, bool, error)) error {
+	st, err := existsState(StateKeyDocTypeRegistry, "document type registry", getState)
+	if err != nil {
+		return err
+	}
+
+	rg, err := StateDocTypeRegistryValue(st)
+	if err != nil {
+		return err
+	}
+
+	de, found := rg.GetByType(doc.DocumentType())
+	if !found {
+		return operation.NewBaseReasonError("document type not registered, %q", doc.DocumentType())
+	}
+
+	if id, ok := doc.info.id.(GeneralDocId); !ok || id.ShortType() != de.ShortType() {
+		return operation.NewBaseReasonError(
+			"document id not matched with document type, %q; %q", doc.DocumentId(), doc.DocumentType())
+	}
+
+	if err := de.CheckPayload(doc.payload); err != nil {
+		return operation.NewBaseReasonError("invalid payload of document, %q: %w", doc.DocumentId(), err)
+	}
+
+	return nil
+}
+
+// checkDocIdRegistered checks the short type of the document id is registered
+// in the document type registry state; the document ids of the blocksign and
+// blockcity documents are not checked.
+func checkDocIdRegistered(documentid string, getState func(key string) (state.State, bool, error)) error {
+	_, ty, err := ParseDocId(documentid)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	if ty != GeneralDocIdType {
+		return nil
+	}
+
+	shortType := NewGeneralDocId(documentid).ShortType()
+
+	switch st, found, err := getState(StateKeyDocTypeRegistry); {
+	case err != nil:
+		return err
+	case found:
+		rg, err := StateDocTypeRegistryValue(st)
+		if err != nil {
+			return err
+		}
+
+		if _, found := rg.Get(shortType); found {
+			return nil
+		}
+	}
+
+	return operation.NewBaseReasonError("short type of document id not registered, %q", documentid)
+}
@@ -0,0 +1,33 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (doc GeneralDocData) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(doc.Hint()),
+		bson.M{
+			"info":    doc.info,
+			"owner":   doc.owner,
+			"payload": doc.payload,
+		}),
+	)
+}
+
+type GeneralDocDataBSONUnpacker struct {
+	DI bson.Raw            `bson:"info"`
+	OW base.AddressDecoder `bson:"owner"`
+	PL map[string]string   `bson:"payload"`
+}
+
+func (doc *GeneralDocData) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ugd GeneralDocDataBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &ugd); err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, ugd.DI, ugd.OW, ugd.PL)
+}
@@ -0,0 +1,36 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (doc *GeneralDocData) unpack(
+	enc encoder.Encoder,
+	di []byte,
+	ow base.AddressDecoder,
+	pl map[string]string,
+) error {
+	// unpack document info
+	if hinter, err := enc.Decode(di); err != nil {
+		return err
+	} else if i, ok := hinter.(DocInfo); !ok {
+		return errors.Errorf("not Document Info: %T", hinter)
+	} else {
+		doc.info = i
+	}
+
+	oa, err := ow.Encode(enc)
+	if err != nil {
+		return err
+	}
+	doc.owner = oa
+
+	doc.payload = map[string]string{}
+	for k := range pl {
+		doc.payload[k] = pl[k]
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type GeneralDocDataJSONPacker struct {
+	jsonenc.HintedHead
+	DI DocInfo           `json:"info"`
+	OW base.Address      `json:"owner"`
+	PL map[string]string `json:"payload"`
+}
+
+func (doc GeneralDocData) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(GeneralDocDataJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(doc.Hint()),
+		DI:         doc.info,
+		OW:         doc.owner,
+		PL:         doc.payload,
+	})
+}
+
+type GeneralDocDataJSONUnpacker struct {
+	DI json.RawMessage     `json:"info"`
+	OW base.AddressDecoder `json:"owner"`
+	PL map[string]string   `json:"payload"`
+}
+
+func (doc *GeneralDocData) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ugd GeneralDocDataJSONUnpacker
+	if err := enc.Unmarshal(b, &ugd); err != nil {
+		return err
+	}
+
+	return doc.unpack(enc, ugd.DI, ugd.OW, ugd.PL)
+}
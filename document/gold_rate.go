@@ -0,0 +1,88 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	GoldRateType   = hint.Type("mitum-blockcity-gold-rate")
+	GoldRateHint   = hint.NewHint(GoldRateType, "v0.0.1")
+	GoldRateHinter = GoldRate{BaseHinter: hint.NewBaseHinter(GoldRateHint)}
+)
+
+// GoldRate is the rate of swap between the gold of blockcity user documents
+// and the currency; price is the amount of currency for one gold. The currency
+// of swap is paid to and from the treasury account.
+type GoldRate struct {
+	hint.BaseHinter
+	cid      currency.CurrencyID
+	price    currency.Big
+	treasury base.Address
+}
+
+func NewGoldRate(cid currency.CurrencyID, price currency.Big, treasury base.Address) GoldRate {
+	return GoldRate{
+		BaseHinter: hint.NewBaseHinter(GoldRateHint),
+		cid:        cid,
+		price:      price,
+		treasury:   treasury,
+	}
+}
+
+func (gr GoldRate) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		gr.cid.Bytes(),
+		gr.price.Bytes(),
+		gr.treasury.Bytes(),
+	)
+}
+
+func (gr GoldRate) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false,
+		gr.BaseHinter,
+		gr.cid,
+		gr.treasury,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid GoldRate: %w", err)
+	}
+
+	if !gr.price.OverZero() {
+		return isvalid.InvalidError.Errorf("invalid GoldRate: price should be over zero")
+	}
+
+	return nil
+}
+
+func (gr GoldRate) Currency() currency.CurrencyID {
+	return gr.cid
+}
+
+func (gr GoldRate) Price() currency.Big {
+	return gr.price
+}
+
+func (gr GoldRate) Treasury() base.Address {
+	return gr.treasury
+}
+
+// Cost returns the amount of currency for the given gold.
+func (gr GoldRate) Cost(gold uint) currency.Big {
+	return gr.price.Mul(currency.NewBig(int64(gold)))
+}
+
+func (gr GoldRate) Equal(b GoldRate) bool {
+	switch {
+	case gr.cid != b.cid:
+		return false
+	case !gr.price.Equal(b.price):
+		return false
+	case !gr.treasury.Equal(b.treasury):
+		return false
+	default:
+		return true
+	}
+}
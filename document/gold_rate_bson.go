@@ -0,0 +1,34 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (gr GoldRate) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(gr.Hint()),
+		bson.M{
+			"currency": gr.cid,
+			"price":    gr.price,
+			"treasury": gr.treasury,
+		}),
+	)
+}
+
+type GoldRateBSONUnpacker struct {
+	CI string              `bson:"currency"`
+	PR currency.Big        `bson:"price"`
+	TR base.AddressDecoder `bson:"treasury"`
+}
+
+func (gr *GoldRate) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ugr GoldRateBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &ugr); err != nil {
+		return err
+	}
+
+	return gr.unpack(enc, ugr.CI, ugr.PR, ugr.TR)
+}
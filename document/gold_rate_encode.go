@@ -0,0 +1,25 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (gr *GoldRate) unpack(
+	enc encoder.Encoder,
+	cid string,
+	price currency.Big,
+	bTreasury base.AddressDecoder,
+) error {
+	treasury, err := bTreasury.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	gr.cid = currency.CurrencyID(cid)
+	gr.price = price
+	gr.treasury = treasury
+
+	return nil
+}
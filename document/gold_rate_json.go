@@ -0,0 +1,38 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type GoldRateJSONPacker struct {
+	jsonenc.HintedHead
+	CI currency.CurrencyID `json:"currency"`
+	PR currency.Big        `json:"price"`
+	TR base.Address        `json:"treasury"`
+}
+
+func (gr GoldRate) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(GoldRateJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(gr.Hint()),
+		CI:         gr.cid,
+		PR:         gr.price,
+		TR:         gr.treasury,
+	})
+}
+
+type GoldRateJSONUnpacker struct {
+	CI string              `json:"currency"`
+	PR currency.Big        `json:"price"`
+	TR base.AddressDecoder `json:"treasury"`
+}
+
+func (gr *GoldRate) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ugr GoldRateJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ugr); err != nil {
+		return err
+	}
+
+	return gr.unpack(enc, ugr.CI, ugr.PR, ugr.TR)
+}
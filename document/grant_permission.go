@@ -0,0 +1,115 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	GrantPermissionFactType   = hint.Type("mitum-grant-permission-operation-fact")
+	GrantPermissionFactHint   = hint.NewHint(GrantPermissionFactType, "v0.0.1")
+	GrantPermissionFactHinter = GrantPermissionFact{BaseHinter: hint.NewBaseHinter(GrantPermissionFactHint)}
+	GrantPermissionType       = hint.Type("mitum-grant-permission-operation")
+	GrantPermissionHint       = hint.NewHint(GrantPermissionType, "v0.0.1")
+	GrantPermissionHinter     = GrantPermission{BaseOperation: operationHinter(GrantPermissionHint)}
+)
+
+// GrantPermissionFact adds the permission to the extended account of target;
+// like currency.CurrencyPolicyUpdater, it should be signed by the suffrage
+// nodes.
+type GrantPermissionFact struct {
+	hint.BaseHinter
+	h          valuehash.Hash
+	token      []byte
+	target     base.Address
+	permission extension.AccountPermission
+}
+
+func NewGrantPermissionFact(
+	token []byte,
+	target base.Address,
+	permission extension.AccountPermission,
+) GrantPermissionFact {
+	fact := GrantPermissionFact{
+		BaseHinter: hint.NewBaseHinter(GrantPermissionFactHint),
+		token:      token,
+		target:     target,
+		permission: permission,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact GrantPermissionFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact GrantPermissionFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GrantPermissionFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.permission.Bytes(),
+	)
+}
+
+func (fact GrantPermissionFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for GrantPermissionFact")
+	}
+
+	if err := isvalid.Check(nil, false, fact.target, fact.permission); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact GrantPermissionFact) Token() []byte {
+	return fact.token
+}
+
+func (fact GrantPermissionFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact GrantPermissionFact) Permission() extension.AccountPermission {
+	return fact.permission
+}
+
+type GrantPermission struct {
+	currency.BaseOperation
+}
+
+func NewGrantPermission(
+	fact GrantPermissionFact, fs []base.FactSign, memo string,
+) (GrantPermission, error) {
+	bo, err := currency.NewBaseOperationFromFact(GrantPermissionHint, fact, fs, memo)
+	if err != nil {
+		return GrantPermission{}, err
+	}
+
+	return GrantPermission{BaseOperation: bo}, nil
+}
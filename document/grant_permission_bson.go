@@ -0,0 +1,48 @@
+package document // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact GrantPermissionFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":       fact.h,
+				"token":      fact.token,
+				"target":     fact.target,
+				"permission": fact.permission,
+			}))
+}
+
+type GrantPermissionFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	TG base.AddressDecoder `bson:"target"`
+	PM uint                `bson:"permission"`
+}
+
+func (fact *GrantPermissionFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ugp GrantPermissionFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ugp); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ugp.H, ugp.TK, ugp.TG, ugp.PM)
+}
+
+func (op *GrantPermission) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package document
+
+import (
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *GrantPermissionFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	tk []byte,
+	btg base.AddressDecoder,
+	pm uint,
+) error {
+	tg, err := btg.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = tk
+	fact.target = tg
+	fact.permission = extension.AccountPermission(pm)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package document
+
+import (
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type GrantPermissionFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash              `json:"hash"`
+	TK []byte                      `json:"token"`
+	TG base.Address                `json:"target"`
+	PM extension.AccountPermission `json:"permission"`
+}
+
+func (fact GrantPermissionFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(GrantPermissionFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.target,
+		PM:         fact.permission,
+	})
+}
+
+type GrantPermissionFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	TG base.AddressDecoder `json:"target"`
+	PM uint                `json:"permission"`
+}
+
+func (fact *GrantPermissionFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ugp GrantPermissionFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ugp); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ugp.H, ugp.TK, ugp.TG, ugp.PM)
+}
+
+func (op *GrantPermission) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package document
+
+import (
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op GrantPermission) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type GrantPermissionProcessor struct {
+	GrantPermission
+	pubs      []key.Publickey
+	threshold base.Threshold
+}
+
+func NewGrantPermissionProcessor(
+	pubs []key.Publickey,
+	threshold base.Threshold,
+) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(GrantPermission)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not GrantPermission, %T", op)
+		}
+
+		return &GrantPermissionProcessor{
+			GrantPermission: i,
+			pubs:            pubs,
+			threshold:       threshold,
+		}, nil
+	}
+}
+
+func (opp *GrantPermissionProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, operation.NewBaseReasonError("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if _, err := opp.grant(getState); err != nil {
+		return nil, err
+	}
+
+	return opp, nil
+}
+
+// Process grants the permission to the latest extended account state.
+func (opp *GrantPermissionProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	st, err := opp.grant(getState)
+	if err != nil {
+		return err
+	}
+
+	return setState(opp.Fact().Hash(), st)
+}
+
+func (opp *GrantPermissionProcessor) grant(
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	fact := opp.Fact().(GrantPermissionFact)
+
+	st, ac, err := extendedAccountState(fact.target, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	nac, err := ac.AddPermission(fact.permission)
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return extension.SetStateAccountValue(st, nac)
+}
+
+func (opp *GrantPermissionProcessor) Close() error {
+	opp.GrantPermission = GrantPermission{}
+	opp.pubs = nil
+	opp.threshold = base.Threshold{}
+
+	return nil
+}
+
+// extendedAccountState returns the extended account state of address; the keys
+// of extended account follows the current account state. If extended account
+// state does not exist, new extended account is returned.
+func extendedAccountState(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (state.State, extension.Account, error) {
+	ast, err := existsState(currency.StateKeyAccount(a), "target account", getState)
+	if err != nil {
+		return nil, extension.Account{}, err
+	}
+
+	cac, err := currency.LoadStateAccountValue(ast)
+	if err != nil {
+		return nil, extension.Account{}, err
+	}
+
+	st, found, err := getState(extension.StateKeyAccount(a))
+	if err != nil {
+		return nil, extension.Account{}, err
+	}
+
+	var ac extension.Account
+	if found {
+		i, err := extension.StateAccountValue(st)
+		if err != nil {
+			return nil, extension.Account{}, err
+		}
+
+		ac = i
+	} else if ac, err = extension.NewAccount(a, nil); err != nil {
+		return nil, extension.Account{}, err
+	}
+
+	if ac, err = ac.SetKeys(cac.Keys()); err != nil {
+		return nil, extension.Account{}, err
+	}
+
+	return st, ac, nil
+}
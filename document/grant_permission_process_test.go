@@ -0,0 +1,102 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testGrantPermissionProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testGrantPermissionProcessor) newGrantPermission(
+	target base.Address, permission extension.AccountPermission, privs ...key.Privatekey,
+) GrantPermission {
+	if len(privs) < 1 {
+		privs = []key.Privatekey{t.suffrage}
+	}
+
+	fact := NewGrantPermissionFact(util.UUID().Bytes(), target, permission)
+
+	op, err := NewGrantPermission(fact, t.newFactSigns(fact, privs...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testGrantPermissionProcessor) TestGrant() {
+	target, sts0 := t.newAccount()
+
+	pool, opr := t.statepool(sts0)
+
+	errs := t.process(opr, t.newGrantPermission(target.Address(), extension.BlockcityAdmin))
+	t.NoError(errs[0])
+
+	st, found := t.updated(pool, extension.StateKeyAccount(target.Address()))
+	t.True(found)
+
+	ac, err := extension.StateAccountValue(st)
+	t.NoError(err)
+	t.True(ac.Address().Equal(target.Address()))
+	t.True(ac.Keys().Equal(target.Keys()))
+	t.Equal([]extension.AccountPermission{extension.BlockcityAdmin}, ac.Permissions())
+
+	// the permission already granted
+	_, opr = t.nextStatepool(pool, sts0)
+
+	errs = t.process(opr, t.newGrantPermission(target.Address(), extension.BlockcityAdmin))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), `permission already granted, "blockcityAdmin"`)
+}
+
+func (t *testGrantPermissionProcessor) TestGrantNotBySuffrage() {
+	target, sts0 := t.newAccount()
+
+	_, opr := t.statepool(sts0)
+
+	errs := t.process(opr, t.newGrantPermission(target.Address(), extension.BlockcityAdmin, target.Privs()...))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "invalid signing")
+}
+
+func (t *testGrantPermissionProcessor) TestGrantUnknownTarget() {
+	target, _ := t.newAccount()
+
+	_, opr := t.statepool()
+
+	errs := t.process(opr, t.newGrantPermission(target.Address(), extension.BlockcityAdmin))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "target account does not exist")
+}
+
+func (t *testGrantPermissionProcessor) TestGrantInSameBlock() {
+	target, sts0 := t.newAccount()
+
+	pool, opr := t.statepool(sts0)
+
+	// the second grant is rejected instead of overwriting the first
+	errs := t.process(opr,
+		t.newGrantPermission(target.Address(), extension.BlockcityAdmin),
+		t.newGrantPermission(target.Address(), extension.BlocksignAdmin),
+	)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated permission update")
+
+	st, found := t.updated(pool, extension.StateKeyAccount(target.Address()))
+	t.True(found)
+
+	ac, err := extension.StateAccountValue(st)
+	t.NoError(err)
+	t.Equal([]extension.AccountPermission{extension.BlockcityAdmin}, ac.Permissions())
+}
+
+func TestGrantPermissionProcessor(t *testing.T) {
+	suite.Run(t, new(testGrantPermissionProcessor))
+}
@@ -23,3 +23,32 @@ func checkThreshold(fs []base.FactSign, keys currency.AccountKeys) error {
 
 	return nil
 }
+
+// checkThresholdOfAccounts checks the fact signs by the keys of each account
+// over its threshold. The fact is signed by the accounts together, so the signs
+// by the keys of the other accounts are not counted for the account, but every
+// sign should be by the keys of one of the accounts.
+func checkThresholdOfAccounts(fs []base.FactSign, keys []currency.AccountKeys) error {
+	sums := make([]uint, len(keys))
+	for i := range fs {
+		var found bool
+		for j := range keys {
+			if ky, ok := keys[j].Key(fs[i].Signer()); ok {
+				sums[j] += ky.Weight()
+				found = true
+			}
+		}
+
+		if !found {
+			return errors.Errorf("unknown key found, %s", fs[i].Signer())
+		}
+	}
+
+	for i := range keys {
+		if sums[i] < keys[i].Threshold() {
+			return errors.Errorf("not passed threshold, sum=%d < threshold=%d", sums[i], keys[i].Threshold())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package document
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	LandGeometryType   = hint.Type("mitum-blockcity-land-geometry")
+	LandGeometryHint   = hint.NewHint(LandGeometryType, "v0.0.1")
+	LandGeometryHinter = LandGeometry{BaseHinter: hint.NewBaseHinter(LandGeometryHint)}
+)
+
+// GeometryType is the GeoJSON type of land geometry.
+type GeometryType string
+
+const (
+	GeometryPoint   GeometryType = "Point"
+	GeometryPolygon GeometryType = "Polygon"
+)
+
+var MaxGeometryCoordinates = 100
+
+// LandGeometry is the location of blockcity land. The coordinates are
+// [longitude, latitude] pairs; point has one coordinate, polygon has the
+// closed exterior ring, in which the first and the last coordinates are same.
+type LandGeometry struct {
+	hint.BaseHinter
+	gtype       GeometryType
+	coordinates [][2]float64
+}
+
+func NewLandGeometry(gtype GeometryType, coordinates [][2]float64) LandGeometry {
+	return LandGeometry{
+		BaseHinter:  hint.NewBaseHinter(LandGeometryHint),
+		gtype:       gtype,
+		coordinates: coordinates,
+	}
+}
+
+func NewPointLandGeometry(lon, lat float64) LandGeometry {
+	return NewLandGeometry(GeometryPoint, [][2]float64{{lon, lat}})
+}
+
+func NewPolygonLandGeometry(ring [][2]float64) LandGeometry {
+	return NewLandGeometry(GeometryPolygon, ring)
+}
+
+func (lg LandGeometry) GeometryType() GeometryType {
+	return lg.gtype
+}
+
+func (lg LandGeometry) Coordinates() [][2]float64 {
+	return lg.coordinates
+}
+
+// IsEmpty checks whether the geometry is not set.
+func (lg LandGeometry) IsEmpty() bool {
+	return len(lg.gtype) < 1
+}
+
+func (lg LandGeometry) Bytes() []byte {
+	bs := make([][]byte, len(lg.coordinates)*2+1)
+	bs[0] = []byte(lg.gtype)
+
+	for i := range lg.coordinates {
+		bs[i*2+1] = float64ToBytes(lg.coordinates[i][0])
+		bs[i*2+2] = float64ToBytes(lg.coordinates[i][1])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (lg LandGeometry) Hash() valuehash.Hash {
+	return lg.GenerateHash()
+}
+
+func (lg LandGeometry) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(lg.Bytes())
+}
+
+func (lg LandGeometry) IsValid([]byte) error {
+	if err := lg.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(lg.coordinates) > MaxGeometryCoordinates {
+		return isvalid.InvalidError.Errorf(
+			"coordinates of land geometry over allowed, %d > %d", len(lg.coordinates), MaxGeometryCoordinates)
+	}
+
+	for i := range lg.coordinates {
+		lon, lat := lg.coordinates[i][0], lg.coordinates[i][1]
+		if math.IsNaN(lon) || lon < -180 || lon > 180 {
+			return isvalid.InvalidError.Errorf("invalid longitude of land geometry, %v", lon)
+		}
+		if math.IsNaN(lat) || lat < -90 || lat > 90 {
+			return isvalid.InvalidError.Errorf("invalid latitude of land geometry, %v", lat)
+		}
+	}
+
+	switch lg.gtype {
+	case GeometryPoint:
+		if len(lg.coordinates) != 1 {
+			return isvalid.InvalidError.Errorf("point land geometry should have one coordinate, %d", len(lg.coordinates))
+		}
+	case GeometryPolygon:
+		if len(lg.coordinates) < 4 {
+			return isvalid.InvalidError.Errorf(
+				"polygon land geometry should have at least 4 coordinates, %d", len(lg.coordinates))
+		}
+
+		if lg.coordinates[0] != lg.coordinates[len(lg.coordinates)-1] {
+			return isvalid.InvalidError.Errorf("polygon land geometry not closed")
+		}
+
+		distinct := map[[2]float64]struct{}{}
+		for i := range lg.coordinates[:len(lg.coordinates)-1] {
+			if _, found := distinct[lg.coordinates[i]]; found {
+				return isvalid.InvalidError.Errorf("duplicated coordinate in polygon land geometry, %v", lg.coordinates[i])
+			}
+			distinct[lg.coordinates[i]] = struct{}{}
+		}
+
+		if isSelfIntersecting(lg.coordinates) {
+			return isvalid.InvalidError.Errorf("polygon land geometry is self-intersecting")
+		}
+	default:
+		return isvalid.InvalidError.Errorf("unknown land geometry type, %q", lg.gtype)
+	}
+
+	return nil
+}
+
+func (lg LandGeometry) Equal(b LandGeometry) bool {
+	if lg.gtype != b.gtype || len(lg.coordinates) != len(b.coordinates) {
+		return false
+	}
+
+	for i := range lg.coordinates {
+		if lg.coordinates[i] != b.coordinates[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSelfIntersecting checks whether any two non-adjacent edges of the closed
+// ring intersect; the geospatial index of digest does not accept such polygon.
+func isSelfIntersecting(ring [][2]float64) bool {
+	n := len(ring) - 1 // number of edges
+	for i := 0; i < n; i++ {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue
+			}
+
+			if segmentsIntersect(ring[i], ring[i+1], ring[j], ring[j+1]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func segmentsIntersect(a, b, c, d [2]float64) bool {
+	o1 := orientation(a, b, c)
+	o2 := orientation(a, b, d)
+	o3 := orientation(c, d, a)
+	o4 := orientation(c, d, b)
+
+	switch {
+	case o1 != o2 && o3 != o4:
+		return true
+	case o1 == 0 && onSegment(a, c, b),
+		o2 == 0 && onSegment(a, d, b),
+		o3 == 0 && onSegment(c, a, d),
+		o4 == 0 && onSegment(c, b, d):
+		return true
+	default:
+		return false
+	}
+}
+
+func orientation(a, b, c [2]float64) int {
+	v := (b[1]-a[1])*(c[0]-b[0]) - (b[0]-a[0])*(c[1]-b[1])
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// onSegment checks whether q lies on the segment pr, when p, q and r are
+// collinear.
+func onSegment(p, q, r [2]float64) bool {
+	return q[0] <= math.Max(p[0], r[0]) && q[0] >= math.Min(p[0], r[0]) &&
+		q[1] <= math.Max(p[1], r[1]) && q[1] >= math.Min(p[1], r[1])
+}
+
+func float64ToBytes(f float64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, math.Float64bits(f))
+
+	return b
+}
@@ -0,0 +1,30 @@
+package document
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (lg LandGeometry) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(lg.Hint()),
+		bson.M{
+			"type":        lg.gtype,
+			"coordinates": lg.coordinates,
+		}),
+	)
+}
+
+type LandGeometryBSONUnpacker struct {
+	GT string       `bson:"type"`
+	CO [][2]float64 `bson:"coordinates"`
+}
+
+func (lg *LandGeometry) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ulg LandGeometryBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &ulg); err != nil {
+		return err
+	}
+
+	return lg.unpack(enc, ulg.GT, ulg.CO)
+}
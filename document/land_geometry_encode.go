@@ -0,0 +1,16 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (lg *LandGeometry) unpack(
+	_ encoder.Encoder,
+	gt string,
+	co [][2]float64,
+) error {
+	lg.gtype = GeometryType(gt)
+	lg.coordinates = co
+
+	return nil
+}
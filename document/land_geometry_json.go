@@ -0,0 +1,33 @@
+package document
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type LandGeometryJSONPacker struct {
+	jsonenc.HintedHead
+	GT GeometryType `json:"type"`
+	CO [][2]float64 `json:"coordinates"`
+}
+
+func (lg LandGeometry) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(LandGeometryJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(lg.Hint()),
+		GT:         lg.gtype,
+		CO:         lg.coordinates,
+	})
+}
+
+type LandGeometryJSONUnpacker struct {
+	GT string       `json:"type"`
+	CO [][2]float64 `json:"coordinates"`
+}
+
+func (lg *LandGeometry) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ulg LandGeometryJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ulg); err != nil {
+		return err
+	}
+
+	return lg.unpack(enc, ulg.GT, ulg.CO)
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/extension"
 	"github.com/rs/zerolog"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
@@ -30,10 +31,38 @@ type GetNewProcessor func(state.Processor) (state.Processor, error)
 type DuplicationType string
 
 const (
-	DuplicationTypeSender   DuplicationType = "sender"
-	DuplicationTypeCurrency DuplicationType = "currency"
+	DuplicationTypeSender     DuplicationType = "sender"
+	DuplicationTypeCurrency   DuplicationType = "currency"
+	DuplicationTypeDocType    DuplicationType = "doctype"
+	DuplicationTypePermission DuplicationType = "permission"
+	DuplicationTypeGoldRate   DuplicationType = "goldrate"
+	DuplicationTypeStatPolicy DuplicationType = "statpolicy"
 )
 
+// blockHeightSetter is the processor, which needs the height of block in
+// processing.
+type blockHeightSetter interface {
+	setBlockHeight(base.Height)
+}
+
+// documentPooler is the processor, which merges its change into the document
+// pooled in the proposal, like the signature of SignDocuments; the operations
+// of documentPooler on the same document are allowed in the same proposal.
+type documentPooler interface {
+	pooledStates() []state.State
+}
+
+// documentPoolState replaces the state set before in pool by the pooled
+// document state, which has all the changes merged in the proposal until now;
+// state.StateV0 keeps the value set first.
+type documentPoolState struct {
+	state.State
+}
+
+func (st documentPoolState) Merge(state.State) (state.State, error) {
+	return st.State, nil
+}
+
 type OperationProcessor struct {
 	id string
 	sync.RWMutex
@@ -45,6 +74,9 @@ type OperationProcessor struct {
 	amountPool           map[string]currency.AmountState
 	duplicated           map[string]DuplicationType
 	duplicatedNewAddress map[string]struct{}
+	duplicatedDocument   map[string]bool
+	duplicatedInventory  map[string]struct{}
+	documentPool         map[string]state.State
 	processorClosers     *sync.Map
 }
 
@@ -82,6 +114,9 @@ func (opr *OperationProcessor) New(pool *storage.Statepool) prprocessor.Operatio
 	nopr.amountPool = map[string]currency.AmountState{}
 	nopr.duplicated = map[string]DuplicationType{}
 	nopr.duplicatedNewAddress = map[string]struct{}{}
+	nopr.duplicatedDocument = map[string]bool{}
+	nopr.duplicatedInventory = map[string]struct{}{}
+	nopr.documentPool = map[string]state.State{}
 	nopr.processorClosers = &sync.Map{}
 
 	nopr.Log().Debug().Str("processor_id", nopr.id).Msg("new operation processors created")
@@ -114,11 +149,40 @@ func (opr *OperationProcessor) setState(op valuehash.Hash, sts ...state.State) e
 				opr.fee[t.Currency()] = f.Add(t.Fee())
 			}
 		}
+
+		// NOTE the pooled document is set instead; Process of the operations
+		// on the same document can be run in any order.
+		if st, found := opr.documentPool[sts[i].Key()]; found {
+			sts[i] = documentPoolState{State: st}
+		}
 	}
 
 	return opr.pool.Set(op, sts...)
 }
 
+// getPooledState returns the document state pooled in the proposal before the
+// state in pool; pool does not return the states updated in the proposal.
+func (opr *OperationProcessor) getPooledState(key string) (state.State, bool, error) {
+	opr.RLock()
+	st, found := opr.documentPool[key]
+	opr.RUnlock()
+
+	if found {
+		return st, true, nil
+	}
+
+	return opr.pool.Get(key)
+}
+
+func (opr *OperationProcessor) poolDocuments(sts []state.State) {
+	opr.Lock()
+	defer opr.Unlock()
+
+	for i := range sts {
+		opr.documentPool[sts[i].Key()] = sts[i]
+	}
+}
+
 func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor, error) {
 	var sp state.Processor
 	switch i, known, err := opr.getNewProcessor(op); {
@@ -130,7 +194,16 @@ func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor,
 		sp = i
 	}
 
-	pop, err := sp.(state.PreProcessor).PreProcess(opr.pool.Get, opr.setState)
+	if i, ok := sp.(blockHeightSetter); ok {
+		i.setBlockHeight(opr.pool.Height())
+	}
+
+	getState := opr.pool.Get
+	if _, ok := sp.(documentPooler); ok {
+		getState = opr.getPooledState
+	}
+
+	pop, err := sp.(state.PreProcessor).PreProcess(getState, opr.setState)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +212,10 @@ func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor,
 		return nil, operation.NewBaseReasonError("duplication found: %w", err)
 	}
 
+	if i, ok := pop.(documentPooler); ok {
+		opr.poolDocuments(i.pooledStates())
+	}
+
 	return pop, nil
 }
 
@@ -152,9 +229,30 @@ func (opr *OperationProcessor) Process(op state.Processor) error {
 		*currency.SuffrageInflationProcessor,
 		*SignDocumentsProcessor,
 		*CreateDocumentsProcessor,
-		*UpdateDocumentsProcessor:
+		*UpdateDocumentsProcessor,
+		*RemoveDocumentsProcessor,
+		*TransferDocumentsProcessor,
+		*AmendSignersProcessor,
+		*RegisterDocumentTypeProcessor,
+		*GrantPermissionProcessor,
+		*RevokePermissionProcessor,
+		*DelegateEditorsProcessor,
+		*CastVoteProcessor,
+		*CloseVotingProcessor,
+		*CommitVoteProcessor,
+		*RevealVoteProcessor,
+		*RentLandProcessor,
+		*RenewLeaseProcessor,
+		*EndLeaseProcessor,
+		*DepositGoldProcessor,
+		*WithdrawGoldProcessor,
+		*TransferGoldProcessor,
+		*UpdateGoldRateProcessor,
+		*SwapGoldProcessor,
+		*UpdateStatPolicyProcessor,
+		*ChangeStatsProcessor:
 		return opr.process(op)
-	case currency.Transfers, currency.CreateAccounts, currency.KeyUpdater, currency.CurrencyRegister, currency.CurrencyPolicyUpdater, currency.SuffrageInflation, SignDocuments, CreateDocuments, UpdateDocuments:
+	case currency.Transfers, currency.CreateAccounts, currency.KeyUpdater, currency.CurrencyRegister, currency.CurrencyPolicyUpdater, currency.SuffrageInflation, SignDocuments, CreateDocuments, UpdateDocuments, RemoveDocuments, TransferDocuments, AmendSigners, RegisterDocumentType, GrantPermission, RevokePermission, DelegateEditors, CastVote, CloseVoting, CommitVote, RevealVote, RentLand, RenewLease, EndLease, DepositGold, WithdrawGold, TransferGold, UpdateGoldRate, SwapGold, UpdateStatPolicy, ChangeStats:
 		pr, err := opr.PreProcess(op)
 		if err != nil {
 			return err
@@ -181,6 +279,48 @@ func (opr *OperationProcessor) process(op state.Processor) error {
 		sp = t
 	case *UpdateDocumentsProcessor:
 		sp = t
+	case *RemoveDocumentsProcessor:
+		sp = t
+	case *TransferDocumentsProcessor:
+		sp = t
+	case *AmendSignersProcessor:
+		sp = t
+	case *RegisterDocumentTypeProcessor:
+		sp = t
+	case *GrantPermissionProcessor:
+		sp = t
+	case *RevokePermissionProcessor:
+		sp = t
+	case *DelegateEditorsProcessor:
+		sp = t
+	case *CastVoteProcessor:
+		sp = t
+	case *CloseVotingProcessor:
+		sp = t
+	case *CommitVoteProcessor:
+		sp = t
+	case *RevealVoteProcessor:
+		sp = t
+	case *RentLandProcessor:
+		sp = t
+	case *RenewLeaseProcessor:
+		sp = t
+	case *EndLeaseProcessor:
+		sp = t
+	case *DepositGoldProcessor:
+		sp = t
+	case *WithdrawGoldProcessor:
+		sp = t
+	case *TransferGoldProcessor:
+		sp = t
+	case *UpdateGoldRateProcessor:
+		sp = t
+	case *SwapGoldProcessor:
+		sp = t
+	case *UpdateStatPolicyProcessor:
+		sp = t
+	case *ChangeStatsProcessor:
+		sp = t
 	default:
 		return op.Process(opr.pool.Get, opr.pool.Set)
 	}
@@ -195,6 +335,10 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 	var did string
 	var didtype DuplicationType
 	var newAddresses []base.Address
+	var documentids []string
+	var inventories []base.Address
+	var treasury base.Address
+	var pooled bool
 
 	switch t := op.(type) {
 	case currency.Transfers:
@@ -219,13 +363,164 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 		did = t.Fact().(currency.CurrencyPolicyUpdaterFact).Currency().String()
 		didtype = DuplicationTypeCurrency
 	case SignDocuments:
-		did = t.Fact().(SignDocumentsFact).Sender().String()
+		fact := t.Fact().(SignDocumentsFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+		pooled = true
 	case CreateDocuments:
-		did = t.Fact().(CreateDocumentsFact).Sender().String()
+		fact := t.Fact().(CreateDocumentsFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+		inventories = []base.Address{fact.Sender()}
 	case UpdateDocuments:
-		did = t.Fact().(UpdateDocumentsFact).Sender().String()
+		fact := t.Fact().(UpdateDocumentsFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case RemoveDocuments:
+		fact := t.Fact().(RemoveDocumentsFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		inventories = []base.Address{fact.Sender()}
+	case TransferDocuments:
+		fact := t.Fact().(TransferDocumentsFact)
+		inventories = []base.Address{fact.Sender()}
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+			inventories = append(inventories, fact.Items()[i].Receiver())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case AmendSigners:
+		fact := t.Fact().(AmendSignersFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case RegisterDocumentType:
+		did = StateKeyDocTypeRegistry
+		didtype = DuplicationTypeDocType
+	case GrantPermission:
+		did = extension.StateKeyAccount(t.Fact().(GrantPermissionFact).Target())
+		didtype = DuplicationTypePermission
+	case RevokePermission:
+		did = extension.StateKeyAccount(t.Fact().(RevokePermissionFact).Target())
+		didtype = DuplicationTypePermission
+	case DelegateEditors:
+		did = t.Fact().(DelegateEditorsFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case CastVote:
+		fact := t.Fact().(CastVoteFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		pooled = true
+	case CloseVoting:
+		fact := t.Fact().(CloseVotingFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case CommitVote:
+		fact := t.Fact().(CommitVoteFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		pooled = true
+	case RevealVote:
+		fact := t.Fact().(RevealVoteFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		pooled = true
+	case RentLand:
+		fact := t.Fact().(RentLandFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case RenewLease:
+		fact := t.Fact().(RenewLeaseFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case EndLease:
+		fact := t.Fact().(EndLeaseFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case DepositGold:
+		fact := t.Fact().(DepositGoldFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case WithdrawGold:
+		fact := t.Fact().(WithdrawGoldFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case TransferGold:
+		fact := t.Fact().(TransferGoldFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId(), fact.Items()[i].Receiver())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case UpdateGoldRate:
+		did = StateKeyGoldRate
+		didtype = DuplicationTypeGoldRate
+	case SwapGold:
+		fact := t.Fact().(SwapGoldFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+
+		if fact.sellsGold() {
+			rate, err := loadGoldRate(opr.pool.Get)
+			if err != nil {
+				return err
+			}
+			treasury = rate.Treasury()
+		}
+	case UpdateStatPolicy:
+		did = StateKeyStatPolicy
+		didtype = DuplicationTypeStatPolicy
+	case ChangeStats:
+		fact := t.Fact().(ChangeStatsFact)
+		for i := range fact.Items() {
+			documentids = append(documentids, fact.Items()[i].DocumentId())
+		}
+		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
 	default:
 		return nil
@@ -238,6 +533,14 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 				return errors.Errorf("violates only one sender in proposal")
 			case DuplicationTypeCurrency:
 				return errors.Errorf("duplicated currency id, %q found in proposal", did)
+			case DuplicationTypeDocType:
+				return errors.Errorf("duplicated document type registration found in proposal")
+			case DuplicationTypePermission:
+				return errors.Errorf("duplicated permission update, %q found in proposal", did)
+			case DuplicationTypeGoldRate:
+				return errors.Errorf("duplicated gold rate update found in proposal")
+			case DuplicationTypeStatPolicy:
+				return errors.Errorf("duplicated stat policy update found in proposal")
 			default:
 				return errors.Errorf("violates duplication in proposal")
 			}
@@ -246,12 +549,88 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 		opr.duplicated[did] = didtype
 	}
 
+	if treasury != nil {
+		if err := opr.checkTreasuryDuplication(treasury); err != nil {
+			return err
+		}
+	}
+
 	if len(newAddresses) > 0 {
 		if err := opr.checkNewAddressDuplication(newAddresses); err != nil {
 			return err
 		}
 	}
 
+	if len(documentids) > 0 {
+		if err := opr.checkDocumentDuplication(documentids, pooled); err != nil {
+			return err
+		}
+	}
+
+	if len(inventories) > 0 {
+		if err := opr.checkInventoryDuplication(inventories); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkDocumentDuplication prevents the same document from being changed by
+// the different operations in the same proposal. The states in pool are not
+// updated until the block is stored and the document data state is replaced
+// as a whole, so the change of one operation can be overwritten by the other,
+// like the gold moved by DepositGold. The operations of documentPooler, like
+// SignDocuments and the votes, merge their changes into the pooled document, so they are
+// allowed together, but not with the other operations.
+func (opr *OperationProcessor) checkDocumentDuplication(documentids []string, pooled bool) error {
+	for i := range documentids {
+		if p, found := opr.duplicatedDocument[documentids[i]]; found && (!p || !pooled) {
+			return errors.Errorf("duplicated document, %q found in proposal", documentids[i])
+		}
+	}
+
+	for i := range documentids {
+		opr.duplicatedDocument[documentids[i]] = pooled
+	}
+
+	return nil
+}
+
+// checkInventoryDuplication prevents the document inventory of the same owner
+// from being changed by the different operations in the same proposal; the
+// states in pool are not updated until the block is stored, so the document
+// info appended by one operation can be overwritten by the other.
+func (opr *OperationProcessor) checkInventoryDuplication(owners []base.Address) error {
+	inventories := map[string]struct{}{}
+	for i := range owners {
+		k := StateKeyDocuments(owners[i])
+		if _, found := opr.duplicatedInventory[k]; found {
+			return errors.Errorf("duplicated document inventory, %q found in proposal", owners[i])
+		}
+
+		inventories[k] = struct{}{}
+	}
+
+	for k := range inventories {
+		opr.duplicatedInventory[k] = struct{}{}
+	}
+
+	return nil
+}
+
+// checkTreasuryDuplication prevents the balance of treasury from being paid by
+// the different operations in the same proposal. The balance of treasury is
+// checked before the block is stored, so the sold gold of SwapGold operations
+// could be paid over the balance. The treasury is kept as sender, so the
+// operations sent by the treasury are also not allowed.
+func (opr *OperationProcessor) checkTreasuryDuplication(treasury base.Address) error {
+	if _, found := opr.duplicated[treasury.String()]; found {
+		return errors.Errorf("balance of treasury, %q already paid in proposal", treasury)
+	}
+
+	opr.duplicated[treasury.String()] = DuplicationTypeSender
+
 	return nil
 }
 
@@ -285,9 +664,131 @@ func (opr *OperationProcessor) Close() error {
 		opr.pool.AddOperations(op)
 	}
 
+	return opr.checkpointWeights()
+}
+
+// checkpointWeights keeps the states of voting weights changed in the block as
+// the checkpoints of the snapshots of the weighted rounds, which take the
+// votes; the checkpoint has the state before the first change after the
+// snapshot. The snapshots of the rounds opened in the block are added to the
+// voting snapshots state.
+func (opr *OperationProcessor) checkpointWeights() error {
+	height := opr.pool.Height()
+
+	snapshots := NewVotingSnapshots(nil, nil)
+	sst, found, err := opr.pool.Get(StateKeyVotingSnapshots)
+	switch {
+	case err != nil:
+		return err
+	case found:
+		i, err := StateVotingSnapshotsValue(sst)
+		if err != nil {
+			return err
+		}
+		snapshots = i
+	}
+
+	us := opr.pool.Updates()
+
+	var opened valuehash.Hash
+	for i := range us {
+		doc, ok := votingDocumentFromUpdate(us[i])
+		if !ok || !doc.IsWeighted() || !doc.HasDeadline() || doc.Closed() || doc.Snapshot() != height {
+			continue
+		}
+
+		snapshots = snapshots.add(height, doc.Deadline())
+		opened = us[i].Operations()[0]
+	}
+
+	if opened != nil {
+		nst, err := SetStateVotingSnapshotsValue(sst, snapshots)
+		if err != nil {
+			return err
+		}
+
+		if err := opr.pool.Set(opened, nst); err != nil {
+			return err
+		}
+	}
+
+	heights := snapshots.Open(height)
+	if len(heights) < 1 {
+		return nil
+	}
+
+	for i := range us {
+		if !isVotingWeightKey(us[i].Key()) {
+			continue
+		}
+
+		if err := opr.checkpointWeight(us[i], heights); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (opr *OperationProcessor) checkpointWeight(u *state.StateUpdater, heights []base.Height) error {
+	// NOTE pool returns the state before the block
+	st, found, err := opr.pool.Get(u.Key())
+	switch {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	}
+
+	if IsStateDocumentDataKey(st.Key()) {
+		if dd, err := StateDocumentDataValue(st); err != nil || dd.Info().DocType() != BCUserDataType {
+			return nil
+		}
+	}
+
+	for i := range heights {
+		cst, found, err := opr.pool.Get(StateKeyVotingCheckpoint(st.Key(), heights[i]))
+		switch {
+		case err != nil:
+			return err
+		case found:
+			continue
+		}
+
+		nst, err := cst.SetValue(st.Value())
+		if err != nil {
+			return err
+		}
+
+		if err := opr.pool.Set(u.Operations()[0], nst); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// isVotingWeightKey checks whether the state of key can be the voting weight;
+// the balances, the document inventories and the blockcity user documents.
+func isVotingWeightKey(key string) bool {
+	return currency.IsStateBalanceKey(key) || IsStateDocumentsKey(key) || IsStateDocumentDataKey(key)
+}
+
+func votingDocumentFromUpdate(u *state.StateUpdater) (BCVotingData, bool) {
+	if !IsStateDocumentDataKey(u.Key()) || u.Value() == nil {
+		return BCVotingData{}, false
+	}
+
+	dd, err := StateDocumentDataValue(u.GetState())
+	if err != nil {
+		return BCVotingData{}, false
+	}
+
+	doc, ok := dd.(BCVotingData)
+
+	return doc, ok
+}
+
 func (opr *OperationProcessor) Cancel() error {
 	opr.Lock()
 	defer opr.Unlock()
@@ -314,7 +815,28 @@ func (opr *OperationProcessor) getNewProcessor(op state.Processor) (state.Proces
 		currency.SuffrageInflation,
 		SignDocuments,
 		UpdateDocuments,
-		CreateDocuments:
+		CreateDocuments,
+		RemoveDocuments,
+		TransferDocuments,
+		AmendSigners,
+		RegisterDocumentType,
+		GrantPermission,
+		RevokePermission,
+		DelegateEditors,
+		CastVote,
+		CloseVoting,
+		CommitVote,
+		RevealVote,
+		RentLand,
+		RenewLease,
+		EndLease,
+		DepositGold,
+		WithdrawGold,
+		TransferGold,
+		UpdateGoldRate,
+		SwapGold,
+		UpdateStatPolicy,
+		ChangeStats:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return op, false, nil
@@ -375,6 +897,9 @@ func (opr *OperationProcessor) close() {
 	opr.amountPool = nil
 	opr.duplicated = nil
 	opr.duplicatedNewAddress = nil
+	opr.duplicatedDocument = nil
+	opr.duplicatedInventory = nil
+	opr.documentPool = nil
 	opr.processorClosers = nil
 
 	operationProcessorPool.Put(opr)
@@ -0,0 +1,418 @@
+package document
+
+import (
+	"time"
+
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/block"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	leveldbstorage "github.com/spikeekips/mitum/storage/leveldb"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/stretchr/testify/suite"
+)
+
+type testAccount struct {
+	currency.Account
+	priv key.Privatekey
+}
+
+func (ac testAccount) Privs() []key.Privatekey {
+	return []key.Privatekey{ac.priv}
+}
+
+// baseTestOperationProcessor processes the operations by OperationProcessor
+// over storage.Statepool, like the proposal processor does in one block.
+type baseTestOperationProcessor struct {
+	suite.Suite
+	networkID base.NetworkID
+	cid       currency.CurrencyID
+	suffrage  key.Privatekey
+}
+
+func (t *baseTestOperationProcessor) SetupSuite() {
+	t.networkID = util.UUID().Bytes()
+	t.cid = currency.CurrencyID("SHOWME")
+	t.suffrage = key.NewBasePrivatekey()
+}
+
+// heightManifest is the last manifest of heightDatabase; only the height is
+// used by storage.Statepool.
+type heightManifest struct {
+	block.Manifest
+	height base.Height
+}
+
+func (m heightManifest) Height() base.Height {
+	return m.height
+}
+
+// heightDatabase is the database, which last block is at the given height.
+type heightDatabase struct {
+	storage.Database
+	last base.Height
+}
+
+func (db heightDatabase) LastManifest() (block.Manifest, bool, error) {
+	return heightManifest{height: db.last}, true, nil
+}
+
+// statepool returns the state pool of genesis block over the given states and
+// the OperationProcessor for it.
+func (t *baseTestOperationProcessor) statepool(s ...[]state.State) (*storage.Statepool, prprocessor.OperationProcessor) {
+	return t.statepoolAt(base.GenesisHeight, s...)
+}
+
+// statepoolAt returns the state pool of the block at height over the given
+// states and the OperationProcessor for it.
+func (t *baseTestOperationProcessor) statepoolAt(
+	height base.Height, s ...[]state.State,
+) (*storage.Statepool, prprocessor.OperationProcessor) {
+	b := map[string]state.State{}
+	for i := range s {
+		for j := range s[i] {
+			b[s[i][j].Key()] = s[i][j]
+		}
+	}
+
+	var db storage.Database = leveldbstorage.NewMemDatabase(encoder.NewEncoders(), jsonenc.NewEncoder())
+	if height > base.GenesisHeight {
+		db = heightDatabase{Database: db, last: height - 1}
+	}
+
+	pool, err := storage.NewStatepoolWithBase(db, b)
+	t.NoError(err)
+	t.Equal(height, pool.Height())
+
+	return pool, t.processor().New(pool)
+}
+
+// nextStatepool returns the state pool of the block after pool; the states
+// updated in pool are stored over the given states.
+func (t *baseTestOperationProcessor) nextStatepool(
+	pool *storage.Statepool, s ...[]state.State,
+) (*storage.Statepool, prprocessor.OperationProcessor) {
+	return t.statepoolAt(pool.Height()+1, append(s, t.updates(pool))...)
+}
+
+// updates returns the states updated in pool.
+func (t *baseTestOperationProcessor) updates(pool *storage.Statepool) []state.State {
+	us := pool.Updates()
+	sts := make([]state.State, len(us))
+	for i := range us {
+		sts[i] = us[i].GetState()
+	}
+
+	return sts
+}
+
+// processor returns OperationProcessor with the processors like node; the
+// operations of suffrage are signed by t.suffrage.
+func (t *baseTestOperationProcessor) processor() *OperationProcessor {
+	threshold, err := base.NewThreshold(1, 100)
+	t.NoError(err)
+
+	pubs := []key.Publickey{t.suffrage.Publickey()}
+
+	opr := NewOperationProcessor(nil)
+
+	for _, i := range []struct {
+		hinter hint.Hinter
+		f      currency.GetNewProcessor
+	}{
+		{currency.TransfersHinter, currency.NewTransfersProcessor(nil)},
+		{SignDocumentsHinter, NewSignDocumentsProcessor(nil)},
+		{CreateDocumentsHinter, NewCreateDocumentsProcessor(nil)},
+		{UpdateDocumentsHinter, NewUpdateDocumentsProcessor(nil)},
+		{RemoveDocumentsHinter, NewRemoveDocumentsProcessor(nil)},
+		{TransferDocumentsHinter, NewTransferDocumentsProcessor(nil)},
+		{AmendSignersHinter, NewAmendSignersProcessor(nil)},
+		{DelegateEditorsHinter, NewDelegateEditorsProcessor(nil)},
+		{CastVoteHinter, NewCastVoteProcessor(nil)},
+		{CloseVotingHinter, NewCloseVotingProcessor(nil)},
+		{CommitVoteHinter, NewCommitVoteProcessor(nil)},
+		{RevealVoteHinter, NewRevealVoteProcessor(nil)},
+		{RentLandHinter, NewRentLandProcessor(nil)},
+		{RenewLeaseHinter, NewRenewLeaseProcessor(nil)},
+		{EndLeaseHinter, NewEndLeaseProcessor(nil)},
+		{DepositGoldHinter, NewDepositGoldProcessor(nil)},
+		{WithdrawGoldHinter, NewWithdrawGoldProcessor(nil)},
+		{TransferGoldHinter, NewTransferGoldProcessor(nil)},
+		{UpdateGoldRateHinter, NewUpdateGoldRateProcessor(nil)},
+		{SwapGoldHinter, NewSwapGoldProcessor(nil)},
+		{UpdateStatPolicyHinter, NewUpdateStatPolicyProcessor(nil)},
+		{ChangeStatsHinter, NewChangeStatsProcessor(nil)},
+		{RegisterDocumentTypeHinter, NewRegisterDocumentTypeProcessor(pubs, threshold)},
+		{GrantPermissionHinter, NewGrantPermissionProcessor(pubs, threshold)},
+		{RevokePermissionHinter, NewRevokePermissionProcessor(pubs, threshold)},
+	} {
+		_, err := opr.SetProcessor(i.hinter, i.f)
+		t.NoError(err)
+	}
+
+	return opr
+}
+
+// process preprocesses all the operations before processing them, like the
+// proposal processor; it returns the errors of PreProcess by operation.
+func (t *baseTestOperationProcessor) process(opr prprocessor.OperationProcessor, ops ...state.Processor) []error {
+	errs := make([]error, len(ops))
+	pprs := make([]state.Processor, len(ops))
+	for i := range ops {
+		pprs[i], errs[i] = opr.PreProcess(ops[i])
+	}
+
+	for i := range pprs {
+		if errs[i] != nil {
+			continue
+		}
+
+		t.NoError(opr.Process(pprs[i]))
+	}
+
+	return errs
+}
+
+func (t *baseTestOperationProcessor) newAccount() (testAccount, []state.State) {
+	priv := key.NewBasePrivatekey()
+
+	k, err := currency.NewBaseAccountKey(priv.Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := currency.NewBaseAccountKeys([]currency.AccountKey{k}, 100)
+	t.NoError(err)
+
+	ac, err := currency.NewAccountFromKeys(keys)
+	t.NoError(err)
+
+	value, err := state.NewHintedValue(ac)
+	t.NoError(err)
+
+	ast, err := state.NewStateV0(currency.StateKeyAccount(ac.Address()), value, base.NilHeight)
+	t.NoError(err)
+
+	return testAccount{Account: ac, priv: priv}, []state.State{ast, t.newBalanceState(ac.Address(), currency.NewBig(1000), t.cid)}
+}
+
+// newAdmin returns the account with blockcityAdmin permission.
+func (t *baseTestOperationProcessor) newAdmin() (testAccount, []state.State) {
+	ac, sts := t.newAccount()
+
+	eac, err := extension.NewAccount(ac.Address(), ac.Keys())
+	t.NoError(err)
+
+	eac, err = eac.AddPermission(extension.BlockcityAdmin)
+	t.NoError(err)
+
+	st, err := state.NewStateV0(extension.StateKeyAccount(ac.Address()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := extension.SetStateAccountValue(st, eac)
+	t.NoError(err)
+
+	return ac, append(sts, nst)
+}
+
+func (t *baseTestOperationProcessor) newBalanceState(a base.Address, big currency.Big, cid currency.CurrencyID) state.State {
+	st, err := state.NewStateV0(currency.StateKeyBalance(a, cid), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := currency.SetStateBalanceValue(st, currency.NewAmount(big, cid))
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newDocumentState(doc DocumentData) state.State {
+	st, err := state.NewStateV0(StateKeyDocumentData(doc.DocumentId()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateDocumentDataValue(st, doc)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newInventoryState(owner base.Address, docs ...DocumentData) state.State {
+	infos := make([]DocInfo, len(docs))
+	for i := range docs {
+		infos[i] = docs[i].Info()
+	}
+
+	st, err := state.NewStateV0(StateKeyDocuments(owner), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateDocumentsValue(st, NewDocumentInventory(infos))
+	t.NoError(err)
+
+	return nst
+}
+
+// newDocumentStates returns the document states and the inventory state of
+// owner with the documents.
+func (t *baseTestOperationProcessor) newDocumentStates(owner base.Address, docs ...DocumentData) []state.State {
+	sts := make([]state.State, len(docs)+1)
+	for i := range docs {
+		sts[i] = t.newDocumentState(docs[i])
+	}
+
+	sts[len(docs)] = t.newInventoryState(owner, docs...)
+
+	return sts
+}
+
+func (t *baseTestOperationProcessor) newBSDocData(
+	owner base.Address, documentid string, signers ...base.Address,
+) BSDocData {
+	dss := make([]DocSign, len(signers))
+	for i := range signers {
+		dss[i] = NewDocSign(signers[i], "signcode", false)
+	}
+
+	return MustNewBSDocData(
+		MustNewDocInfo(documentid, BSDocDataType),
+		owner,
+		FileHash("filehash"),
+		NewDocSign(owner, "signcode", true),
+		"title",
+		currency.NewBig(10),
+		dss,
+	)
+}
+
+// newBCVotingData returns the voting document of the first round, which takes
+// the votes until deadline.
+func (t *baseTestOperationProcessor) newBCVotingData(
+	owner base.Address, documentid string, deadline base.Height, candidates ...base.Address,
+) BCVotingData {
+	vcs := make([]VotingCandidate, len(candidates))
+	for i := range candidates {
+		vcs[i] = NewVotingCandidate(candidates[i], "nickname", "manifest", 0)
+	}
+
+	return MustNewBCVotingData(
+		MustNewDocInfo(documentid, BCVotingDataType),
+		owner,
+		1,
+		NewDocumentTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		vcs,
+		"",
+		owner,
+		NewDocumentTerm(time.Hour*720),
+	).WithDeadline(deadline)
+}
+
+func (t *baseTestOperationProcessor) newBCUserData(owner base.Address, documentid string, gold, bankgold uint) BCUserData {
+	return MustNewBCUserData(
+		MustNewDocInfo(documentid, BCUserDataType),
+		owner,
+		gold,
+		bankgold,
+		MustNewUserStatistics(1, 1, 1, 1, 1, 1, 1),
+	)
+}
+
+func (t *baseTestOperationProcessor) newSignDocuments(sender testAccount, owner base.Address, documentid string) SignDocuments {
+	items := []SignDocumentItem{NewSignDocumentsItemSingleFile(documentid, owner, t.cid)}
+	fact := NewSignDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewSignDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *baseTestOperationProcessor) newCastVote(sender testAccount, documentid string, candidate base.Address) CastVote {
+	items := []CastVoteItem{NewCastVoteItemImpl(documentid, candidate, t.cid)}
+	fact := NewCastVoteFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewCastVote(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *baseTestOperationProcessor) newCommitVote(
+	sender testAccount, documentid string, candidate base.Address, salt string,
+) CommitVote {
+	commitment := NewVoteCommitment(documentid, sender.Address(), candidate, salt)
+
+	items := []CommitVoteItem{NewCommitVoteItemImpl(documentid, commitment, t.cid)}
+	fact := NewCommitVoteFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewCommitVote(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+// newSecretBCVotingData returns the voting document, which takes the commits
+// until 33 and the reveals until 66.
+func (t *baseTestOperationProcessor) newSecretBCVotingData(owner base.Address, candidates ...base.Address) BCVotingData {
+	return t.newBCVotingData(owner, "1cvi", base.Height(33), candidates...).WithRevealDeadline(base.Height(66))
+}
+
+func (t *baseTestOperationProcessor) newFactSigns(fact base.Fact, privs ...key.Privatekey) []base.FactSign {
+	fs := make([]base.FactSign, len(privs))
+	for i := range privs {
+		sig, err := base.NewFactSignature(privs[i], fact, t.networkID)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(privs[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+// updated returns the state updated in pool.
+func (t *baseTestOperationProcessor) updated(pool *storage.Statepool, key string) (state.State, bool) {
+	us := pool.Updates()
+	for i := range us {
+		if us[i].Key() == key {
+			return us[i].GetState(), true
+		}
+	}
+
+	return nil, false
+}
+
+func (t *baseTestOperationProcessor) updatedDocument(pool *storage.Statepool, documentid string) DocumentData {
+	st, found := t.updated(pool, StateKeyDocumentData(documentid))
+	t.True(found, "document, %q not updated", documentid)
+
+	doc, err := StateDocumentDataValue(st)
+	t.NoError(err)
+
+	return doc
+}
+
+// updatedBalance returns the balance of account in t.cid updated in pool.
+func (t *baseTestOperationProcessor) updatedBalance(pool *storage.Statepool, a base.Address) currency.Big {
+	st, found := t.updated(pool, currency.StateKeyBalance(a, t.cid))
+	t.True(found)
+
+	am, err := currency.StateBalanceValue(st)
+	t.NoError(err)
+
+	return am.Big()
+}
+
+func (t *baseTestOperationProcessor) updatedInventory(pool *storage.Statepool, owner base.Address) DocumentInventory {
+	st, found := t.updated(pool, StateKeyDocuments(owner))
+	t.True(found, "document inventory of %v not updated", owner)
+
+	dinv, err := StateDocumentsValue(st)
+	t.NoError(err)
+
+	return dinv
+}
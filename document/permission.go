@@ -0,0 +1,78 @@
+package document
+
+import (
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+)
+
+// DocumentDataPermission returns the account permission to create or update
+// the document data; false means any account can do.
+func DocumentDataPermission(doc DocumentData) (extension.AccountPermission, bool) {
+	switch doc.(type) {
+	case BCUserData, BCLandData, BCVotingData, BCHistoryData:
+		return extension.BlockcityAdmin, true
+	default:
+		return 0, false
+	}
+}
+
+// checkDocumentDataPermission checks the extended account of a in state has
+// the permission for the document data.
+func checkDocumentDataPermission(
+	a base.Address,
+	doc DocumentData,
+	getState func(key string) (state.State, bool, error),
+) error {
+	ap, required := DocumentDataPermission(doc)
+	if !required {
+		return nil
+	}
+
+	switch ok, err := hasAccountPermission(a, ap, getState); {
+	case err != nil:
+		return err
+	case !ok:
+		return operation.NewBaseReasonError("account, %v has no permission, %q for %q", a, ap, doc.DocumentType())
+	default:
+		return nil
+	}
+}
+
+// checkAccountPermission checks the extended account of a in state has the
+// permission.
+func checkAccountPermission(
+	a base.Address,
+	ap extension.AccountPermission,
+	getState func(key string) (state.State, bool, error),
+) error {
+	switch ok, err := hasAccountPermission(a, ap, getState); {
+	case err != nil:
+		return err
+	case !ok:
+		return operation.NewBaseReasonError("account, %v has no permission, %q", a, ap)
+	default:
+		return nil
+	}
+}
+
+func hasAccountPermission(
+	a base.Address,
+	ap extension.AccountPermission,
+	getState func(key string) (state.State, bool, error),
+) (bool, error) {
+	switch st, found, err := getState(extension.StateKeyAccount(a)); {
+	case err != nil:
+		return false, err
+	case !found:
+		return false, nil
+	default:
+		ac, err := extension.StateAccountValue(st)
+		if err != nil {
+			return false, err
+		}
+
+		return ac.HasPermission(ap), nil
+	}
+}
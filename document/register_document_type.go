@@ -0,0 +1,102 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RegisterDocumentTypeFactType   = hint.Type("mitum-register-document-type-operation-fact")
+	RegisterDocumentTypeFactHint   = hint.NewHint(RegisterDocumentTypeFactType, "v0.0.1")
+	RegisterDocumentTypeFactHinter = RegisterDocumentTypeFact{BaseHinter: hint.NewBaseHinter(RegisterDocumentTypeFactHint)}
+	RegisterDocumentTypeType       = hint.Type("mitum-register-document-type-operation")
+	RegisterDocumentTypeHint       = hint.NewHint(RegisterDocumentTypeType, "v0.0.1")
+	RegisterDocumentTypeHinter     = RegisterDocumentType{BaseOperation: operationHinter(RegisterDocumentTypeHint)}
+)
+
+// RegisterDocumentTypeFact registers new document type; like
+// currency.CurrencyRegister, it should be signed by the suffrage nodes.
+type RegisterDocumentTypeFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	design DocTypeDesign
+}
+
+func NewRegisterDocumentTypeFact(token []byte, design DocTypeDesign) RegisterDocumentTypeFact {
+	fact := RegisterDocumentTypeFact{
+		BaseHinter: hint.NewBaseHinter(RegisterDocumentTypeFactHint),
+		token:      token,
+		design:     design,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RegisterDocumentTypeFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RegisterDocumentTypeFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RegisterDocumentTypeFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.design.Bytes(),
+	)
+}
+
+func (fact RegisterDocumentTypeFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for RegisterDocumentTypeFact")
+	}
+
+	if err := isvalid.Check(nil, false, fact.design); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RegisterDocumentTypeFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RegisterDocumentTypeFact) Design() DocTypeDesign {
+	return fact.design
+}
+
+type RegisterDocumentType struct {
+	currency.BaseOperation
+}
+
+func NewRegisterDocumentType(
+	fact RegisterDocumentTypeFact, fs []base.FactSign, memo string,
+) (RegisterDocumentType, error) {
+	bo, err := currency.NewBaseOperationFromFact(RegisterDocumentTypeHint, fact, fs, memo)
+	if err != nil {
+		return RegisterDocumentType{}, err
+	}
+
+	return RegisterDocumentType{BaseOperation: bo}, nil
+}
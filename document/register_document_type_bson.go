@@ -0,0 +1,45 @@
+package document // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact RegisterDocumentTypeFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":   fact.h,
+				"token":  fact.token,
+				"design": fact.design,
+			}))
+}
+
+type RegisterDocumentTypeFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	DE bson.Raw        `bson:"design"`
+}
+
+func (fact *RegisterDocumentTypeFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var urf RegisterDocumentTypeFactBSONUnpacker
+	if err := bson.Unmarshal(b, &urf); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, urf.H, urf.TK, urf.DE)
+}
+
+func (op *RegisterDocumentType) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
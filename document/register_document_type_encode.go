@@ -0,0 +1,30 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *RegisterDocumentTypeFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	tk []byte,
+	bde []byte,
+) error {
+	hinter, err := enc.Decode(bde)
+	if err != nil {
+		return err
+	}
+
+	de, ok := hinter.(DocTypeDesign)
+	if !ok {
+		return errors.Errorf("not DocTypeDesign: %T", hinter)
+	}
+
+	fact.h = h
+	fact.token = tk
+	fact.design = de
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type RegisterDocumentTypeFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	DE DocTypeDesign  `json:"design"`
+}
+
+func (fact RegisterDocumentTypeFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RegisterDocumentTypeFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		DE:         fact.design,
+	})
+}
+
+type RegisterDocumentTypeFactJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	TK []byte          `json:"token"`
+	DE json.RawMessage `json:"design"`
+}
+
+func (fact *RegisterDocumentTypeFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var urf RegisterDocumentTypeFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &urf); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, urf.H, urf.TK, urf.DE)
+}
+
+func (op *RegisterDocumentType) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
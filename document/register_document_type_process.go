@@ -0,0 +1,109 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op RegisterDocumentType) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RegisterDocumentTypeProcessor struct {
+	RegisterDocumentType
+	pubs      []key.Publickey
+	threshold base.Threshold
+	nst       state.State // new document type registry state
+}
+
+func NewRegisterDocumentTypeProcessor(
+	pubs []key.Publickey,
+	threshold base.Threshold,
+) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RegisterDocumentType)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not RegisterDocumentType, %T", op)
+		}
+
+		return &RegisterDocumentTypeProcessor{
+			RegisterDocumentType: i,
+			pubs:                 pubs,
+			threshold:            threshold,
+		}, nil
+	}
+}
+
+func (opp *RegisterDocumentTypeProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, operation.NewBaseReasonError("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	st, err := opp.register(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.nst = st
+
+	return opp, nil
+}
+
+// Process sets the registry state with the document type registered in
+// PreProcess; the registry is replaced as a whole, so only one
+// RegisterDocumentType is allowed in the same proposal, see
+// OperationProcessor.checkDuplication.
+func (opp *RegisterDocumentTypeProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	return setState(opp.Fact().Hash(), opp.nst)
+}
+
+func (opp *RegisterDocumentTypeProcessor) register(
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	fact := opp.Fact().(RegisterDocumentTypeFact)
+
+	st, found, err := getState(StateKeyDocTypeRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	rg := NewDocTypeRegistry(nil)
+	if found {
+		i, err := StateDocTypeRegistryValue(st)
+		if err != nil {
+			return nil, err
+		}
+		rg = i
+	}
+
+	nrg, err := rg.Add(fact.Design())
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return SetStateDocTypeRegistryValue(st, nrg)
+}
+
+func (opp *RegisterDocumentTypeProcessor) Close() error {
+	opp.RegisterDocumentType = RegisterDocumentType{}
+	opp.pubs = nil
+	opp.threshold = base.Threshold{}
+	opp.nst = nil
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRegisterDocumentTypeProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testRegisterDocumentTypeProcessor) newRegisterDocumentType(
+	shortType string, docType hint.Type, fields ...DocField,
+) RegisterDocumentType {
+	if len(fields) < 1 {
+		fields = []DocField{NewDocField("title", DocFieldKindString, true, 0)}
+	}
+
+	design := NewDocTypeDesign(shortType, docType, fields)
+	fact := NewRegisterDocumentTypeFact(util.UUID().Bytes(), design)
+
+	op, err := NewRegisterDocumentType(fact, t.newFactSigns(fact, t.suffrage), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRegisterDocumentTypeProcessor) TestRegisterInSameBlock() {
+	pool, opr := t.statepool()
+
+	errs := t.process(opr,
+		t.newRegisterDocumentType("abc", hint.Type("showme-abc")),
+		t.newRegisterDocumentType("def", hint.Type("showme-def")),
+	)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document type registration")
+
+	st, found := t.updated(pool, StateKeyDocTypeRegistry)
+	t.True(found)
+
+	rg, err := StateDocTypeRegistryValue(st)
+	t.NoError(err)
+	t.Equal(1, len(rg.Designs()))
+
+	// the other is registered in the next block
+	pool, opr = t.nextStatepool(pool)
+
+	errs = t.process(opr, t.newRegisterDocumentType("def", hint.Type("showme-def")))
+	t.NoError(errs[0])
+
+	st, found = t.updated(pool, StateKeyDocTypeRegistry)
+	t.True(found)
+
+	rg, err = StateDocTypeRegistryValue(st)
+	t.NoError(err)
+	t.Equal(2, len(rg.Designs()))
+
+	_, found = rg.Get("abc")
+	t.True(found)
+	_, found = rg.Get("def")
+	t.True(found)
+}
+
+func (t *testRegisterDocumentTypeProcessor) TestAlreadyRegistered() {
+	pool, opr := t.statepool()
+
+	errs := t.process(opr, t.newRegisterDocumentType("abc", hint.Type("showme-abc")))
+	t.NoError(errs[0])
+
+	_, opr = t.nextStatepool(pool)
+
+	errs = t.process(opr, t.newRegisterDocumentType("abc", hint.Type("showme-ghi")))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "short type already registered")
+}
+
+func (t *testRegisterDocumentTypeProcessor) newCreateDocuments(sender testAccount, doc DocumentData) CreateDocuments {
+	items := []CreateDocumentsItem{NewCreateDocumentsItemImpl(doc, t.cid)}
+	fact := NewCreateDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewCreateDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRegisterDocumentTypeProcessor) newTransferDocuments(
+	sender testAccount, receiver base.Address, documentid string,
+) TransferDocuments {
+	items := []TransferDocumentsItem{NewTransferDocumentsItemImpl(documentid, receiver, t.cid)}
+	fact := NewTransferDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewTransferDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+// TestCreateAndTransferRegisteredType processes the document of the type
+// registered in the previous block; the node is not restarted between the
+// blocks.
+func (t *testRegisterDocumentTypeProcessor) TestCreateAndTransferRegisteredType() {
+	owner, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+
+	docType := hint.Type("showme-abc")
+
+	pool, opr := t.statepool(sts0, sts1)
+
+	errs := t.process(opr, t.newRegisterDocumentType("abc", docType,
+		NewDocField("title", DocFieldKindString, true, 0),
+		NewDocField("holder", DocFieldKindAddress, true, 0),
+	))
+	t.NoError(errs[0])
+
+	rsts := t.updates(pool)
+
+	newDoc := func(id, holder string) GeneralDocData {
+		return NewGeneralDocData(
+			NewGeneralDocInfo(id, docType),
+			owner.Address(),
+			map[string]string{"title": "title", "holder": holder},
+		)
+	}
+
+	doc := newDoc("1abc", receiver.Address().String())
+
+	// the value of address field should be the currency address
+	invalids := []string{"receiver", receiver.Address().String() + " ", "receiver" + base.StringAddressType.String()}
+	for i := range invalids {
+		_, opr = t.nextStatepool(pool, sts0, sts1)
+
+		errs = t.process(opr, t.newCreateDocuments(owner, newDoc("2abc", invalids[i])))
+		t.Error(errs[0], "%d: %q", i, invalids[i])
+		t.Contains(errs[0].Error(), "is not address", "%d: %q", i, invalids[i])
+	}
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1)
+
+	errs = t.process(opr, t.newCreateDocuments(owner, doc))
+	t.NoError(errs[0])
+
+	created := t.updatedDocument(pool, doc.DocumentId()).(GeneralDocData)
+	v, _ := created.Value("holder")
+	t.Equal(receiver.Address().String(), v)
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, rsts)
+
+	errs = t.process(opr, t.newTransferDocuments(owner, receiver.Address(), doc.DocumentId()))
+	t.NoError(errs[0])
+
+	t.True(t.updatedDocument(pool, doc.DocumentId()).Owner().Equal(receiver.Address()))
+	t.True(t.updatedInventory(pool, receiver.Address()).Exists(doc.DocumentId()))
+}
+
+func TestRegisterDocumentTypeProcessor(t *testing.T) {
+	suite.Run(t, new(testRegisterDocumentTypeProcessor))
+}
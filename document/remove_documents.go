@@ -0,0 +1,161 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RemoveDocumentsFactType   = hint.Type("mitum-remove-documents-operation-fact")
+	RemoveDocumentsFactHint   = hint.NewHint(RemoveDocumentsFactType, "v0.0.1")
+	RemoveDocumentsFactHinter = RemoveDocumentsFact{BaseHinter: hint.NewBaseHinter(RemoveDocumentsFactHint)}
+	RemoveDocumentsType       = hint.Type("mitum-remove-documents-operation")
+	RemoveDocumentsHint       = hint.NewHint(RemoveDocumentsType, "v0.0.1")
+	RemoveDocumentsHinter     = RemoveDocuments{BaseOperation: operationHinter(RemoveDocumentsHint)}
+)
+
+var MaxRemoveDocumentsItems uint = 10
+
+type RemoveDocumentsItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Currency() currency.CurrencyID
+	Rebuild() RemoveDocumentsItem
+}
+
+type RemoveDocumentsFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []RemoveDocumentsItem
+}
+
+func NewRemoveDocumentsFact(token []byte, sender base.Address, items []RemoveDocumentsItem) RemoveDocumentsFact {
+	fact := RemoveDocumentsFact{
+		BaseHinter: hint.NewBaseHinter(RemoveDocumentsFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RemoveDocumentsFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RemoveDocumentsFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RemoveDocumentsFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact RemoveDocumentsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for RemoveDocumentsFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxRemoveDocumentsItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxRemoveDocumentsItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	docIdMap := map[string]bool{}
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		k := it.DocumentId()
+		if _, found := docIdMap[k]; found {
+			return errors.Errorf("duplicated document id, %s", k)
+		}
+		docIdMap[k] = true
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RemoveDocumentsFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RemoveDocumentsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveDocumentsFact) Items() []RemoveDocumentsItem {
+	return fact.items
+}
+
+func (fact RemoveDocumentsFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact RemoveDocumentsFact) Rebuild() RemoveDocumentsFact {
+	items := make([]RemoveDocumentsItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type RemoveDocuments struct {
+	currency.BaseOperation
+}
+
+func NewRemoveDocuments(fact RemoveDocumentsFact, fs []base.FactSign, memo string) (RemoveDocuments, error) {
+	bo, err := currency.NewBaseOperationFromFact(RemoveDocumentsHint, fact, fs, memo)
+	if err != nil {
+		return RemoveDocuments{}, err
+	} else {
+
+		return RemoveDocuments{BaseOperation: bo}, nil
+	}
+}
@@ -0,0 +1,66 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	RemoveDocumentsItemImplType   = hint.Type("mitum-remove-documents-item")
+	RemoveDocumentsItemImplHint   = hint.NewHint(RemoveDocumentsItemImplType, "v0.0.1")
+	RemoveDocumentsItemImplHinter = RemoveDocumentsItemImpl{BaseHinter: hint.NewBaseHinter(RemoveDocumentsItemImplHint)}
+)
+
+type RemoveDocumentsItemImpl struct {
+	hint.BaseHinter
+	id  string
+	cid currency.CurrencyID
+}
+
+func NewRemoveDocumentsItemImpl(
+	id string,
+	cid currency.CurrencyID) RemoveDocumentsItemImpl {
+
+	return RemoveDocumentsItemImpl{
+		BaseHinter: hint.NewBaseHinter(RemoveDocumentsItemImplHint),
+		id:         id,
+		cid:        cid,
+	}
+}
+
+func (it RemoveDocumentsItemImpl) Bytes() []byte {
+	bs := make([][]byte, 2)
+	bs[0] = []byte(it.id)
+	bs[1] = it.cid.Bytes()
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it RemoveDocumentsItemImpl) IsValid([]byte) error {
+	if _, _, err := ParseDocId(it.id); err != nil {
+		return isvalid.InvalidError.Errorf("invalid RemoveDocumentsItem: %w", err)
+	}
+
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid RemoveDocumentsItem: %w", err)
+	}
+	return nil
+}
+
+func (it RemoveDocumentsItemImpl) DocumentId() string {
+	return it.id
+}
+
+func (it RemoveDocumentsItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it RemoveDocumentsItemImpl) Rebuild() RemoveDocumentsItem {
+	return it
+}
@@ -0,0 +1,30 @@
+package document // nolint:dupl
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it RemoveDocumentsItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.id,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type RemoveDocumentsItemImplBSONUnpacker struct {
+	DI string `bson:"documentid"`
+	CI string `bson:"currency"`
+}
+
+func (it *RemoveDocumentsItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var urd RemoveDocumentsItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &urd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, urd.DI, urd.CI)
+}
@@ -0,0 +1,17 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *RemoveDocumentsItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	scid string,
+) error {
+	it.id = di
+	it.cid = currency.CurrencyID(scid)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type RemoveDocumentsItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it RemoveDocumentsItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RemoveDocumentsItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.id,
+		CI:         it.cid,
+	})
+}
+
+type RemoveDocumentsItemImplJSONUnpacker struct {
+	DI string `json:"documentid"`
+	CI string `json:"currency"`
+}
+
+func (it *RemoveDocumentsItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var urd RemoveDocumentsItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &urd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, urd.DI, urd.CI)
+}
@@ -0,0 +1,276 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var RemoveDocumentsItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RemoveDocumentsItemProcessor)
+	},
+}
+
+var RemoveDocumentsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RemoveDocumentsProcessor)
+	},
+}
+
+func (op RemoveDocuments) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RemoveDocumentsItemProcessor struct {
+	cp     *currency.CurrencyPool
+	h      valuehash.Hash
+	sender base.Address
+	item   RemoveDocumentsItem
+	dd     DocumentData // document data to be archived
+	nds    state.State  // document data state (key = document id)
+}
+
+func (opp *RemoveDocumentsItemProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) error {
+
+	if err := opp.item.IsValid(nil); err != nil {
+		return operation.NewBaseReasonError(err.Error())
+	}
+
+	if err := checkDocIdRegistered(opp.item.DocumentId(), getState); err != nil {
+		return err
+	}
+
+	// check existence of document state with documentid
+	switch st, found, err := getState(StateKeyDocumentData(opp.item.DocumentId())); {
+	case err != nil:
+		return err
+	case !found:
+		return operation.NewBaseReasonError("document not registered with documentid, %q", opp.item.DocumentId())
+	default:
+		opp.nds = st
+	}
+
+	dd, err := StateDocumentDataValue(opp.nds)
+	if err != nil {
+		return err
+	}
+
+	if IsArchivedDocumentData(dd) {
+		return operation.NewBaseReasonError("document already removed, %q", opp.item.DocumentId())
+	}
+
+	if !dd.Owner().Equal(opp.sender) {
+		return operation.NewBaseReasonError("sender not matched with Owner in document, %v", opp.sender)
+	}
+
+	opp.dd = dd
+
+	return nil
+}
+
+func (opp *RemoveDocumentsItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+
+	sts := make([]state.State, 1)
+
+	// set archived document state
+	if dst, err := SetStateDocumentDataValue(opp.nds, NewArchivedDocumentData(opp.dd)); err != nil {
+		return nil, err
+	} else {
+		sts[0] = dst
+	}
+
+	return sts, nil
+}
+
+func (opp *RemoveDocumentsItemProcessor) Close() error {
+	opp.cp = nil
+	opp.h = nil
+	opp.sender = nil
+	opp.item = nil
+	opp.dd = nil
+	opp.nds = nil
+
+	RemoveDocumentsItemProcessorPool.Put(opp)
+
+	return nil
+}
+
+type RemoveDocumentsProcessor struct {
+	cp *currency.CurrencyPool
+	RemoveDocuments
+	dinv     DocumentInventory
+	ndinvs   state.State
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	ns       []*RemoveDocumentsItemProcessor              // ItemProcessor
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewRemoveDocumentsProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RemoveDocuments)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not RemoveDocuments, %T", op)
+		}
+
+		opp := RemoveDocumentsProcessorPool.Get().(*RemoveDocumentsProcessor)
+
+		opp.cp = cp
+		opp.RemoveDocuments = i
+		opp.dinv = DocumentInventory{}
+		opp.ndinvs = nil
+		opp.sb = nil
+		opp.ns = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *RemoveDocumentsProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RemoveDocumentsFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// check existence of document inventory state with sender address
+	switch st, found, err := getState(StateKeyDocuments(fact.sender)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, operation.NewBaseReasonError("sender has no document inventory, %v", fact.sender)
+	default:
+		dinv, err := StateDocumentsValue(st)
+		if err != nil {
+			return nil, err
+		}
+		opp.dinv = dinv
+		opp.ndinvs = st
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	// prepare item processor for each items
+	ns := make([]*RemoveDocumentsItemProcessor, len(fact.items))
+	for i := range fact.items {
+		if !opp.dinv.Exists(fact.items[i].DocumentId()) {
+			return nil, operation.NewBaseReasonError(
+				"document not found in sender's document inventory, %q", fact.items[i].DocumentId())
+		}
+
+		c := RemoveDocumentsItemProcessorPool.Get().(*RemoveDocumentsItemProcessor)
+		c.cp = opp.cp
+		c.h = opp.Hash()
+		c.sender = fact.sender
+		c.item = fact.items[i]
+
+		if err := c.PreProcess(getState, setState); err != nil {
+			return nil, err
+		}
+
+		ns[i] = c
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.ns = ns
+
+	return opp, nil
+}
+
+func (opp *RemoveDocumentsProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	// get fact
+	fact := opp.Fact().(RemoveDocumentsFact)
+
+	var sts []state.State // nolint:prealloc
+
+	// append archived document data state and remove doc info from sender document inventory
+	for i := range opp.ns {
+		if s, err := opp.ns[i].Process(getState, setState); err != nil {
+			return operation.NewBaseReasonError("failed to process remove document item: %w", err)
+		} else {
+			sts = append(sts, s...)
+		}
+
+		if err := opp.dinv.Remove(opp.ns[i].dd.Info()); err != nil {
+			return err
+		}
+	}
+
+	opp.dinv.Sort(true)
+
+	// prepare document inventory state and append it
+	if dinvs, err := SetStateDocumentsValue(opp.ndinvs, opp.dinv); err != nil {
+		return err
+	} else {
+		sts = append(sts, dinvs)
+	}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *RemoveDocumentsProcessor) Close() error {
+	for i := range opp.ns {
+		_ = opp.ns[i].Close()
+	}
+
+	opp.cp = nil
+	opp.RemoveDocuments = RemoveDocuments{}
+	opp.dinv = DocumentInventory{}
+	opp.ndinvs = nil
+	opp.sb = nil
+	opp.ns = nil
+	opp.required = nil
+
+	RemoveDocumentsProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *RemoveDocumentsProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(RemoveDocumentsFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
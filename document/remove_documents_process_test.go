@@ -0,0 +1,54 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRemoveDocumentsProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testRemoveDocumentsProcessor) newRemoveDocuments(sender testAccount, documentid string) RemoveDocuments {
+	items := []RemoveDocumentsItem{NewRemoveDocumentsItemImpl(documentid, t.cid)}
+	fact := NewRemoveDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewRemoveDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRemoveDocumentsProcessor) TestRemoveSignedInSameBlock() {
+	owner, sts0 := t.newAccount()
+	signer, sts1 := t.newAccount()
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", signer.Address())
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	remove := t.newRemoveDocuments(owner, doc.DocumentId())
+
+	// the remove is rejected instead of overwriting the signature
+	errs := t.process(opr, t.newSignDocuments(signer, owner.Address(), doc.DocumentId()), remove)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document")
+
+	t.True(t.updatedDocument(pool, doc.DocumentId()).(BSDocData).Signers()[0].Signed())
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, remove)
+	t.NoError(errs[0])
+
+	t.True(IsArchivedDocumentData(t.updatedDocument(pool, doc.DocumentId())))
+}
+
+func TestRemoveDocumentsProcessor(t *testing.T) {
+	suite.Run(t, new(testRemoveDocumentsProcessor))
+}
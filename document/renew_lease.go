@@ -0,0 +1,162 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RenewLeaseFactType   = hint.Type("mitum-renew-lease-operation-fact")
+	RenewLeaseFactHint   = hint.NewHint(RenewLeaseFactType, "v0.0.1")
+	RenewLeaseFactHinter = RenewLeaseFact{BaseHinter: hint.NewBaseHinter(RenewLeaseFactHint)}
+	RenewLeaseType       = hint.Type("mitum-renew-lease-operation")
+	RenewLeaseHint       = hint.NewHint(RenewLeaseType, "v0.0.1")
+	RenewLeaseHinter     = RenewLease{BaseOperation: operationHinter(RenewLeaseHint)}
+)
+
+var MaxRenewLeaseItems uint = 10
+
+type RenewLeaseItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Period() base.Height
+	Rent() currency.Big
+	Currency() currency.CurrencyID
+	Rebuild() RenewLeaseItem
+}
+
+type RenewLeaseFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []RenewLeaseItem
+}
+
+func NewRenewLeaseFact(token []byte, sender base.Address, items []RenewLeaseItem) RenewLeaseFact {
+	fact := RenewLeaseFact{
+		BaseHinter: hint.NewBaseHinter(RenewLeaseFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RenewLeaseFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RenewLeaseFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RenewLeaseFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact RenewLeaseFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for RenewLeaseFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxRenewLeaseItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxRenewLeaseItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RenewLeaseFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RenewLeaseFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RenewLeaseFact) Items() []RenewLeaseItem {
+	return fact.items
+}
+
+func (fact RenewLeaseFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact RenewLeaseFact) Rebuild() RenewLeaseFact {
+	items := make([]RenewLeaseItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type RenewLease struct {
+	currency.BaseOperation
+}
+
+func NewRenewLease(fact RenewLeaseFact, fs []base.FactSign, memo string) (RenewLease, error) {
+	bo, err := currency.NewBaseOperationFromFact(RenewLeaseHint, fact, fs, memo)
+	if err != nil {
+		return RenewLease{}, err
+	} else {
+
+		return RenewLease{BaseOperation: bo}, nil
+	}
+}
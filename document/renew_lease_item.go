@@ -0,0 +1,95 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	RenewLeaseItemImplType   = hint.Type("mitum-renew-lease-item")
+	RenewLeaseItemImplHint   = hint.NewHint(RenewLeaseItemImplType, "v0.0.1")
+	RenewLeaseItemImplHinter = RenewLeaseItemImpl{BaseHinter: hint.NewBaseHinter(RenewLeaseItemImplHint)}
+)
+
+// RenewLeaseItemImpl extends the active lease of the blockcity land document by
+// the period of blocks. The rent is paid by the renter, who is the sender, to
+// the owner of land in the currency of item; the owner signs the fact with the
+// renter.
+type RenewLeaseItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	period     base.Height
+	rent       currency.Big
+	cid        currency.CurrencyID
+}
+
+func NewRenewLeaseItemImpl(
+	documentid string,
+	period base.Height,
+	rent currency.Big,
+	cid currency.CurrencyID) RenewLeaseItemImpl {
+
+	return RenewLeaseItemImpl{
+		BaseHinter: hint.NewBaseHinter(RenewLeaseItemImplHint),
+		documentid: documentid,
+		period:     period,
+		rent:       rent,
+		cid:        cid,
+	}
+}
+
+func (it RenewLeaseItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.period.Bytes(),
+		it.rent.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it RenewLeaseItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid RenewLeaseItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid RenewLeaseItem: empty documentid")
+	}
+
+	if it.period < base.Height(1) {
+		return isvalid.InvalidError.Errorf("invalid RenewLeaseItem: period should be over zero, %v", it.period)
+	}
+
+	if it.rent.Compare(currency.ZeroBig) < 0 {
+		return isvalid.InvalidError.Errorf("invalid RenewLeaseItem: negative rent, %v", it.rent)
+	}
+
+	return nil
+}
+
+func (it RenewLeaseItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it RenewLeaseItemImpl) Period() base.Height {
+	return it.period
+}
+
+func (it RenewLeaseItemImpl) Rent() currency.Big {
+	return it.rent
+}
+
+func (it RenewLeaseItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it RenewLeaseItemImpl) Rebuild() RenewLeaseItem {
+	return it
+}
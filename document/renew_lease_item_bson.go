@@ -0,0 +1,36 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it RenewLeaseItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"period":     it.period,
+				"rent":       it.rent,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type RenewLeaseItemImplBSONUnpacker struct {
+	DI string       `bson:"documentid"`
+	PR base.Height  `bson:"period"`
+	RN currency.Big `bson:"rent"`
+	CI string       `bson:"currency"`
+}
+
+func (it *RenewLeaseItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var url RenewLeaseItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &url); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, url.DI, url.PR, url.RN, url.CI)
+}
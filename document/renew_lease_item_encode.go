@@ -0,0 +1,22 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *RenewLeaseItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	pr base.Height,
+	rn currency.Big,
+	cid string,
+) error {
+	it.documentid = di
+	it.period = pr
+	it.rent = rn
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type RenewLeaseItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	PR base.Height         `json:"period"`
+	RN currency.Big        `json:"rent"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it RenewLeaseItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RenewLeaseItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		PR:         it.period,
+		RN:         it.rent,
+		CI:         it.cid,
+	})
+}
+
+type RenewLeaseItemImplJSONUnpacker struct {
+	DI string       `json:"documentid"`
+	PR base.Height  `json:"period"`
+	RN currency.Big `json:"rent"`
+	CI string       `json:"currency"`
+}
+
+func (it *RenewLeaseItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var url RenewLeaseItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &url); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, url.DI, url.PR, url.RN, url.CI)
+}
@@ -0,0 +1,180 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var RenewLeaseProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenewLeaseProcessor)
+	},
+}
+
+func (op RenewLease) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RenewLeaseProcessor struct {
+	cp *currency.CurrencyPool
+	RenewLease
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+	nsts     []state.State                                // renewed land documents and owner balances
+}
+
+func NewRenewLeaseProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RenewLease)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not RenewLease, %T", op)
+		}
+
+		opp := RenewLeaseProcessorPool.Get().(*RenewLeaseProcessor)
+
+		opp.cp = cp
+		opp.RenewLease = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+		opp.nsts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *RenewLeaseProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RenewLeaseProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RenewLeaseFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state; the sender, renter pays the rents
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	sts, owners, err := opp.renewLease(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	// check fact sign; the owners of lands sign the fact with the renter
+	if err := checkFactSignsByAccounts(append([]base.Address{fact.sender}, owners...), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.nsts = sts
+
+	return opp, nil
+}
+
+// Process sets the land documents renewed in PreProcess; the other operations
+// on the same land are not allowed in the same proposal, see
+// OperationProcessor.checkDocumentDuplication.
+func (opp *RenewLeaseProcessor) Process( // nolint:dupl
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RenewLeaseFact)
+
+	sts := make([]state.State, len(opp.nsts), len(opp.nsts)+len(opp.required))
+	copy(sts, opp.nsts)
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// renewLease returns the land document states, which leases are extended, the
+// balance states of the owners, who receive the rents, and the owners, who
+// should sign the fact. Only the active lease of sender can be renewed.
+func (opp *RenewLeaseProcessor) renewLease(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, []base.Address, error) {
+	fact := opp.Fact().(RenewLeaseFact)
+
+	var sts []state.State
+	var owners []base.Address
+	rp := newRentPayments()
+	for i := range fact.items {
+		it := fact.items[i]
+
+		dst, doc, err := loadLandDocument(it.DocumentId(), getState)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !doc.IsLeased(opp.height) {
+			return nil, nil, operation.NewBaseReasonError("land not leased, %q", it.DocumentId())
+		}
+
+		if !fact.sender.Equal(doc.Account()) {
+			return nil, nil, operation.NewBaseReasonError("sender is not renter of land, %q", it.DocumentId())
+		}
+
+		if err := rp.pay(fact.sender, doc.Owner(), it.Rent(), it.Currency(), getState); err != nil {
+			return nil, nil, err
+		}
+
+		nst, err := SetStateDocumentDataValue(dst, doc.lease(doc.Account(), doc.Renter(), doc.LeaseEnd()+it.Period()))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sts = append(sts, nst)
+		owners = appendAddress(owners, doc.Owner())
+	}
+
+	return append(sts, rp.states()...), owners, nil
+}
+
+func (opp *RenewLeaseProcessor) Close() error {
+	opp.cp = nil
+	opp.RenewLease = RenewLease{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+	opp.nsts = nil
+
+	RenewLeaseProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *RenewLeaseProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(RenewLeaseFact)
+	items := make([]rentItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return calculateRentItemsFee(opp.cp, items)
+}
@@ -0,0 +1,173 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RentLandFactType   = hint.Type("mitum-rent-land-operation-fact")
+	RentLandFactHint   = hint.NewHint(RentLandFactType, "v0.0.1")
+	RentLandFactHinter = RentLandFact{BaseHinter: hint.NewBaseHinter(RentLandFactHint)}
+	RentLandType       = hint.Type("mitum-rent-land-operation")
+	RentLandHint       = hint.NewHint(RentLandType, "v0.0.1")
+	RentLandHinter     = RentLand{BaseOperation: operationHinter(RentLandHint)}
+)
+
+var MaxRentLandItems uint = 10
+
+type RentLandItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Renter() base.Address
+	Name() string
+	Period() base.Height
+	Rent() currency.Big
+	Currency() currency.CurrencyID
+	Rebuild() RentLandItem
+}
+
+type RentLandFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []RentLandItem
+}
+
+func NewRentLandFact(token []byte, sender base.Address, items []RentLandItem) RentLandFact {
+	fact := RentLandFact{
+		BaseHinter: hint.NewBaseHinter(RentLandFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RentLandFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RentLandFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RentLandFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact RentLandFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for RentLandFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxRentLandItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxRentLandItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		if !it.Renter().Equal(fact.sender) {
+			return errors.Errorf("renter should be sender, %q", it.DocumentId())
+		}
+
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RentLandFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RentLandFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RentLandFact) Items() []RentLandItem {
+	return fact.items
+}
+
+func (fact RentLandFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	// NOTE renters can find the leases in their history
+	for i := range fact.items {
+		as = append(as, fact.items[i].Renter())
+	}
+
+	return as, nil
+}
+
+func (fact RentLandFact) Rebuild() RentLandFact {
+	items := make([]RentLandItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type RentLand struct {
+	currency.BaseOperation
+}
+
+func NewRentLand(fact RentLandFact, fs []base.FactSign, memo string) (RentLand, error) {
+	bo, err := currency.NewBaseOperationFromFact(RentLandHint, fact, fs, memo)
+	if err != nil {
+		return RentLand{}, err
+	} else {
+
+		return RentLand{BaseOperation: bo}, nil
+	}
+}
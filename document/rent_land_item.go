@@ -0,0 +1,111 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	RentLandItemImplType   = hint.Type("mitum-rent-land-item")
+	RentLandItemImplHint   = hint.NewHint(RentLandItemImplType, "v0.0.1")
+	RentLandItemImplHinter = RentLandItemImpl{BaseHinter: hint.NewBaseHinter(RentLandItemImplHint)}
+)
+
+// RentLandItemImpl leases the blockcity land document to the renter, who is the
+// sender, for the period of blocks. The rent is paid by the renter to the owner
+// of land in the currency of item; the owner signs the fact with the renter.
+type RentLandItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	renter     base.Address
+	name       string
+	period     base.Height
+	rent       currency.Big
+	cid        currency.CurrencyID
+}
+
+func NewRentLandItemImpl(
+	documentid string,
+	renter base.Address,
+	name string,
+	period base.Height,
+	rent currency.Big,
+	cid currency.CurrencyID) RentLandItemImpl {
+
+	return RentLandItemImpl{
+		BaseHinter: hint.NewBaseHinter(RentLandItemImplHint),
+		documentid: documentid,
+		renter:     renter,
+		name:       name,
+		period:     period,
+		rent:       rent,
+		cid:        cid,
+	}
+}
+
+func (it RentLandItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.renter.Bytes(),
+		[]byte(it.name),
+		it.period.Bytes(),
+		it.rent.Bytes(),
+		it.cid.Bytes(),
+	)
+}
+
+func (it RentLandItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.renter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid RentLandItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid RentLandItem: empty documentid")
+	}
+
+	if it.period < base.Height(1) {
+		return isvalid.InvalidError.Errorf("invalid RentLandItem: period should be over zero, %v", it.period)
+	}
+
+	if it.rent.Compare(currency.ZeroBig) < 0 {
+		return isvalid.InvalidError.Errorf("invalid RentLandItem: negative rent, %v", it.rent)
+	}
+
+	return nil
+}
+
+func (it RentLandItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it RentLandItemImpl) Renter() base.Address {
+	return it.renter
+}
+
+func (it RentLandItemImpl) Name() string {
+	return it.name
+}
+
+func (it RentLandItemImpl) Period() base.Height {
+	return it.period
+}
+
+func (it RentLandItemImpl) Rent() currency.Big {
+	return it.rent
+}
+
+func (it RentLandItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it RentLandItemImpl) Rebuild() RentLandItem {
+	return it
+}
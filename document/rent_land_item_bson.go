@@ -0,0 +1,40 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it RentLandItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"renter":     it.renter,
+				"name":       it.name,
+				"period":     it.period,
+				"rent":       it.rent,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type RentLandItemImplBSONUnpacker struct {
+	DI string              `bson:"documentid"`
+	RT base.AddressDecoder `bson:"renter"`
+	NM string              `bson:"name"`
+	PR base.Height         `bson:"period"`
+	RN currency.Big        `bson:"rent"`
+	CI string              `bson:"currency"`
+}
+
+func (it *RentLandItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var url RentLandItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &url); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, url.DI, url.RT, url.NM, url.PR, url.RN, url.CI)
+}
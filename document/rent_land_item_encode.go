@@ -0,0 +1,31 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *RentLandItemImpl) unpack(
+	enc encoder.Encoder,
+	di string,
+	brt base.AddressDecoder,
+	nm string,
+	pr base.Height,
+	rn currency.Big,
+	cid string,
+) error {
+	a, err := brt.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	it.documentid = di
+	it.renter = a
+	it.name = nm
+	it.period = pr
+	it.rent = rn
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type RentLandItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	RT base.Address        `json:"renter"`
+	NM string              `json:"name"`
+	PR base.Height         `json:"period"`
+	RN currency.Big        `json:"rent"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it RentLandItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RentLandItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		RT:         it.renter,
+		NM:         it.name,
+		PR:         it.period,
+		RN:         it.rent,
+		CI:         it.cid,
+	})
+}
+
+type RentLandItemImplJSONUnpacker struct {
+	DI string              `json:"documentid"`
+	RT base.AddressDecoder `json:"renter"`
+	NM string              `json:"name"`
+	PR base.Height         `json:"period"`
+	RN currency.Big        `json:"rent"`
+	CI string              `json:"currency"`
+}
+
+func (it *RentLandItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var url RentLandItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &url); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, url.DI, url.RT, url.NM, url.PR, url.RN, url.CI)
+}
@@ -0,0 +1,299 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var RentLandProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RentLandProcessor)
+	},
+}
+
+func (op RentLand) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RentLandProcessor struct {
+	cp *currency.CurrencyPool
+	RentLand
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+	nsts     []state.State                                // leased land documents and owner balances
+}
+
+func NewRentLandProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RentLand)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not RentLand, %T", op)
+		}
+
+		opp := RentLandProcessorPool.Get().(*RentLandProcessor)
+
+		opp.cp = cp
+		opp.RentLand = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+		opp.nsts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *RentLandProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RentLandProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RentLandFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state; the sender, renter pays the rents
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	sts, owners, err := opp.rentLand(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	// check fact sign; the owners of lands sign the fact with the renter
+	if err := checkFactSignsByAccounts(append([]base.Address{fact.sender}, owners...), opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.nsts = sts
+
+	return opp, nil
+}
+
+// Process sets the land documents leased in PreProcess; the other operations on
+// the same land are not allowed in the same proposal, see
+// OperationProcessor.checkDocumentDuplication.
+func (opp *RentLandProcessor) Process( // nolint:dupl
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RentLandFact)
+
+	sts := make([]state.State, len(opp.nsts), len(opp.nsts)+len(opp.required))
+	copy(sts, opp.nsts)
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// rentLand returns the land document states, which are leased to the sender,
+// the balance states of the owners, who receive the rents, and the owners,
+// who should sign the fact.
+func (opp *RentLandProcessor) rentLand(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, []base.Address, error) {
+	fact := opp.Fact().(RentLandFact)
+
+	var sts []state.State
+	var owners []base.Address
+	rp := newRentPayments()
+	for i := range fact.items {
+		it := fact.items[i]
+
+		dst, doc, err := loadLandDocument(it.DocumentId(), getState)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if doc.IsLeased(opp.height) {
+			return nil, nil, operation.NewBaseReasonError(
+				"land already leased until %v, %q", doc.LeaseEnd(), it.DocumentId())
+		}
+
+		if it.Renter().Equal(doc.Owner()) {
+			return nil, nil, operation.NewBaseReasonError("owner can not rent own land, %q", it.DocumentId())
+		}
+
+		if err := rp.pay(fact.sender, doc.Owner(), it.Rent(), it.Currency(), getState); err != nil {
+			return nil, nil, err
+		}
+
+		nst, err := SetStateDocumentDataValue(dst, doc.lease(it.Renter(), it.Name(), opp.height+it.Period()))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sts = append(sts, nst)
+		owners = appendAddress(owners, doc.Owner())
+	}
+
+	return append(sts, rp.states()...), owners, nil
+}
+
+func (opp *RentLandProcessor) Close() error {
+	opp.cp = nil
+	opp.RentLand = RentLand{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+	opp.nsts = nil
+
+	RentLandProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *RentLandProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(RentLandFact)
+	items := make([]rentItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return calculateRentItemsFee(opp.cp, items)
+}
+
+// rentItem is the item of RentLand and RenewLease, which rent is paid by
+// sender, the renter.
+type rentItem interface {
+	CurrencyItem
+	Rent() currency.Big
+}
+
+// calculateRentItemsFee returns the fees of items with the rents.
+func calculateRentItemsFee(cp *currency.CurrencyPool, items []rentItem) (map[currency.CurrencyID][2]currency.Big, error) {
+	cis := make([]CurrencyItem, len(items))
+	for i := range items {
+		cis[i] = items[i]
+	}
+
+	required, err := CalculateCurrencyItemsFee(cp, cis)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		rq := required[items[i].Currency()]
+		required[items[i].Currency()] = [2]currency.Big{rq[0].Add(items[i].Rent()), rq[1]}
+	}
+
+	return required, nil
+}
+
+// loadLandDocument returns the state and the blockcity land document of
+// documentid.
+func loadLandDocument(
+	documentid string,
+	getState func(key string) (state.State, bool, error),
+) (state.State, BCLandData, error) {
+	st, err := existsState(StateKeyDocumentData(documentid), "document", getState)
+	if err != nil {
+		return nil, BCLandData{}, err
+	}
+
+	dd, err := StateDocumentDataValue(st)
+	if err != nil {
+		return nil, BCLandData{}, err
+	}
+
+	if IsArchivedDocumentData(dd) {
+		return nil, BCLandData{}, operation.NewBaseReasonError("document already removed, %q", documentid)
+	}
+
+	doc, ok := dd.(BCLandData)
+	if !ok {
+		return nil, BCLandData{}, operation.NewBaseReasonError("not land document, %q", documentid)
+	}
+
+	return st, doc, nil
+}
+
+// rentPayments collects the rents paid to the owners of lands. The balance
+// states of owners are kept by key, so the rents of the items for the lands of
+// the same owner are added up.
+type rentPayments struct {
+	keys []string
+	bs   map[string]currency.AmountState
+}
+
+func newRentPayments() *rentPayments {
+	return &rentPayments{bs: map[string]currency.AmountState{}}
+}
+
+func (rp *rentPayments) pay(
+	sender, owner base.Address,
+	rent currency.Big,
+	cid currency.CurrencyID,
+	getState func(key string) (state.State, bool, error),
+) error {
+	if !rent.OverZero() {
+		return nil
+	}
+
+	if sender.Equal(owner) {
+		return operation.NewBaseReasonError("owner can not pay rent to itself, %v", owner)
+	}
+
+	k := currency.StateKeyBalance(owner, cid)
+	ob, found := rp.bs[k]
+	if !found {
+		st, _, err := getState(k)
+		if err != nil {
+			return err
+		}
+
+		ob = currency.NewAmountState(st, cid)
+		rp.keys = append(rp.keys, k)
+	}
+
+	rp.bs[k] = ob.Add(rent)
+
+	return nil
+}
+
+func (rp *rentPayments) states() []state.State {
+	sts := make([]state.State, len(rp.keys))
+	for i := range rp.keys {
+		sts[i] = rp.bs[rp.keys[i]]
+	}
+
+	return sts
+}
+
+// appendAddress appends the address, which is not in the addresses yet.
+func appendAddress(as []base.Address, a base.Address) []base.Address {
+	for i := range as {
+		if as[i].Equal(a) {
+			return as
+		}
+	}
+
+	return append(as, a)
+}
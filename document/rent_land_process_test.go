@@ -0,0 +1,204 @@
+package document
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRentLandProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testRentLandProcessor) newBCLandData(owner base.Address) BCLandData {
+	return MustNewBCLandData(
+		MustNewDocInfo("1cli", BCLandDataType),
+		owner,
+		"address",
+		"area",
+		"",
+		owner,
+		NewDocumentTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		30,
+	)
+}
+
+func (t *testRentLandProcessor) newRentLand(
+	sender testAccount, documentid string, rent int64, privs ...key.Privatekey,
+) RentLand {
+	items := []RentLandItem{
+		NewRentLandItemImpl(documentid, sender.Address(), "renter", base.Height(10), currency.NewBig(rent), t.cid),
+	}
+	fact := NewRentLandFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewRentLand(fact, t.newFactSigns(fact, privs...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRentLandProcessor) newRenewLease(
+	sender testAccount, documentid string, rent int64, privs ...key.Privatekey,
+) RenewLease {
+	items := []RenewLeaseItem{NewRenewLeaseItemImpl(documentid, base.Height(10), currency.NewBig(rent), t.cid)}
+	fact := NewRenewLeaseFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewRenewLease(fact, t.newFactSigns(fact, privs...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRentLandProcessor) TestRentByRenter() {
+	owner, sts0 := t.newAccount()
+	renter, sts1 := t.newAccount()
+
+	doc := t.newBCLandData(owner.Address())
+
+	pool, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newRentLand(renter, doc.DocumentId(), 10, renter.priv, owner.priv))
+	t.NoError(errs[0])
+
+	leased := t.updatedDocument(pool, doc.DocumentId()).(BCLandData)
+	t.True(leased.Account().Equal(renter.Address()))
+	t.Equal("renter", leased.Renter())
+	t.Equal(pool.Height()+base.Height(10), leased.LeaseEnd())
+
+	// the renter pays the rent to the owner
+	t.Equal(currency.NewBig(990), t.updatedBalance(pool, renter.Address()))
+	t.Equal(currency.NewBig(1010), t.updatedBalance(pool, owner.Address()))
+}
+
+func (t *testRentLandProcessor) TestRentNotSignedByOwner() {
+	owner, sts0 := t.newAccount()
+	renter, sts1 := t.newAccount()
+
+	doc := t.newBCLandData(owner.Address())
+
+	_, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newRentLand(renter, doc.DocumentId(), 10, renter.priv))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "not passed threshold")
+}
+
+func (t *testRentLandProcessor) TestRenterShouldBeSender() {
+	owner, _ := t.newAccount()
+	renter, _ := t.newAccount()
+
+	items := []RentLandItem{
+		NewRentLandItemImpl("1cli", renter.Address(), "renter", base.Height(10), currency.NewBig(10), t.cid),
+	}
+	fact := NewRentLandFact(util.UUID().Bytes(), owner.Address(), items)
+
+	op, err := NewRentLand(fact, t.newFactSigns(fact, owner.priv, renter.priv), "")
+	t.NoError(err)
+
+	err = op.IsValid(t.networkID)
+	t.Error(err)
+	t.Contains(err.Error(), "renter should be sender")
+}
+
+func (t *testRentLandProcessor) TestRentOwnLand() {
+	owner, sts0 := t.newAccount()
+
+	doc := t.newBCLandData(owner.Address())
+
+	_, opr := t.statepool(sts0, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newRentLand(owner, doc.DocumentId(), 10, owner.priv))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "owner can not rent own land")
+}
+
+func (t *testRentLandProcessor) TestRenewLease() {
+	owner, sts0 := t.newAccount()
+	renter, sts1 := t.newAccount()
+
+	doc := t.newBCLandData(owner.Address())
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	errs := t.process(opr, t.newRentLand(renter, doc.DocumentId(), 10, renter.priv, owner.priv))
+	t.NoError(errs[0])
+
+	leaseEnd := t.updatedDocument(pool, doc.DocumentId()).(BCLandData).LeaseEnd()
+
+	// only the renter renews the lease
+	_, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newRenewLease(owner, doc.DocumentId(), 10, owner.priv))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "sender is not renter of land")
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newRenewLease(renter, doc.DocumentId(), 10, renter.priv, owner.priv))
+	t.NoError(errs[0])
+
+	renewed := t.updatedDocument(pool, doc.DocumentId()).(BCLandData)
+	t.True(renewed.Account().Equal(renter.Address()))
+	t.Equal(leaseEnd+base.Height(10), renewed.LeaseEnd())
+}
+
+func (t *testRentLandProcessor) newEndLease(sender testAccount, documentid string) EndLease {
+	items := []EndLeaseItem{NewEndLeaseItemImpl(documentid, t.cid)}
+	fact := NewEndLeaseFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewEndLease(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRentLandProcessor) TestEndLease() {
+	owner, sts0 := t.newAccount()
+	renter, sts1 := t.newAccount()
+
+	doc := t.newBCLandData(owner.Address())
+
+	pool, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newRentLand(renter, doc.DocumentId(), 10, renter.priv, owner.priv))
+	t.NoError(errs[0])
+
+	leased := t.updates(pool)
+
+	// the owner ends the lease only after it expired
+	_, opr = t.statepoolAt(base.Height(9), sts0, sts1, leased)
+
+	errs = t.process(opr, t.newEndLease(owner, doc.DocumentId()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "lease not expired until 10")
+
+	pool, opr = t.statepoolAt(base.Height(10), sts0, sts1, leased)
+
+	errs = t.process(opr, t.newEndLease(owner, doc.DocumentId()))
+	t.NoError(errs[0])
+
+	ended := t.updatedDocument(pool, doc.DocumentId()).(BCLandData)
+	t.True(ended.Account().Equal(owner.Address()))
+	t.Equal("", ended.Renter())
+	t.Equal(base.Height(0), ended.LeaseEnd())
+
+	// the renter ends the lease at any time
+	pool, opr = t.statepoolAt(base.Height(5), sts0, sts1, leased)
+
+	errs = t.process(opr, t.newEndLease(renter, doc.DocumentId()))
+	t.NoError(errs[0])
+	t.True(t.updatedDocument(pool, doc.DocumentId()).(BCLandData).Account().Equal(owner.Address()))
+}
+
+func TestRentLandProcessor(t *testing.T) {
+	suite.Run(t, new(testRentLandProcessor))
+}
@@ -0,0 +1,167 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RevealVoteFactType   = hint.Type("mitum-reveal-vote-operation-fact")
+	RevealVoteFactHint   = hint.NewHint(RevealVoteFactType, "v0.0.1")
+	RevealVoteFactHinter = RevealVoteFact{BaseHinter: hint.NewBaseHinter(RevealVoteFactHint)}
+	RevealVoteType       = hint.Type("mitum-reveal-vote-operation")
+	RevealVoteHint       = hint.NewHint(RevealVoteType, "v0.0.1")
+	RevealVoteHinter     = RevealVote{BaseOperation: operationHinter(RevealVoteHint)}
+)
+
+var MaxRevealVoteItems uint = 10
+
+type RevealVoteItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Candidate() base.Address
+	Salt() string
+	Currency() currency.CurrencyID
+	Rebuild() RevealVoteItem
+}
+
+type RevealVoteFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []RevealVoteItem
+}
+
+func NewRevealVoteFact(token []byte, sender base.Address, items []RevealVoteItem) RevealVoteFact {
+	fact := RevealVoteFact{
+		BaseHinter: hint.NewBaseHinter(RevealVoteFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RevealVoteFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RevealVoteFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevealVoteFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact RevealVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for RevealVoteFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxRevealVoteItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxRevealVoteItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RevealVoteFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RevealVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevealVoteFact) Items() []RevealVoteItem {
+	return fact.items
+}
+
+func (fact RevealVoteFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	// NOTE candidates can find the votes in their history
+	for i := range fact.items {
+		as = append(as, fact.items[i].Candidate())
+	}
+
+	return as, nil
+}
+
+func (fact RevealVoteFact) Rebuild() RevealVoteFact {
+	items := make([]RevealVoteItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type RevealVote struct {
+	currency.BaseOperation
+}
+
+func NewRevealVote(fact RevealVoteFact, fs []base.FactSign, memo string) (RevealVote, error) {
+	bo, err := currency.NewBaseOperationFromFact(RevealVoteHint, fact, fs, memo)
+	if err != nil {
+		return RevealVote{}, err
+	} else {
+
+		return RevealVote{BaseOperation: bo}, nil
+	}
+}
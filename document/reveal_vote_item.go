@@ -0,0 +1,90 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	RevealVoteItemImplType   = hint.Type("mitum-reveal-vote-item")
+	RevealVoteItemImplHint   = hint.NewHint(RevealVoteItemImplType, "v0.0.1")
+	RevealVoteItemImplHinter = RevealVoteItemImpl{BaseHinter: hint.NewBaseHinter(RevealVoteItemImplHint)}
+)
+
+// RevealVoteItemImpl reveals the committed vote for the candidate of the
+// blockcity voting document with the salt of commitment.
+type RevealVoteItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	candidate  base.Address
+	salt       string
+	cid        currency.CurrencyID
+}
+
+func NewRevealVoteItemImpl(
+	documentid string,
+	candidate base.Address,
+	salt string,
+	cid currency.CurrencyID) RevealVoteItemImpl {
+
+	return RevealVoteItemImpl{
+		BaseHinter: hint.NewBaseHinter(RevealVoteItemImplHint),
+		documentid: documentid,
+		candidate:  candidate,
+		salt:       salt,
+		cid:        cid,
+	}
+}
+
+func (it RevealVoteItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.candidate.Bytes(),
+		[]byte(it.salt),
+		it.cid.Bytes(),
+	)
+}
+
+func (it RevealVoteItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.candidate,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid RevealVoteItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid RevealVoteItem: empty documentid")
+	}
+
+	if len(it.salt) < 1 {
+		return isvalid.InvalidError.Errorf("invalid RevealVoteItem: empty salt")
+	}
+
+	return nil
+}
+
+func (it RevealVoteItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it RevealVoteItemImpl) Candidate() base.Address {
+	return it.candidate
+}
+
+func (it RevealVoteItemImpl) Salt() string {
+	return it.salt
+}
+
+func (it RevealVoteItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it RevealVoteItemImpl) Rebuild() RevealVoteItem {
+	return it
+}
@@ -0,0 +1,35 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it RevealVoteItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"candidate":  it.candidate,
+				"salt":       it.salt,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type RevealVoteItemImplBSONUnpacker struct {
+	DI string              `bson:"documentid"`
+	CD base.AddressDecoder `bson:"candidate"`
+	SL string              `bson:"salt"`
+	CI string              `bson:"currency"`
+}
+
+func (it *RevealVoteItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ucv RevealVoteItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.CD, ucv.SL, ucv.CI)
+}
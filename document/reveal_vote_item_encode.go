@@ -0,0 +1,27 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *RevealVoteItemImpl) unpack(
+	enc encoder.Encoder,
+	di string,
+	bcd base.AddressDecoder,
+	sl string,
+	cid string,
+) error {
+	a, err := bcd.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	it.documentid = di
+	it.candidate = a
+	it.salt = sl
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
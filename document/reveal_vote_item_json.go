@@ -0,0 +1,41 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type RevealVoteItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	CD base.Address        `json:"candidate"`
+	SL string              `json:"salt"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it RevealVoteItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RevealVoteItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		CD:         it.candidate,
+		SL:         it.salt,
+		CI:         it.cid,
+	})
+}
+
+type RevealVoteItemImplJSONUnpacker struct {
+	DI string              `json:"documentid"`
+	CD base.AddressDecoder `json:"candidate"`
+	SL string              `json:"salt"`
+	CI string              `json:"currency"`
+}
+
+func (it *RevealVoteItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ucv RevealVoteItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ucv); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, ucv.DI, ucv.CD, ucv.SL, ucv.CI)
+}
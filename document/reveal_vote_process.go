@@ -0,0 +1,205 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var RevealVoteProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RevealVoteProcessor)
+	},
+}
+
+func (op RevealVote) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RevealVoteProcessor struct {
+	cp *currency.CurrencyPool
+	RevealVote
+	height   base.Height                                  // height of block in processing
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+	nsts     []state.State                                // voting document and voting box states with vote
+}
+
+func NewRevealVoteProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RevealVote)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not RevealVote, %T", op)
+		}
+
+		opp := RevealVoteProcessorPool.Get().(*RevealVoteProcessor)
+
+		opp.cp = cp
+		opp.RevealVote = i
+		opp.height = base.NilHeight
+		opp.sb = nil
+		opp.required = nil
+		opp.nsts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *RevealVoteProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RevealVoteProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RevealVoteFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	sts, err := opp.revealVote(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	opp.nsts = sts
+
+	return opp, nil
+}
+
+// pooledStates returns the voting document and voting box states with the
+// revealed votes; the next reveals in the same proposal are counted over them.
+func (opp *RevealVoteProcessor) pooledStates() []state.State {
+	return opp.nsts
+}
+
+// Process sets the states with the votes revealed in PreProcess; the reveals on
+// the same voting document in the same proposal are merged into the pooled
+// document, see OperationProcessor.setState.
+func (opp *RevealVoteProcessor) Process( // nolint:dupl
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RevealVoteFact)
+
+	sts := make([]state.State, len(opp.nsts), len(opp.nsts)+len(opp.required))
+	copy(sts, opp.nsts)
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// revealVote returns the voting document states and the voting box states,
+// which the revealed votes of sender are counted in. The revealed vote should
+// match with the commitment of sender; the commitments not revealed until the
+// reveal deadline are not counted.
+func (opp *RevealVoteProcessor) revealVote(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(RevealVoteFact)
+
+	var sts []state.State
+	for i := range fact.items {
+		it := fact.items[i]
+
+		dst, doc, err := loadVotingDocument(it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		if phase := doc.Phase(opp.height); phase != VotingPhaseReveal {
+			return nil, operation.NewBaseReasonError("reveals not accepted in %s phase, %q", phase, it.DocumentId())
+		}
+
+		if _, found := doc.Candidate(it.Candidate()); !found {
+			return nil, operation.NewBaseReasonError("candidate not found in document, %q", it.Candidate())
+		}
+
+		bst, box, err := loadVotingBox(doc, getState)
+		if err != nil {
+			return nil, err
+		}
+
+		vc, found := box.Commit(fact.sender)
+		switch {
+		case !found:
+			return nil, operation.NewBaseReasonError("sender not committed, %v; %q", fact.sender, it.DocumentId())
+		case box.HasVoted(fact.sender):
+			return nil, operation.NewBaseReasonError("sender already revealed, %v; %q", fact.sender, it.DocumentId())
+		case !vc.Commitment().Equal(NewVoteCommitment(it.DocumentId(), fact.sender, it.Candidate(), it.Salt())):
+			return nil, operation.NewBaseReasonError("revealed vote not matched with commitment, %v; %q", fact.sender, it.DocumentId())
+		}
+
+		ndoc, err := doc.addVote(it.Candidate(), vc.Weight())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		nst, err := SetStateDocumentDataValue(dst, ndoc)
+		if err != nil {
+			return nil, err
+		}
+
+		nbst, err := SetStateVotingBoxValue(bst, box.Vote(fact.sender))
+		if err != nil {
+			return nil, err
+		}
+
+		sts = append(sts, nst, nbst)
+	}
+
+	return sts, nil
+}
+
+func (opp *RevealVoteProcessor) Close() error {
+	opp.cp = nil
+	opp.RevealVote = RevealVote{}
+	opp.height = base.NilHeight
+	opp.sb = nil
+	opp.required = nil
+	opp.nsts = nil
+
+	RevealVoteProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *RevealVoteProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(RevealVoteFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
@@ -0,0 +1,90 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRevealVoteProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testRevealVoteProcessor) newRevealVote(
+	sender testAccount, documentid string, candidate base.Address, salt string,
+) RevealVote {
+	items := []RevealVoteItem{NewRevealVoteItemImpl(documentid, candidate, salt, t.cid)}
+	fact := NewRevealVoteFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewRevealVote(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRevealVoteProcessor) TestRevealsInSameBlock() {
+	owner, sts0 := t.newAccount()
+	voter0, sts1 := t.newAccount()
+	voter1, sts2 := t.newAccount()
+	candidate, sts3 := t.newAccount()
+
+	doc := t.newSecretBCVotingData(owner.Address(), candidate.Address())
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	// commits in the different blocks before the deadline
+	pool, opr := t.statepool(sts0, sts1, sts2, sts3, dsts)
+	t.NoError(t.process(opr, t.newCommitVote(voter0, doc.DocumentId(), candidate.Address(), "salt0"))[0])
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, sts2, sts3, dsts)
+	t.NoError(t.process(opr, t.newCommitVote(voter1, doc.DocumentId(), candidate.Address(), "salt1"))[0])
+
+	// reveals after the deadline
+	sts4 := t.updates(pool)
+	pool, opr = t.statepoolAt(base.Height(34), sts0, sts1, sts2, sts3, dsts, sts4)
+
+	// all the reveals in the block are counted
+	errs := t.process(opr,
+		t.newRevealVote(voter0, doc.DocumentId(), candidate.Address(), "salt0"),
+		t.newRevealVote(voter1, doc.DocumentId(), candidate.Address(), "salt1"),
+	)
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+
+	revealed := t.updatedDocument(pool, doc.DocumentId()).(BCVotingData)
+	vc, found := revealed.Candidate(candidate.Address())
+	t.True(found)
+	t.Equal(uint(2), vc.Count())
+
+	st, found := t.updated(pool, StateKeyVotingBox(doc.DocumentId()))
+	t.True(found)
+
+	box, err := StateVotingBoxValue(st)
+	t.NoError(err)
+	t.True(box.HasVoted(voter0.Address()))
+	t.True(box.HasVoted(voter1.Address()))
+}
+
+func (t *testRevealVoteProcessor) TestRevealNotMatched() {
+	owner, sts0 := t.newAccount()
+	voter, sts1 := t.newAccount()
+	candidate, sts2 := t.newAccount()
+
+	doc := t.newSecretBCVotingData(owner.Address(), candidate.Address())
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	pool, opr := t.statepool(sts0, sts1, sts2, dsts)
+	t.NoError(t.process(opr, t.newCommitVote(voter, doc.DocumentId(), candidate.Address(), "salt"))[0])
+
+	_, opr = t.statepoolAt(base.Height(34), sts0, sts1, sts2, dsts, t.updates(pool))
+
+	errs := t.process(opr, t.newRevealVote(voter, doc.DocumentId(), candidate.Address(), "showme"))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "revealed vote not matched with commitment")
+}
+
+func TestRevealVoteProcessor(t *testing.T) {
+	suite.Run(t, new(testRevealVoteProcessor))
+}
@@ -0,0 +1,115 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RevokePermissionFactType   = hint.Type("mitum-revoke-permission-operation-fact")
+	RevokePermissionFactHint   = hint.NewHint(RevokePermissionFactType, "v0.0.1")
+	RevokePermissionFactHinter = RevokePermissionFact{BaseHinter: hint.NewBaseHinter(RevokePermissionFactHint)}
+	RevokePermissionType       = hint.Type("mitum-revoke-permission-operation")
+	RevokePermissionHint       = hint.NewHint(RevokePermissionType, "v0.0.1")
+	RevokePermissionHinter     = RevokePermission{BaseOperation: operationHinter(RevokePermissionHint)}
+)
+
+// RevokePermissionFact removes the permission from the extended account of
+// target; like currency.CurrencyPolicyUpdater, it should be signed by the
+// suffrage nodes.
+type RevokePermissionFact struct {
+	hint.BaseHinter
+	h          valuehash.Hash
+	token      []byte
+	target     base.Address
+	permission extension.AccountPermission
+}
+
+func NewRevokePermissionFact(
+	token []byte,
+	target base.Address,
+	permission extension.AccountPermission,
+) RevokePermissionFact {
+	fact := RevokePermissionFact{
+		BaseHinter: hint.NewBaseHinter(RevokePermissionFactHint),
+		token:      token,
+		target:     target,
+		permission: permission,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RevokePermissionFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RevokePermissionFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokePermissionFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.permission.Bytes(),
+	)
+}
+
+func (fact RevokePermissionFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for RevokePermissionFact")
+	}
+
+	if err := isvalid.Check(nil, false, fact.target, fact.permission); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact RevokePermissionFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RevokePermissionFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact RevokePermissionFact) Permission() extension.AccountPermission {
+	return fact.permission
+}
+
+type RevokePermission struct {
+	currency.BaseOperation
+}
+
+func NewRevokePermission(
+	fact RevokePermissionFact, fs []base.FactSign, memo string,
+) (RevokePermission, error) {
+	bo, err := currency.NewBaseOperationFromFact(RevokePermissionHint, fact, fs, memo)
+	if err != nil {
+		return RevokePermission{}, err
+	}
+
+	return RevokePermission{BaseOperation: bo}, nil
+}
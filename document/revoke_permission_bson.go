@@ -0,0 +1,48 @@
+package document // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact RevokePermissionFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":       fact.h,
+				"token":      fact.token,
+				"target":     fact.target,
+				"permission": fact.permission,
+			}))
+}
+
+type RevokePermissionFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	TG base.AddressDecoder `bson:"target"`
+	PM uint                `bson:"permission"`
+}
+
+func (fact *RevokePermissionFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var urp RevokePermissionFactBSONUnpacker
+	if err := bson.Unmarshal(b, &urp); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, urp.H, urp.TK, urp.TG, urp.PM)
+}
+
+func (op *RevokePermission) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
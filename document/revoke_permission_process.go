@@ -0,0 +1,98 @@
+package document
+
+import (
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (op RevokePermission) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RevokePermissionProcessor struct {
+	RevokePermission
+	pubs      []key.Publickey
+	threshold base.Threshold
+}
+
+func NewRevokePermissionProcessor(
+	pubs []key.Publickey,
+	threshold base.Threshold,
+) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RevokePermission)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not RevokePermission, %T", op)
+		}
+
+		return &RevokePermissionProcessor{
+			RevokePermission: i,
+			pubs:             pubs,
+			threshold:        threshold,
+		}, nil
+	}
+}
+
+func (opp *RevokePermissionProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, operation.NewBaseReasonError("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	if _, err := opp.revoke(getState); err != nil {
+		return nil, err
+	}
+
+	return opp, nil
+}
+
+// Process revokes the permission from the latest extended account state.
+func (opp *RevokePermissionProcessor) Process(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	st, err := opp.revoke(getState)
+	if err != nil {
+		return err
+	}
+
+	return setState(opp.Fact().Hash(), st)
+}
+
+func (opp *RevokePermissionProcessor) revoke(
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	fact := opp.Fact().(RevokePermissionFact)
+
+	st, ac, err := extendedAccountState(fact.target, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	nac, err := ac.RemovePermission(fact.permission)
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return extension.SetStateAccountValue(st, nac)
+}
+
+func (opp *RevokePermissionProcessor) Close() error {
+	opp.RevokePermission = RevokePermission{}
+	opp.pubs = nil
+	opp.threshold = base.Threshold{}
+
+	return nil
+}
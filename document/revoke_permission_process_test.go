@@ -0,0 +1,78 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testRevokePermissionProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testRevokePermissionProcessor) newRevokePermission(
+	target base.Address, permission extension.AccountPermission, privs ...key.Privatekey,
+) RevokePermission {
+	if len(privs) < 1 {
+		privs = []key.Privatekey{t.suffrage}
+	}
+
+	fact := NewRevokePermissionFact(util.UUID().Bytes(), target, permission)
+
+	op, err := NewRevokePermission(fact, t.newFactSigns(fact, privs...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testRevokePermissionProcessor) TestRevoke() {
+	admin, sts0 := t.newAdmin()
+
+	pool, opr := t.statepool(sts0)
+
+	errs := t.process(opr, t.newRevokePermission(admin.Address(), extension.BlockcityAdmin))
+	t.NoError(errs[0])
+
+	st, found := t.updated(pool, extension.StateKeyAccount(admin.Address()))
+	t.True(found)
+
+	ac, err := extension.StateAccountValue(st)
+	t.NoError(err)
+	t.Empty(ac.Permissions())
+
+	// the permission already revoked
+	_, opr = t.nextStatepool(pool, sts0)
+
+	errs = t.process(opr, t.newRevokePermission(admin.Address(), extension.BlockcityAdmin))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), `permission not granted, "blockcityAdmin"`)
+}
+
+func (t *testRevokePermissionProcessor) TestRevokeNotGranted() {
+	target, sts0 := t.newAccount()
+
+	_, opr := t.statepool(sts0)
+
+	errs := t.process(opr, t.newRevokePermission(target.Address(), extension.BlockcityAdmin))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), `permission not granted, "blockcityAdmin"`)
+}
+
+func (t *testRevokePermissionProcessor) TestRevokeNotBySuffrage() {
+	admin, sts0 := t.newAdmin()
+
+	_, opr := t.statepool(sts0)
+
+	errs := t.process(opr, t.newRevokePermission(admin.Address(), extension.BlockcityAdmin, admin.Privs()...))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "invalid signing")
+}
+
+func TestRevokePermissionProcessor(t *testing.T) {
+	suite.Run(t, new(testRevokePermissionProcessor))
+}
@@ -28,6 +28,7 @@ type SignDocumentItem interface {
 	DocumentId() string
 	Owner() base.Address
 	Currency() currency.CurrencyID
+	Status() DocSignStatus
 	Rebuild() SignDocumentItem
 }
 
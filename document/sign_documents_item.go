@@ -59,6 +59,11 @@ func (it BaseSignDocumentsItem) Currency() currency.CurrencyID {
 	return it.cid
 }
 
+// Status returns signed; BaseSignDocumentsItem always signs the document.
+func (it BaseSignDocumentsItem) Status() DocSignStatus {
+	return DocSignStatusSigned
+}
+
 func (it BaseSignDocumentsItem) Rebuild() SignDocumentItem {
 	return it
 }
@@ -32,6 +32,7 @@ func (op SignDocuments) Process(
 type SignDocumentsItemProcessor struct {
 	cp     *currency.CurrencyPool
 	h      valuehash.Hash
+	height base.Height // height of block in processing
 	sender base.Address
 	item   SignDocumentItem
 	nds    state.State       // new document data state (key = document filehash)
@@ -83,67 +84,113 @@ func (opp *SignDocumentsItemProcessor) PreProcess(
 	case !found:
 		return operation.NewBaseReasonError("document not registered with documentid, %q", opp.item.DocumentId())
 	default:
-		opp.nds = st
+		doc, err := opp.signDocument(st)
+		if err != nil {
+			return err
+		}
+
+		nst, err := SetStateDocumentDataValue(st, doc)
+		if err != nil {
+			return err
+		}
+
+		opp.nds = nst
 	}
 
-	dd, err := StateDocumentDataValue(opp.nds)
+	return nil
+}
+
+// Process returns the document data state signed in PreProcess; the signatures
+// on the same document in the same proposal are merged into the pooled
+// document, see OperationProcessor.setState.
+func (opp *SignDocumentsItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+
+	sts := make([]state.State, 1)
+	sts[0] = opp.nds
+
+	return sts, nil
+}
+
+// signDocument returns new BSDocData from the document state, which DocSign
+// status of sender is changed by item.
+func (opp *SignDocumentsItemProcessor) signDocument(st state.State) (BSDocData, error) {
+	dd, err := StateDocumentDataValue(st)
 	if err != nil {
-		return err
+		return BSDocData{}, err
+	}
+
+	if IsArchivedDocumentData(dd) {
+		return BSDocData{}, operation.NewBaseReasonError("document already removed, %q", opp.item.DocumentId())
 	}
 
 	v, ok := dd.(BSDocData)
 	if !ok {
-		return operation.NewBaseReasonError("Document is not Blocksign Document, %v", opp.item.DocumentId())
+		return BSDocData{}, operation.NewBaseReasonError("Document is not Blocksign Document, %v", opp.item.DocumentId())
 	}
-	if !v.Creator().Address().Equal(opp.item.Owner()) {
-		return operation.NewBaseReasonError("Owner not matched with creator in document, %v", opp.item.Owner())
+	// NOTE the document can be transferred, so the owner, not the creator is
+	// checked.
+	if !v.Owner().Equal(opp.item.Owner()) {
+		return BSDocData{}, operation.NewBaseReasonError("Owner not matched with owner in document, %v", opp.item.Owner())
 	}
 
-	if len(v.Signers()) < 1 {
-		return operation.NewBaseReasonError("sender not found in document Signers, %v", opp.sender)
+	if v.IsExpired(opp.height) {
+		return BSDocData{}, operation.NewBaseReasonError(
+			"document signing deadline passed, %q; deadline=%v height=%v", opp.item.DocumentId(), v.Deadline(), opp.height)
 	}
+
+	// NOTE copy signers; the signers slice is shared with the value of the
+	// given state.
+	signers := make([]DocSign, len(v.Signers()))
+	copy(signers, v.Signers())
+
 	// check signer exist in document data signers
-	for i := range v.Signers() {
-		if v.Signers()[i].Address().Equal(opp.sender) {
-			v.Signers()[i].SetSigned()
+	idx := -1
+	for i := range signers {
+		if signers[i].Address().Equal(opp.sender) {
+			idx = i
+
 			break
 		}
-		if i == (len(v.Signers()) - 1) {
-			return operation.NewBaseReasonError("sender not found in document Signers, %v", opp.sender)
-		}
 	}
 
-	// update document data state
-	st, err := SetStateDocumentDataValue(opp.nds, dd)
-	if err != nil {
-		return err
+	if idx < 0 {
+		return BSDocData{}, operation.NewBaseReasonError("sender not found in document Signers, %v", opp.sender)
 	}
-	opp.nds = st
 
-	return nil
-}
+	// signature can be revoked only before document is completed
+	if opp.item.Status() == DocSignStatusRevoked && isCompletedSigners(signers) {
+		return BSDocData{}, operation.NewBaseReasonError("document already completed, %q", opp.item.DocumentId())
+	}
 
-func (opp *SignDocumentsItemProcessor) Process(
-	_ func(key string) (state.State, bool, error),
-	_ func(valuehash.Hash, ...state.State) error,
-) ([]state.State, error) {
+	if v.IsSequential() {
+		if err := checkSequentialSigning(signers, idx, opp.item.Status()); err != nil {
+			return BSDocData{}, err
+		}
+	}
 
-	sts := make([]state.State, 1)
-	sts[0] = opp.nds
+	if err := signers[idx].SetStatus(opp.item.Status()); err != nil {
+		return BSDocData{}, operation.NewBaseReasonErrorFromError(err)
+	}
 
-	return sts, nil
+	v.signers = signers
+
+	return v, nil
 }
 
 func (opp *SignDocumentsItemProcessor) Close() error {
 	opp.cp = nil
 	opp.h = nil
+	opp.height = base.NilHeight
 	opp.sender = nil
 	opp.item = nil
 	opp.nds = nil
 	opp.dinv = DocumentInventory{}
 	opp.ndinvs = nil
 
-	CreateDocumentsItemProcessorPool.Put(opp)
+	SignDocumentsItemProcessorPool.Put(opp)
 
 	return nil
 }
@@ -151,6 +198,7 @@ func (opp *SignDocumentsItemProcessor) Close() error {
 type SignDocumentsProcessor struct {
 	cp *currency.CurrencyPool
 	SignDocuments
+	height   base.Height                                  // height of block in processing
 	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
 	ns       []*SignDocumentsItemProcessor                // ItemProcessor
 	required map[currency.CurrencyID][2]currency.Big      // Fee
@@ -169,6 +217,10 @@ func NewSignDocumentsProcessor(cp *currency.CurrencyPool) currency.GetNewProcess
 	}
 }
 
+func (opp *SignDocumentsProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *SignDocumentsProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
@@ -192,7 +244,7 @@ func (opp *SignDocumentsProcessor) PreProcess(
 	ns := make([]*SignDocumentsItemProcessor, len(fact.items))
 	for i := range fact.items {
 
-		c := &SignDocumentsItemProcessor{cp: opp.cp, sender: fact.sender, h: opp.Hash(), item: fact.items[i]}
+		c := &SignDocumentsItemProcessor{cp: opp.cp, sender: fact.sender, h: opp.Hash(), height: opp.height, item: fact.items[i]}
 		if err := c.PreProcess(getState, setState); err != nil {
 			return nil, operation.NewBaseReasonErrorFromError(err)
 		}
@@ -210,6 +262,17 @@ func (opp *SignDocumentsProcessor) PreProcess(
 	return opp, nil
 }
 
+// pooledStates returns the signed document states; the next SignDocuments in
+// the same proposal signs over them.
+func (opp *SignDocumentsProcessor) pooledStates() []state.State {
+	sts := make([]state.State, len(opp.ns))
+	for i := range opp.ns {
+		sts[i] = opp.ns[i].nds
+	}
+
+	return sts
+}
+
 func (opp *SignDocumentsProcessor) Process( // nolint:dupl
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
@@ -244,48 +307,51 @@ func (opp *SignDocumentsProcessor) Close() error {
 	opp.sb = nil
 	opp.required = nil
 
-	CreateDocumentsProcessorPool.Put(opp)
+	SignDocumentsProcessorPool.Put(opp)
 
 	return nil
 }
 
-func (opp *SignDocumentsProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
-	fact := opp.Fact().(SignDocumentsFact)
-	items := make([]SignDocumentItem, len(fact.items))
-	for i := range fact.items {
-		items[i] = fact.items[i]
+// checkSequentialSigning checks the order of signers; signer can sign after
+// all the previous signers signed, and can revoke before the next signers
+// sign.
+func checkSequentialSigning(signers []DocSign, idx int, status DocSignStatus) error {
+	switch status {
+	case DocSignStatusSigned:
+		for i := 0; i < idx; i++ {
+			if !signers[i].Signed() {
+				return operation.NewBaseReasonError(
+					"sequential signing; previous signer, %v has not signed yet", signers[i].Address())
+			}
+		}
+	case DocSignStatusRevoked:
+		for i := idx + 1; i < len(signers); i++ {
+			if signers[i].Signed() {
+				return operation.NewBaseReasonError(
+					"sequential signing; next signer, %v already signed", signers[i].Address())
+			}
+		}
 	}
 
-	required := map[currency.CurrencyID][2]currency.Big{}
-
-	for i := range items {
-		it := items[i]
-
-		rq := [2]currency.Big{currency.ZeroBig, currency.ZeroBig}
-
-		if k, found := required[it.Currency()]; found {
-			rq = k
-		}
-		if opp.cp == nil {
-			required[it.Currency()] = rq
+	return nil
+}
 
-			continue
+func isCompletedSigners(signers []DocSign) bool {
+	for i := range signers {
+		if !signers[i].Signed() {
+			return false
 		}
+	}
 
-		feeer, found := opp.cp.Feeer(it.Currency())
-		if !found {
-			return nil, operation.NewBaseReasonError("unknown currency id found, %q", it.Currency())
-		}
-		switch k, err := feeer.Fee(currency.ZeroBig); {
-		case err != nil:
-			return nil, err
-		case !k.OverZero():
-			required[it.Currency()] = rq
-		default:
-			required[it.Currency()] = [2]currency.Big{rq[0].Add(k), rq[1].Add(k)}
-		}
+	return true
+}
 
+func (opp *SignDocumentsProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(SignDocumentsFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
 	}
 
-	return required, nil
+	return CalculateCurrencyItemsFee(opp.cp, items)
 }
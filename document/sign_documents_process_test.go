@@ -0,0 +1,279 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testSignDocumentsProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testSignDocumentsProcessor) newAmendSigners(
+	sender testAccount, documentid string, add []DocSign, remove []base.Address,
+) AmendSigners {
+	items := []AmendSignersItem{NewAmendSignersItemImpl(documentid, add, remove, t.cid)}
+	fact := NewAmendSignersFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewAmendSigners(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testSignDocumentsProcessor) newSignDocumentsStatus(
+	sender testAccount, owner base.Address, documentid string, status DocSignStatus,
+) SignDocuments {
+	items := []SignDocumentItem{NewSignDocumentsItemSingleFileStatus(documentid, owner, status, t.cid)}
+	fact := NewSignDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewSignDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testSignDocumentsProcessor) newSigners(n int) ([]testAccount, []base.Address, []state.State) {
+	acs := make([]testAccount, n)
+	as := make([]base.Address, n)
+
+	var sts []state.State
+	for i := range acs {
+		ac, st := t.newAccount()
+		acs[i] = ac
+		as[i] = ac.Address()
+		sts = append(sts, st...)
+	}
+
+	return acs, as, sts
+}
+
+func (t *testSignDocumentsProcessor) TestSignersInSameBlock() {
+	owner, sts0 := t.newAccount()
+	signers, as, sts1 := t.newSigners(3)
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", as...).WithSigningPolicy(SigningPolicySequential)
+
+	pool, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	ops := make([]state.Processor, len(signers))
+	for i := range signers {
+		ops[i] = t.newSignDocuments(signers[i], owner.Address(), doc.DocumentId())
+	}
+
+	// all the signatures in the block are kept; the next signer signs over the
+	// signature of the previous signer in sequential signing, and the processed
+	// order does not matter.
+	pprs := make([]state.Processor, len(ops))
+	for i := range ops {
+		ppr, err := opr.PreProcess(ops[i])
+		t.NoError(err)
+
+		pprs[i] = ppr
+	}
+
+	for i := len(pprs) - 1; i >= 0; i-- {
+		t.NoError(opr.Process(pprs[i]))
+	}
+
+	signed := t.updatedDocument(pool, doc.DocumentId()).(BSDocData)
+	t.Equal(len(signers), len(signed.Signers()))
+	for i := range signed.Signers() {
+		t.True(signed.Signers()[i].Address().Equal(signers[i].Address()))
+		t.True(signed.Signers()[i].Signed(), "signer, %q not signed", signed.Signers()[i].Address())
+	}
+}
+
+func (t *testSignDocumentsProcessor) TestSignersInSameBlockOutOfOrder() {
+	owner, sts0 := t.newAccount()
+	signers, as, sts1 := t.newSigners(2)
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", as...).WithSigningPolicy(SigningPolicySequential)
+
+	pool, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	// the second signer can not sign before the first in the same block
+	errs := t.process(opr,
+		t.newSignDocuments(signers[1], owner.Address(), doc.DocumentId()),
+		t.newSignDocuments(signers[0], owner.Address(), doc.DocumentId()),
+	)
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "has not signed yet")
+	t.NoError(errs[1])
+
+	signed := t.updatedDocument(pool, doc.DocumentId()).(BSDocData)
+	t.True(signed.Signers()[0].Signed())
+	t.False(signed.Signers()[1].Signed())
+}
+
+func (t *testSignDocumentsProcessor) TestSignAfterDeadline() {
+	owner, sts0 := t.newAccount()
+	signer, sts1 := t.newAccount()
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", signer.Address()).WithDeadline(base.Height(33))
+
+	pool, _ := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	pr, err := NewSignDocumentsProcessor(nil)(t.newSignDocuments(signer, owner.Address(), doc.DocumentId()))
+	t.NoError(err)
+
+	pr.(blockHeightSetter).setBlockHeight(base.Height(34))
+
+	_, err = pr.(state.PreProcessor).PreProcess(pool.Get, pool.Set)
+	t.Error(err)
+	t.Contains(err.Error(), "deadline passed")
+}
+
+func (t *testSignDocumentsProcessor) TestSequentialSigning() {
+	owner, sts0 := t.newAccount()
+	signers, as, sts1 := t.newSigners(2)
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", as...).WithSigningPolicy(SigningPolicySequential)
+
+	_, opr := t.statepool(sts0, sts1, t.newDocumentStates(owner.Address(), doc))
+
+	errs := t.process(opr, t.newSignDocuments(signers[1], owner.Address(), doc.DocumentId()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "has not signed yet")
+}
+
+func (t *testSignDocumentsProcessor) TestDeclineAndRevoke() {
+	owner, sts0 := t.newAccount()
+	signers, as, sts1 := t.newSigners(2)
+	decliner, revoker := signers[0], signers[1]
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", as...)
+
+	dsts := t.newDocumentStates(owner.Address(), doc)
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	errs := t.process(opr,
+		t.newSignDocumentsStatus(decliner, owner.Address(), doc.DocumentId(), DocSignStatusDeclined),
+		t.newSignDocuments(revoker, owner.Address(), doc.DocumentId()),
+	)
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+
+	signed := t.updatedDocument(pool, doc.DocumentId()).(BSDocData)
+	t.Equal(DocSignStatusDeclined, signed.Signers()[0].Status())
+	t.Equal(DocSignStatusSigned, signed.Signers()[1].Status())
+
+	// declined is final; signed can be revoked
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr,
+		t.newSignDocuments(decliner, owner.Address(), doc.DocumentId()),
+		t.newSignDocumentsStatus(revoker, owner.Address(), doc.DocumentId(), DocSignStatusRevoked),
+	)
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), `DocSign status can not be changed from "declined" to "signed"`)
+	t.NoError(errs[1])
+
+	revoked := t.updatedDocument(pool, doc.DocumentId()).(BSDocData)
+	t.Equal(DocSignStatusDeclined, revoked.Signers()[0].Status())
+	t.Equal(DocSignStatusRevoked, revoked.Signers()[1].Status())
+
+	// revoked can be signed again
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newSignDocuments(revoker, owner.Address(), doc.DocumentId()))
+	t.NoError(errs[0])
+
+	t.Equal(DocSignStatusSigned, t.updatedDocument(pool, doc.DocumentId()).(BSDocData).Signers()[1].Status())
+}
+
+func (t *testSignDocumentsProcessor) TestRevokeCompleted() {
+	owner, sts0 := t.newAccount()
+	signer, sts1 := t.newAccount()
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", signer.Address())
+
+	dsts := t.newDocumentStates(owner.Address(), doc)
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	errs := t.process(opr, t.newSignDocuments(signer, owner.Address(), doc.DocumentId()))
+	t.NoError(errs[0])
+
+	// the signature can not be revoked after all the signers signed
+	_, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	errs = t.process(opr, t.newSignDocumentsStatus(signer, owner.Address(), doc.DocumentId(), DocSignStatusRevoked))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "document already completed")
+}
+
+func (t *testSignDocumentsProcessor) TestSequentialRevoke() {
+	owner, sts0 := t.newAccount()
+	signers, as, sts1 := t.newSigners(2)
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", as...).WithSigningPolicy(SigningPolicySequential)
+
+	dsts := t.newDocumentStates(owner.Address(), doc)
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	errs := t.process(opr, t.newSignDocuments(signers[0], owner.Address(), doc.DocumentId()))
+	t.NoError(errs[0])
+
+	_, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+
+	// the first signer can not revoke after the next signer signed
+	errs = t.process(opr,
+		t.newSignDocuments(signers[1], owner.Address(), doc.DocumentId()),
+		t.newSignDocumentsStatus(signers[0], owner.Address(), doc.DocumentId(), DocSignStatusRevoked),
+	)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "next signer")
+	t.Contains(errs[1].Error(), "already signed")
+}
+
+func (t *testSignDocumentsProcessor) TestAmendSigners() {
+	owner, sts0 := t.newAccount()
+	signers, as, sts1 := t.newSigners(3)
+	signer, pending, added := signers[0], signers[1], signers[2]
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", as[:2]...)
+
+	dsts := t.newDocumentStates(owner.Address(), doc)
+	pool, opr := t.statepool(sts0, sts1, dsts)
+
+	amend := t.newAmendSigners(
+		owner, doc.DocumentId(),
+		[]DocSign{NewDocSign(added.Address(), "signcode", false)},
+		[]base.Address{pending.Address()},
+	)
+
+	// signing and amending the same document in the same block; the amend is
+	// rejected instead of dropping the signature.
+	errs := t.process(opr, t.newSignDocuments(signer, owner.Address(), doc.DocumentId()), amend)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document")
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+	errs = t.process(opr, amend)
+	t.NoError(errs[0])
+
+	amended := t.updatedDocument(pool, doc.DocumentId()).(BSDocData)
+	t.Equal(2, len(amended.Signers()))
+	t.True(amended.Signers()[0].Address().Equal(signer.Address()))
+	t.True(amended.Signers()[0].Signed())
+	t.True(amended.Signers()[1].Address().Equal(added.Address()))
+	t.False(amended.Signers()[1].Signed())
+
+	// signed signer can not be removed
+	_, opr = t.nextStatepool(pool, sts0, sts1, dsts)
+	errs = t.process(opr, t.newAmendSigners(owner, doc.DocumentId(), nil, []base.Address{signer.Address()}))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "signed signer can not be removed")
+}
+
+func TestSignDocumentsProcessor(t *testing.T) {
+	suite.Run(t, new(testSignDocumentsProcessor))
+}
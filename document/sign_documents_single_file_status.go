@@ -0,0 +1,64 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+var (
+	SignItemSingleDocumentStatusType   = hint.Type("mitum-blocksign-sign-item-single-document-status")
+	SignItemSingleDocumentStatusHint   = hint.NewHint(SignItemSingleDocumentStatusType, "v0.0.1")
+	SignItemSingleDocumentStatusHinter = SignDocumentsItemSingleFileStatus{
+		BaseSignDocumentsItem: BaseSignDocumentsItem{BaseHinter: hint.NewBaseHinter(SignItemSingleDocumentStatusHint)},
+	}
+)
+
+// SignDocumentsItemSingleFileStatus changes the DocSign status of sender to
+// signed, declined or revoked.
+type SignDocumentsItemSingleFileStatus struct {
+	BaseSignDocumentsItem
+	status DocSignStatus
+}
+
+func NewSignDocumentsItemSingleFileStatus(
+	docId string,
+	owner base.Address,
+	status DocSignStatus,
+	cid currency.CurrencyID,
+) SignDocumentsItemSingleFileStatus {
+	return SignDocumentsItemSingleFileStatus{
+		BaseSignDocumentsItem: NewBaseSignDocumentsItem(SignItemSingleDocumentStatusHint, docId, owner, cid),
+		status:                status,
+	}
+}
+
+func (it SignDocumentsItemSingleFileStatus) Bytes() []byte {
+	return util.ConcatBytesSlice(it.BaseSignDocumentsItem.Bytes(), it.status.Bytes())
+}
+
+func (it SignDocumentsItemSingleFileStatus) IsValid([]byte) error {
+	if err := it.BaseSignDocumentsItem.IsValid(nil); err != nil {
+		return err
+	}
+
+	switch it.status {
+	case DocSignStatusSigned, DocSignStatusDeclined, DocSignStatusRevoked:
+	default:
+		return errors.Errorf("invalid sign status, %q", it.status)
+	}
+
+	return nil
+}
+
+func (it SignDocumentsItemSingleFileStatus) Status() DocSignStatus {
+	return it.status
+}
+
+func (it SignDocumentsItemSingleFileStatus) Rebuild() SignDocumentItem {
+	it.BaseSignDocumentsItem = it.BaseSignDocumentsItem.Rebuild().(BaseSignDocumentsItem)
+
+	return it
+}
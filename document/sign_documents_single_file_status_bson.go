@@ -0,0 +1,35 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it SignDocumentsItemSingleFileStatus) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.id,
+				"owner":      it.owner,
+				"status":     it.status,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type SignDocumentsItemSingleFileStatusBSONUnpacker struct {
+	DI string              `bson:"documentid"`
+	OW base.AddressDecoder `bson:"owner"`
+	ST string              `bson:"status"`
+	CI string              `bson:"currency"`
+}
+
+func (it *SignDocumentsItemSingleFileStatus) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usd SignDocumentsItemSingleFileStatusBSONUnpacker
+	if err := bson.Unmarshal(b, &usd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, usd.DI, usd.OW, usd.ST, usd.CI)
+}
@@ -0,0 +1,22 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *SignDocumentsItemSingleFileStatus) unpack(
+	enc encoder.Encoder,
+	di string,
+	ow base.AddressDecoder,
+	st string,
+	scid string,
+) error {
+	if err := it.BaseSignDocumentsItem.unpack(enc, di, ow, scid); err != nil {
+		return err
+	}
+
+	it.status = DocSignStatus(st)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type SignDocumentsItemSingleFileStatusJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	OW base.Address        `json:"owner"`
+	ST DocSignStatus       `json:"status"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it SignDocumentsItemSingleFileStatus) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SignDocumentsItemSingleFileStatusJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.id,
+		OW:         it.owner,
+		ST:         it.status,
+		CI:         it.cid,
+	})
+}
+
+type SignDocumentsItemSingleFileStatusJSONUnpacker struct {
+	DI string              `json:"documentid"`
+	OW base.AddressDecoder `json:"owner"`
+	ST string              `json:"status"`
+	CI string              `json:"currency"`
+}
+
+func (it *SignDocumentsItemSingleFileStatus) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usd SignDocumentsItemSingleFileStatusJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &usd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, usd.DI, usd.OW, usd.ST, usd.CI)
+}
@@ -1,8 +1,10 @@
 package document
 
 import (
+	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 )
@@ -27,3 +29,57 @@ func checkFactSignsByState(
 
 	return nil
 }
+
+// checkFactSignsByAccounts checks the fact signs are signed by each of the
+// accounts over the threshold of its keys.
+func checkFactSignsByAccounts(
+	addresses []base.Address,
+	fs []base.FactSign,
+	getState func(string) (state.State, bool, error),
+) error {
+	keys := make([]currency.AccountKeys, len(addresses))
+	for i := range addresses {
+		st, err := existsState(currency.StateKeyAccount(addresses[i]), "keys of account", getState)
+		if err != nil {
+			return err
+		}
+
+		ks, err := currency.StateKeysValue(st)
+		if err != nil {
+			return operation.NewBaseReasonErrorFromError(err)
+		}
+
+		keys[i] = ks
+	}
+
+	if err := checkThresholdOfAccounts(fs, keys); err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return nil
+}
+
+// checkFactSignsByPubs checks the fact signs are signed by the suffrage nodes
+// over threshold.
+func checkFactSignsByPubs(pubs []key.Publickey, threshold base.Threshold, fs []base.FactSign) error {
+	if len(fs) < 1 {
+		return errors.Errorf("empty factsigns")
+	}
+
+	var signed uint
+	for i := range fs {
+		for j := range pubs {
+			if fs[i].Signer().Equal(pubs[j]) {
+				signed++
+
+				break
+			}
+		}
+	}
+
+	if signed < threshold.Threshold {
+		return errors.Errorf("not enough suffrage signs, signed=%d < threshold=%d", signed, threshold.Threshold)
+	}
+
+	return nil
+}
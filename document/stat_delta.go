@@ -0,0 +1,138 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	StatDeltaType   = hint.Type("mitum-blockcity-stat-delta")
+	StatDeltaHint   = hint.NewHint(StatDeltaType, "v0.0.1")
+	StatDeltaHinter = StatDelta{BaseHinter: hint.NewBaseHinter(StatDeltaHint)}
+)
+
+// StatDelta is the signed change of each field of UserStatistics.
+type StatDelta struct {
+	hint.BaseHinter
+	hp           int64
+	strength     int64
+	agility      int64
+	dexterity    int64
+	charisma     int64
+	intelligence int64
+	vital        int64
+}
+
+func NewStatDelta(hp, strength, agility, dexterity, charisma, intelligence, vital int64) StatDelta {
+	return StatDelta{
+		BaseHinter:   hint.NewBaseHinter(StatDeltaHint),
+		hp:           hp,
+		strength:     strength,
+		agility:      agility,
+		dexterity:    dexterity,
+		charisma:     charisma,
+		intelligence: intelligence,
+		vital:        vital,
+	}
+}
+
+// NewStatDeltaBetween returns the delta, which changes the statistics from to
+// to.
+func NewStatDeltaBetween(from, to UserStatistics) StatDelta {
+	a, b := from.values(), to.values()
+
+	var d [7]int64
+	for i := range a {
+		d[i] = int64(b[i]) - int64(a[i])
+	}
+
+	return newStatDeltaFromValues(d)
+}
+
+func newStatDeltaFromValues(d [7]int64) StatDelta {
+	return NewStatDelta(d[0], d[1], d[2], d[3], d[4], d[5], d[6])
+}
+
+func (sd StatDelta) values() [7]int64 {
+	return [7]int64{sd.hp, sd.strength, sd.agility, sd.dexterity, sd.charisma, sd.intelligence, sd.vital}
+}
+
+func (sd StatDelta) Bytes() []byte {
+	v := sd.values()
+
+	bs := make([][]byte, len(v))
+	for i := range v {
+		bs[i] = util.Int64ToBytes(v[i])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (sd StatDelta) IsValid([]byte) error {
+	if err := sd.BaseHinter.IsValid(nil); err != nil {
+		return isvalid.InvalidError.Errorf("invalid StatDelta: %w", err)
+	}
+
+	if sd.IsZero() {
+		return isvalid.InvalidError.Errorf("invalid StatDelta: empty delta")
+	}
+
+	return nil
+}
+
+// IsZero checks whether the delta changes nothing.
+func (sd StatDelta) IsZero() bool {
+	return sd.values() == [7]int64{}
+}
+
+func (sd StatDelta) Equal(b StatDelta) bool {
+	return sd.values() == b.values()
+}
+
+// applyStatDelta returns the statistics changed by the delta; each field can
+// not be under zero.
+func (us UserStatistics) applyStatDelta(d StatDelta) (UserStatistics, error) {
+	v, dv := us.values(), d.values()
+	names := userStatisticsNames()
+
+	for i := range v {
+		switch {
+		case dv[i] < 0 && uint64(-dv[i]) > uint64(v[i]):
+			return us, errors.Errorf("%s under zero, %d - %d", names[i], v[i], -dv[i])
+		case dv[i] < 0:
+			v[i] -= uint(-dv[i])
+		case v[i]+uint(dv[i]) < v[i]:
+			return us, errors.Errorf("%s overflows, %d + %d", names[i], v[i], dv[i])
+		default:
+			v[i] += uint(dv[i])
+		}
+	}
+
+	return newUserStatisticsFromValues(v), nil
+}
+
+func (us UserStatistics) values() [7]uint {
+	return [7]uint{us.hp, us.strength, us.agility, us.dexterity, us.charisma, us.intelligence, us.vital}
+}
+
+func newUserStatisticsFromValues(v [7]uint) UserStatistics {
+	return NewUserStatistics(v[0], v[1], v[2], v[3], v[4], v[5], v[6])
+}
+
+// Total returns the sum of all the fields, which is checked against the stat
+// point budget of StatPolicy.
+func (us UserStatistics) Total() uint64 {
+	var t uint64
+	v := us.values()
+	for i := range v {
+		t += uint64(v[i])
+	}
+
+	return t
+}
+
+func userStatisticsNames() [7]string {
+	return [7]string{"hp", "strength", "agility", "dexterity", "charisma", "intelligence", "vital"}
+}
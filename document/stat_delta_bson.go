@@ -0,0 +1,40 @@
+package document
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (sd StatDelta) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sd.Hint()),
+		bson.M{
+			"hp":           sd.hp,
+			"strength":     sd.strength,
+			"agility":      sd.agility,
+			"dexterity":    sd.dexterity,
+			"charisma":     sd.charisma,
+			"intelligence": sd.intelligence,
+			"vital":        sd.vital,
+		}),
+	)
+}
+
+type StatDeltaBSONUnpacker struct {
+	HP int64 `bson:"hp"`
+	ST int64 `bson:"strength"`
+	AG int64 `bson:"agility"`
+	DX int64 `bson:"dexterity"`
+	CR int64 `bson:"charisma"`
+	IG int64 `bson:"intelligence"`
+	VT int64 `bson:"vital"`
+}
+
+func (sd *StatDelta) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usd StatDeltaBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &usd); err != nil {
+		return err
+	}
+
+	return sd.unpack(enc, [7]int64{usd.HP, usd.ST, usd.AG, usd.DX, usd.CR, usd.IG, usd.VT})
+}
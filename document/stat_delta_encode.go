@@ -0,0 +1,20 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (sd *StatDelta) unpack(
+	_ encoder.Encoder,
+	d [7]int64,
+) error {
+	sd.hp = d[0]
+	sd.strength = d[1]
+	sd.agility = d[2]
+	sd.dexterity = d[3]
+	sd.charisma = d[4]
+	sd.intelligence = d[5]
+	sd.vital = d[6]
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package document
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type StatDeltaJSONPacker struct {
+	jsonenc.HintedHead
+	HP int64 `json:"hp"`
+	ST int64 `json:"strength"`
+	AG int64 `json:"agility"`
+	DX int64 `json:"dexterity"`
+	CR int64 `json:"charisma"`
+	IG int64 `json:"intelligence"`
+	VT int64 `json:"vital"`
+}
+
+func (sd StatDelta) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(StatDeltaJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sd.Hint()),
+		HP:         sd.hp,
+		ST:         sd.strength,
+		AG:         sd.agility,
+		DX:         sd.dexterity,
+		CR:         sd.charisma,
+		IG:         sd.intelligence,
+		VT:         sd.vital,
+	})
+}
+
+type StatDeltaJSONUnpacker struct {
+	HP int64 `json:"hp"`
+	ST int64 `json:"strength"`
+	AG int64 `json:"agility"`
+	DX int64 `json:"dexterity"`
+	CR int64 `json:"charisma"`
+	IG int64 `json:"intelligence"`
+	VT int64 `json:"vital"`
+}
+
+func (sd *StatDelta) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usd StatDeltaJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &usd); err != nil {
+		return err
+	}
+
+	return sd.unpack(enc, [7]int64{usd.HP, usd.ST, usd.AG, usd.DX, usd.CR, usd.IG, usd.VT})
+}
@@ -0,0 +1,101 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	StatPolicyType   = hint.Type("mitum-blockcity-stat-policy")
+	StatPolicyHint   = hint.NewHint(StatPolicyType, "v0.0.1")
+	StatPolicyHinter = StatPolicy{BaseHinter: hint.NewBaseHinter(StatPolicyHint)}
+)
+
+// StatPolicy bounds the statistics of blockcity user documents; each field
+// should be between min and max, and with budget, the sum of all the fields
+// should not be over budget.
+type StatPolicy struct {
+	hint.BaseHinter
+	min    UserStatistics
+	max    UserStatistics
+	budget uint // no budget when zero
+}
+
+func NewStatPolicy(min, max UserStatistics, budget uint) StatPolicy {
+	return StatPolicy{
+		BaseHinter: hint.NewBaseHinter(StatPolicyHint),
+		min:        min,
+		max:        max,
+		budget:     budget,
+	}
+}
+
+func (sp StatPolicy) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sp.min.Bytes(),
+		sp.max.Bytes(),
+		util.UintToBytes(sp.budget),
+	)
+}
+
+func (sp StatPolicy) IsValid([]byte) error {
+	if err := sp.BaseHinter.IsValid(nil); err != nil {
+		return isvalid.InvalidError.Errorf("invalid StatPolicy: %w", err)
+	}
+
+	min, max := sp.min.values(), sp.max.values()
+	names := userStatisticsNames()
+	for i := range min {
+		if min[i] > max[i] {
+			return isvalid.InvalidError.Errorf("invalid StatPolicy: min of %s over max, %d > %d", names[i], min[i], max[i])
+		}
+	}
+
+	if sp.HasBudget() && sp.min.Total() > uint64(sp.budget) {
+		return isvalid.InvalidError.Errorf("invalid StatPolicy: sum of min over budget, %d > %d", sp.min.Total(), sp.budget)
+	}
+
+	return nil
+}
+
+func (sp StatPolicy) Min() UserStatistics {
+	return sp.min
+}
+
+func (sp StatPolicy) Max() UserStatistics {
+	return sp.max
+}
+
+func (sp StatPolicy) Budget() uint {
+	return sp.budget
+}
+
+func (sp StatPolicy) HasBudget() bool {
+	return sp.budget > 0
+}
+
+// Check checks whether the statistics are within the bounds and the budget.
+func (sp StatPolicy) Check(us UserStatistics) error {
+	v, min, max := us.values(), sp.min.values(), sp.max.values()
+	names := userStatisticsNames()
+	for i := range v {
+		switch {
+		case v[i] < min[i]:
+			return errors.Errorf("%s under min, %d < %d", names[i], v[i], min[i])
+		case v[i] > max[i]:
+			return errors.Errorf("%s over max, %d > %d", names[i], v[i], max[i])
+		}
+	}
+
+	if sp.HasBudget() && us.Total() > uint64(sp.budget) {
+		return errors.Errorf("sum of statistics over budget, %d > %d", us.Total(), sp.budget)
+	}
+
+	return nil
+}
+
+func (sp StatPolicy) Equal(b StatPolicy) bool {
+	return sp.min.Equal(b.min) && sp.max.Equal(b.max) && sp.budget == b.budget
+}
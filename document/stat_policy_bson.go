@@ -0,0 +1,34 @@
+package document
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (sp StatPolicy) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"min": sp.min,
+		"max": sp.max,
+	}
+
+	if sp.HasBudget() {
+		m["budget"] = sp.budget
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(sp.Hint()), m))
+}
+
+type StatPolicyBSONUnpacker struct {
+	MN bson.Raw `bson:"min"`
+	MX bson.Raw `bson:"max"`
+	BG uint     `bson:"budget,omitempty"`
+}
+
+func (sp *StatPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usp StatPolicyBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &usp); err != nil {
+		return err
+	}
+
+	return sp.unpack(enc, usp.MN, usp.MX, usp.BG)
+}
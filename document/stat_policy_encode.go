@@ -0,0 +1,42 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (sp *StatPolicy) unpack(
+	enc encoder.Encoder,
+	bmin, bmax []byte,
+	budget uint,
+) error {
+	min, err := decodeUserStatistics(bmin, enc)
+	if err != nil {
+		return err
+	}
+
+	max, err := decodeUserStatistics(bmax, enc)
+	if err != nil {
+		return err
+	}
+
+	sp.min = min
+	sp.max = max
+	sp.budget = budget
+
+	return nil
+}
+
+func decodeUserStatistics(b []byte, enc encoder.Encoder) (UserStatistics, error) {
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		return UserStatistics{}, err
+	}
+
+	us, ok := hinter.(UserStatistics)
+	if !ok {
+		return UserStatistics{}, util.WrongTypeError.Errorf("expected UserStatistics, not %T", hinter)
+	}
+
+	return us, nil
+}
@@ -0,0 +1,38 @@
+package document
+
+import (
+	"encoding/json"
+
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type StatPolicyJSONPacker struct {
+	jsonenc.HintedHead
+	MN UserStatistics `json:"min"`
+	MX UserStatistics `json:"max"`
+	BG uint           `json:"budget,omitempty"`
+}
+
+func (sp StatPolicy) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(StatPolicyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sp.Hint()),
+		MN:         sp.min,
+		MX:         sp.max,
+		BG:         sp.budget,
+	})
+}
+
+type StatPolicyJSONUnpacker struct {
+	MN json.RawMessage `json:"min"`
+	MX json.RawMessage `json:"max"`
+	BG uint            `json:"budget,omitempty"`
+}
+
+func (sp *StatPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usp StatPolicyJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &usp); err != nil {
+		return err
+	}
+
+	return sp.unpack(enc, usp.MN, usp.MX, usp.BG)
+}
@@ -74,6 +74,222 @@ func SetStateDocumentsValue(st state.State, v DocumentInventory) (state.State, e
 	}
 }
 
+var StateKeyDocTypeRegistry = "document:DocTypeRegistry"
+
+func IsStateDocTypeRegistryKey(key string) bool {
+	return key == StateKeyDocTypeRegistry
+}
+
+func StateDocTypeRegistryValue(st state.State) (DocTypeRegistry, error) {
+	v := st.Value()
+	if v == nil {
+		return DocTypeRegistry{}, util.NotFoundError.Errorf("document type registry not found in State")
+	}
+
+	if s, ok := v.Interface().(DocTypeRegistry); !ok {
+		return DocTypeRegistry{}, errors.Errorf("invalid document type registry value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateDocTypeRegistryValue(st state.State, v DocTypeRegistry) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+var StateKeyGoldRate = "blockcity:GoldRate"
+
+func IsStateGoldRateKey(key string) bool {
+	return key == StateKeyGoldRate
+}
+
+func StateGoldRateValue(st state.State) (GoldRate, error) {
+	v := st.Value()
+	if v == nil {
+		return GoldRate{}, util.NotFoundError.Errorf("gold rate not found in State")
+	}
+
+	if s, ok := v.Interface().(GoldRate); !ok {
+		return GoldRate{}, errors.Errorf("invalid gold rate value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateGoldRateValue(st state.State, v GoldRate) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+var StateKeyStatPolicy = "blockcity:StatPolicy"
+
+func IsStateStatPolicyKey(key string) bool {
+	return key == StateKeyStatPolicy
+}
+
+func StateStatPolicyValue(st state.State) (StatPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return StatPolicy{}, util.NotFoundError.Errorf("stat policy not found in State")
+	}
+
+	if s, ok := v.Interface().(StatPolicy); !ok {
+		return StatPolicy{}, errors.Errorf("invalid stat policy value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateStatPolicyValue(st state.State, v StatPolicy) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+var StateKeyDelegatesSuffix = ":Delegates"
+
+func StateKeyDelegates(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyDelegatesSuffix)
+}
+
+func IsStateDelegatesKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyDelegatesSuffix)
+}
+
+func StateDelegatesValue(st state.State) (DocumentDelegates, error) {
+	v := st.Value()
+	if v == nil {
+		return DocumentDelegates{}, util.NotFoundError.Errorf("document delegates not found in State")
+	}
+
+	if s, ok := v.Interface().(DocumentDelegates); !ok {
+		return DocumentDelegates{}, errors.Errorf("invalid document delegates value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateDelegatesValue(st state.State, v DocumentDelegates) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+var StateKeyVotingBoxSuffix = ":VotingBox"
+
+func StateKeyVotingBox(documentid string) string {
+	return fmt.Sprintf("%s%s", documentid, StateKeyVotingBoxSuffix)
+}
+
+func IsStateVotingBoxKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyVotingBoxSuffix)
+}
+
+func StateVotingBoxValue(st state.State) (VotingBox, error) {
+	v := st.Value()
+	if v == nil {
+		return VotingBox{}, util.NotFoundError.Errorf("voting box not found in State")
+	}
+
+	if s, ok := v.Interface().(VotingBox); !ok {
+		return VotingBox{}, errors.Errorf("invalid voting box value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateVotingBoxValue(st state.State, v VotingBox) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+var StateKeyVotingResultSuffix = ":VotingResult"
+
+// StateKeyVotingResult returns the state key of the result of the round of
+// voting document.
+func StateKeyVotingResult(documentid string, round uint) string {
+	return fmt.Sprintf("%s-%d%s", documentid, round, StateKeyVotingResultSuffix)
+}
+
+func IsStateVotingResultKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyVotingResultSuffix)
+}
+
+func StateVotingResultValue(st state.State) (VotingResult, error) {
+	v := st.Value()
+	if v == nil {
+		return VotingResult{}, util.NotFoundError.Errorf("voting result not found in State")
+	}
+
+	if s, ok := v.Interface().(VotingResult); !ok {
+		return VotingResult{}, errors.Errorf("invalid voting result value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateVotingResultValue(st state.State, v VotingResult) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+var StateKeyVotingSnapshots = "blockcity:VotingSnapshots"
+
+func IsStateVotingSnapshotsKey(key string) bool {
+	return key == StateKeyVotingSnapshots
+}
+
+func StateVotingSnapshotsValue(st state.State) (VotingSnapshots, error) {
+	v := st.Value()
+	if v == nil {
+		return VotingSnapshots{}, util.NotFoundError.Errorf("voting snapshots not found in State")
+	}
+
+	if s, ok := v.Interface().(VotingSnapshots); !ok {
+		return VotingSnapshots{}, errors.Errorf("invalid voting snapshots value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateVotingSnapshotsValue(st state.State, v VotingSnapshots) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}
+
+var StateKeyVotingCheckpointSuffix = ":VotingCheckpoint"
+
+// StateKeyVotingCheckpoint returns the state key of the checkpoint of the
+// state of key at the snapshot height; the checkpoint has the same value with
+// the state of key at the snapshot.
+func StateKeyVotingCheckpoint(key string, snapshot base.Height) string {
+	return fmt.Sprintf("%s-%d%s", key, snapshot, StateKeyVotingCheckpointSuffix)
+}
+
+func IsStateVotingCheckpointKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyVotingCheckpointSuffix)
+}
+
 func checkExistsState(
 	key string,
 	getState func(key string) (state.State, bool, error),
@@ -0,0 +1,204 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	SwapGoldFactType   = hint.Type("mitum-swap-gold-operation-fact")
+	SwapGoldFactHint   = hint.NewHint(SwapGoldFactType, "v0.0.1")
+	SwapGoldFactHinter = SwapGoldFact{BaseHinter: hint.NewBaseHinter(SwapGoldFactHint)}
+	SwapGoldType       = hint.Type("mitum-swap-gold-operation")
+	SwapGoldHint       = hint.NewHint(SwapGoldType, "v0.0.1")
+	SwapGoldHinter     = SwapGold{BaseOperation: operationHinter(SwapGoldHint)}
+)
+
+var MaxSwapGoldItems uint = 10
+
+type SwapGoldItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Mode() SwapMode
+	Amount() uint
+	Currency() currency.CurrencyID
+	Rebuild() SwapGoldItem
+}
+
+// SwapGoldFact swaps the gold of the user documents of sender with the
+// currency of the gold rate. The currency and price of fact should match with
+// the gold rate in state, so the swap is not processed when the rate is
+// changed after the sender signed.
+type SwapGoldFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	cid    currency.CurrencyID
+	price  currency.Big
+	items  []SwapGoldItem
+}
+
+func NewSwapGoldFact(
+	token []byte,
+	sender base.Address,
+	cid currency.CurrencyID,
+	price currency.Big,
+	items []SwapGoldItem,
+) SwapGoldFact {
+	fact := SwapGoldFact{
+		BaseHinter: hint.NewBaseHinter(SwapGoldFactHint),
+		token:      token,
+		sender:     sender,
+		cid:        cid,
+		price:      price,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact SwapGoldFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact SwapGoldFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SwapGoldFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.cid.Bytes(),
+		fact.price.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact SwapGoldFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for SwapGoldFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxSwapGoldItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxSwapGoldItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.cid); err != nil {
+		return err
+	}
+
+	if !fact.price.OverZero() {
+		return errors.Errorf("price of gold should be over zero")
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact SwapGoldFact) Token() []byte {
+	return fact.token
+}
+
+func (fact SwapGoldFact) Sender() base.Address {
+	return fact.sender
+}
+
+// Currency returns the currency of swap; the fee is paid by the currency of
+// each item.
+func (fact SwapGoldFact) Currency() currency.CurrencyID {
+	return fact.cid
+}
+
+func (fact SwapGoldFact) Price() currency.Big {
+	return fact.price
+}
+
+func (fact SwapGoldFact) Items() []SwapGoldItem {
+	return fact.items
+}
+
+// sellsGold checks whether the items sell gold; the currency for the sold gold
+// is paid by the treasury.
+func (fact SwapGoldFact) sellsGold() bool {
+	for i := range fact.items {
+		if fact.items[i].Mode() == SwapSell {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (fact SwapGoldFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact SwapGoldFact) Rebuild() SwapGoldFact {
+	items := make([]SwapGoldItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type SwapGold struct {
+	currency.BaseOperation
+}
+
+func NewSwapGold(fact SwapGoldFact, fs []base.FactSign, memo string) (SwapGold, error) {
+	bo, err := currency.NewBaseOperationFromFact(SwapGoldHint, fact, fs, memo)
+	if err != nil {
+		return SwapGold{}, err
+	} else {
+
+		return SwapGold{BaseOperation: bo}, nil
+	}
+}
@@ -0,0 +1,114 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	SwapGoldItemImplType   = hint.Type("mitum-swap-gold-item")
+	SwapGoldItemImplHint   = hint.NewHint(SwapGoldItemImplType, "v0.0.1")
+	SwapGoldItemImplHinter = SwapGoldItemImpl{BaseHinter: hint.NewBaseHinter(SwapGoldItemImplHint)}
+)
+
+// SwapMode is the direction of gold swap.
+type SwapMode string
+
+const (
+	SwapBuy  SwapMode = "buy"  // currency of sender into gold of document
+	SwapSell SwapMode = "sell" // gold of document into currency of sender
+)
+
+func (sm SwapMode) Bytes() []byte {
+	return []byte(sm)
+}
+
+func (sm SwapMode) String() string {
+	return string(sm)
+}
+
+func (sm SwapMode) IsValid([]byte) error {
+	switch sm {
+	case SwapBuy, SwapSell:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown swap mode, %q", sm)
+	}
+}
+
+// SwapGoldItemImpl buys or sells the gold of the blockcity user document.
+type SwapGoldItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	mode       SwapMode
+	amount     uint
+	cid        currency.CurrencyID
+}
+
+func NewSwapGoldItemImpl(
+	documentid string,
+	mode SwapMode,
+	amount uint,
+	cid currency.CurrencyID) SwapGoldItemImpl {
+
+	return SwapGoldItemImpl{
+		BaseHinter: hint.NewBaseHinter(SwapGoldItemImplHint),
+		documentid: documentid,
+		mode:       mode,
+		amount:     amount,
+		cid:        cid,
+	}
+}
+
+func (it SwapGoldItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		it.mode.Bytes(),
+		util.UintToBytes(it.amount),
+		it.cid.Bytes(),
+	)
+}
+
+func (it SwapGoldItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.mode,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid SwapGoldItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid SwapGoldItem: empty documentid")
+	}
+
+	if it.amount < 1 {
+		return isvalid.InvalidError.Errorf("invalid SwapGoldItem: amount should be over zero")
+	}
+
+	return nil
+}
+
+func (it SwapGoldItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it SwapGoldItemImpl) Mode() SwapMode {
+	return it.mode
+}
+
+// Amount returns the amount of gold to be swapped.
+func (it SwapGoldItemImpl) Amount() uint {
+	return it.amount
+}
+
+func (it SwapGoldItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it SwapGoldItemImpl) Rebuild() SwapGoldItem {
+	return it
+}
@@ -0,0 +1,34 @@
+package document // nolint:dupl
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it SwapGoldItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"mode":       it.mode,
+				"amount":     it.amount,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type SwapGoldItemImplBSONUnpacker struct {
+	DI string `bson:"documentid"`
+	MD string `bson:"mode"`
+	AM uint   `bson:"amount"`
+	CI string `bson:"currency"`
+}
+
+func (it *SwapGoldItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u SwapGoldItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.MD, u.AM, u.CI)
+}
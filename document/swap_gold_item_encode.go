@@ -0,0 +1,21 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *SwapGoldItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	md string,
+	am uint,
+	cid string,
+) error {
+	it.documentid = di
+	it.mode = SwapMode(md)
+	it.amount = am
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
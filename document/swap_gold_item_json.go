@@ -0,0 +1,40 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type SwapGoldItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	MD SwapMode            `json:"mode"`
+	AM uint                `json:"amount"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it SwapGoldItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SwapGoldItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		MD:         it.mode,
+		AM:         it.amount,
+		CI:         it.cid,
+	})
+}
+
+type SwapGoldItemImplJSONUnpacker struct {
+	DI string `json:"documentid"`
+	MD string `json:"mode"`
+	AM uint   `json:"amount"`
+	CI string `json:"currency"`
+}
+
+func (it *SwapGoldItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var u SwapGoldItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.MD, u.AM, u.CI)
+}
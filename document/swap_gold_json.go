@@ -0,0 +1,61 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type SwapGoldFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash      `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.Address        `json:"sender"`
+	CI currency.CurrencyID `json:"currency"`
+	PR currency.Big        `json:"price"`
+	IT []SwapGoldItem      `json:"items"`
+}
+
+func (fact SwapGoldFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SwapGoldFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		CI:         fact.cid,
+		PR:         fact.price,
+		IT:         fact.items,
+	})
+}
+
+type SwapGoldFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	CI string              `json:"currency"`
+	PR currency.Big        `json:"price"`
+	IT json.RawMessage     `json:"items"`
+}
+
+func (fact *SwapGoldFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uda SwapGoldFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uda); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, uda.H, uda.TK, uda.SD, uda.CI, uda.PR, uda.IT)
+}
+
+func (op *SwapGold) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
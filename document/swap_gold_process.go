@@ -0,0 +1,244 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var SwapGoldProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SwapGoldProcessor)
+	},
+}
+
+func (op SwapGold) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type SwapGoldProcessor struct {
+	cp *currency.CurrencyPool
+	SwapGold
+	rate     GoldRate
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewSwapGoldProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(SwapGold)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not SwapGold, %T", op)
+		}
+
+		opp := SwapGoldProcessorPool.Get().(*SwapGoldProcessor)
+
+		opp.cp = cp
+		opp.SwapGold = i
+		opp.rate = GoldRate{}
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *SwapGoldProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(SwapGoldFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	rate, err := loadGoldRate(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	if fact.cid != rate.Currency() || !fact.price.Equal(rate.Price()) {
+		return nil, operation.NewBaseReasonError(
+			"gold rate changed, %v %q != %v %q", fact.price, fact.cid, rate.Price(), rate.Currency())
+	}
+
+	if fact.sender.Equal(rate.Treasury()) {
+		return nil, operation.NewBaseReasonError("treasury can not swap gold, %v", fact.sender)
+	}
+	opp.rate = rate
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if _, _, err := opp.swapGold(getState); err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *SwapGoldProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(SwapGoldFact)
+
+	sts, sells, err := opp.swapGold(getState)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process swap gold: %w", err)
+	}
+
+	// append sender balance state; the currency of sold gold is paid to
+	// sender
+	for k := range opp.required {
+		rq := opp.required[k]
+		sb := opp.sb[k].Sub(rq[0]).AddFee(rq[1])
+		if k == opp.rate.Currency() {
+			sb = sb.Add(sells)
+		}
+
+		sts = append(sts, sb)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// swapGold returns the user document states with the swapped gold and the
+// balance state of treasury, with the amount of currency paid to sender for
+// the sold gold. The currency for the bought gold is already added in the
+// required amount of sender.
+func (opp *SwapGoldProcessor) swapGold(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, currency.Big, error) {
+	fact := opp.Fact().(SwapGoldFact)
+
+	buys, sells := currency.ZeroBig, currency.ZeroBig
+
+	gl := newGoldLedger()
+	for i := range fact.items {
+		it := fact.items[i]
+
+		doc, err := gl.load(fact.sender, it.DocumentId(), getState)
+		if err != nil {
+			return nil, currency.ZeroBig, err
+		}
+
+		var ndoc BCUserData
+		switch it.Mode() {
+		case SwapBuy:
+			ndoc, err = doc.addGold(it.Amount())
+			buys = buys.Add(opp.rate.Cost(it.Amount()))
+		case SwapSell:
+			ndoc, err = doc.subGold(it.Amount())
+			sells = sells.Add(opp.rate.Cost(it.Amount()))
+		default:
+			err = operation.NewBaseReasonError("unknown swap mode, %q", it.Mode())
+		}
+		if err != nil {
+			return nil, currency.ZeroBig, operation.NewBaseReasonErrorFromError(err)
+		}
+		gl.set(ndoc)
+	}
+
+	sts, err := gl.states()
+	if err != nil {
+		return nil, currency.ZeroBig, err
+	}
+
+	tst, err := existsState(
+		currency.StateKeyBalance(opp.rate.Treasury(), opp.rate.Currency()), "currency of treasury", getState)
+	if err != nil {
+		return nil, currency.ZeroBig, err
+	}
+
+	// NOTE the balance of treasury is not paid by the other operations in the
+	// same proposal; see OperationProcessor.checkTreasuryDuplication.
+	if sells.Compare(buys) > 0 {
+		am, err := currency.StateBalanceValue(tst)
+		if err != nil {
+			return nil, currency.ZeroBig, operation.NewBaseReasonError("insufficient balance of treasury: %w", err)
+		}
+
+		if am.Big().Add(buys).Compare(sells) < 0 {
+			return nil, currency.ZeroBig, operation.NewBaseReasonError(
+				"insufficient balance of treasury, %v; %v + %v < %v", opp.rate.Treasury(), am.Big(), buys, sells)
+		}
+	}
+
+	tb := currency.NewAmountState(tst, opp.rate.Currency()).Add(buys).Sub(sells)
+
+	return append(sts, tb), sells, nil
+}
+
+func (opp *SwapGoldProcessor) Close() error {
+	opp.cp = nil
+	opp.SwapGold = SwapGold{}
+	opp.rate = GoldRate{}
+	opp.sb = nil
+	opp.required = nil
+
+	SwapGoldProcessorPool.Put(opp)
+
+	return nil
+}
+
+// calculateItemsFee returns the fee of items with the currency for the bought
+// gold; the currency of gold rate is always included, so the balance of
+// sender, to which the currency for the sold gold is paid, is prepared.
+func (opp *SwapGoldProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(SwapGoldFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	required, err := CalculateCurrencyItemsFee(opp.cp, items)
+	if err != nil {
+		return nil, err
+	}
+
+	rq := [2]currency.Big{currency.ZeroBig, currency.ZeroBig}
+	if k, found := required[opp.rate.Currency()]; found {
+		rq = k
+	}
+
+	for i := range fact.items {
+		if fact.items[i].Mode() == SwapBuy {
+			rq[0] = rq[0].Add(opp.rate.Cost(fact.items[i].Amount()))
+		}
+	}
+
+	required[opp.rate.Currency()] = rq
+
+	return required, nil
+}
+
+// loadGoldRate returns the gold rate in state.
+func loadGoldRate(getState func(key string) (state.State, bool, error)) (GoldRate, error) {
+	st, err := existsState(StateKeyGoldRate, "gold rate", getState)
+	if err != nil {
+		return GoldRate{}, err
+	}
+
+	return StateGoldRateValue(st)
+}
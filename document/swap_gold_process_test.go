@@ -0,0 +1,169 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testSwapGoldProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testSwapGoldProcessor) newGoldRateState(treasury base.Address, price int64) []state.State {
+	st, err := state.NewStateV0(StateKeyGoldRate, nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateGoldRateValue(st, NewGoldRate(t.cid, currency.NewBig(price), treasury))
+	t.NoError(err)
+
+	return []state.State{nst}
+}
+
+func (t *testSwapGoldProcessor) newSwapGold(
+	sender testAccount, documentid string, mode SwapMode, amount uint, price int64,
+) SwapGold {
+	items := []SwapGoldItem{NewSwapGoldItemImpl(documentid, mode, amount, t.cid)}
+	fact := NewSwapGoldFact(util.UUID().Bytes(), sender.Address(), t.cid, currency.NewBig(price), items)
+
+	op, err := NewSwapGold(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testSwapGoldProcessor) newUpdateGoldRate(sender testAccount, rate GoldRate) UpdateGoldRate {
+	fact := NewUpdateGoldRateFact(util.UUID().Bytes(), sender.Address(), rate, t.cid)
+
+	op, err := NewUpdateGoldRate(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testSwapGoldProcessor) TestSellsInSameBlock() {
+	treasury, sts0 := t.newAccount()
+	seller0, sts1 := t.newAccount()
+	seller1, sts2 := t.newAccount()
+
+	doc0 := t.newBCUserData(seller0.Address(), "1cui", 100, 0)
+	doc1 := t.newBCUserData(seller1.Address(), "2cui", 100, 0)
+
+	sts := [][]state.State{
+		sts0, sts1, sts2,
+		t.newGoldRateState(treasury.Address(), 10),
+		t.newDocumentStates(seller0.Address(), doc0),
+		t.newDocumentStates(seller1.Address(), doc1),
+	}
+
+	pool, opr := t.statepool(sts...)
+
+	sell1 := t.newSwapGold(seller1, doc1.DocumentId(), SwapSell, 60, 10)
+
+	// the sell of seller1 is rejected instead of paying over the balance of
+	// treasury
+	errs := t.process(opr, t.newSwapGold(seller0, doc0.DocumentId(), SwapSell, 60, 10), sell1)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "balance of treasury")
+
+	t.Equal(currency.NewBig(400), t.updatedBalance(pool, treasury.Address()))
+	t.Equal(currency.NewBig(1600), t.updatedBalance(pool, seller0.Address()))
+	t.Equal(uint(40), t.updatedDocument(pool, doc0.DocumentId()).(BCUserData).Gold())
+
+	_, opr = t.nextStatepool(pool, sts...)
+
+	errs = t.process(opr, sell1)
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "insufficient balance of treasury")
+}
+
+func (t *testSwapGoldProcessor) TestTransferByTreasuryInSameBlock() {
+	treasury, sts0 := t.newAccount()
+	seller, sts1 := t.newAccount()
+
+	doc := t.newBCUserData(seller.Address(), "1cui", 100, 0)
+
+	_, opr := t.statepool(sts0, sts1,
+		t.newGoldRateState(treasury.Address(), 10), t.newDocumentStates(seller.Address(), doc))
+
+	items := []currency.TransfersItem{
+		currency.NewTransfersItemSingleAmount(seller.Address(), currency.NewAmount(currency.NewBig(600), t.cid)),
+	}
+	fact := currency.NewTransfersFact(util.UUID().Bytes(), treasury.Address(), items)
+
+	transfers, err := currency.NewTransfers(fact, t.newFactSigns(fact, treasury.Privs()...), "")
+	t.NoError(err)
+
+	// the treasury is kept as sender by the sell
+	errs := t.process(opr, t.newSwapGold(seller, doc.DocumentId(), SwapSell, 60, 10), transfers)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "violates only one sender")
+}
+
+func (t *testSwapGoldProcessor) TestBuysInSameBlock() {
+	treasury, sts0 := t.newAccount()
+	buyer0, sts1 := t.newAccount()
+	buyer1, sts2 := t.newAccount()
+
+	doc0 := t.newBCUserData(buyer0.Address(), "1cui", 0, 0)
+	doc1 := t.newBCUserData(buyer1.Address(), "2cui", 0, 0)
+
+	pool, opr := t.statepool(sts0, sts1, sts2,
+		t.newGoldRateState(treasury.Address(), 10),
+		t.newDocumentStates(buyer0.Address(), doc0),
+		t.newDocumentStates(buyer1.Address(), doc1),
+	)
+
+	// the buys only pay to treasury
+	errs := t.process(opr,
+		t.newSwapGold(buyer0, doc0.DocumentId(), SwapBuy, 10, 10),
+		t.newSwapGold(buyer1, doc1.DocumentId(), SwapBuy, 20, 10),
+	)
+	t.NoError(errs[0])
+	t.NoError(errs[1])
+
+	t.Equal(currency.NewBig(1300), t.updatedBalance(pool, treasury.Address()))
+	t.Equal(currency.NewBig(900), t.updatedBalance(pool, buyer0.Address()))
+	t.Equal(currency.NewBig(800), t.updatedBalance(pool, buyer1.Address()))
+	t.Equal(uint(20), t.updatedDocument(pool, doc1.DocumentId()).(BCUserData).Gold())
+}
+
+func (t *testSwapGoldProcessor) TestUpdateGoldRate() {
+	admin, sts0 := t.newAdmin()
+	treasury, sts1 := t.newAccount()
+	buyer, sts2 := t.newAccount()
+
+	doc := t.newBCUserData(buyer.Address(), "1cui", 0, 0)
+	dsts := t.newDocumentStates(buyer.Address(), doc)
+
+	pool, opr := t.statepool(sts0, sts1, sts2, t.newGoldRateState(treasury.Address(), 10), dsts)
+
+	errs := t.process(opr, t.newUpdateGoldRate(admin, NewGoldRate(t.cid, currency.NewBig(20), treasury.Address())))
+	t.NoError(errs[0])
+
+	// the swap with the old price is rejected
+	_, opr = t.nextStatepool(pool, sts0, sts1, sts2, dsts)
+
+	errs = t.process(opr, t.newSwapGold(buyer, doc.DocumentId(), SwapBuy, 10, 10))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "gold rate changed")
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, sts2, dsts)
+
+	errs = t.process(opr, t.newSwapGold(buyer, doc.DocumentId(), SwapBuy, 10, 20))
+	t.NoError(errs[0])
+
+	t.Equal(currency.NewBig(800), t.updatedBalance(pool, buyer.Address()))
+}
+
+func TestSwapGoldProcessor(t *testing.T) {
+	suite.Run(t, new(testSwapGoldProcessor))
+}
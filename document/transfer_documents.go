@@ -0,0 +1,169 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferDocumentsFactType   = hint.Type("mitum-transfer-documents-operation-fact")
+	TransferDocumentsFactHint   = hint.NewHint(TransferDocumentsFactType, "v0.0.1")
+	TransferDocumentsFactHinter = TransferDocumentsFact{BaseHinter: hint.NewBaseHinter(TransferDocumentsFactHint)}
+	TransferDocumentsType       = hint.Type("mitum-transfer-documents-operation")
+	TransferDocumentsHint       = hint.NewHint(TransferDocumentsType, "v0.0.1")
+	TransferDocumentsHinter     = TransferDocuments{BaseOperation: operationHinter(TransferDocumentsHint)}
+)
+
+var MaxTransferDocumentsItems uint = 10
+
+type TransferDocumentsItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Receiver() base.Address
+	Currency() currency.CurrencyID
+	Rebuild() TransferDocumentsItem
+}
+
+type TransferDocumentsFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []TransferDocumentsItem
+}
+
+func NewTransferDocumentsFact(token []byte, sender base.Address, items []TransferDocumentsItem) TransferDocumentsFact {
+	fact := TransferDocumentsFact{
+		BaseHinter: hint.NewBaseHinter(TransferDocumentsFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferDocumentsFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferDocumentsFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferDocumentsFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact TransferDocumentsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for TransferDocumentsFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxTransferDocumentsItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxTransferDocumentsItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	docIdMap := map[string]bool{}
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		k := it.DocumentId()
+		if _, found := docIdMap[k]; found {
+			return errors.Errorf("duplicated document id, %s", k)
+		}
+		docIdMap[k] = true
+
+		if it.Receiver().Equal(fact.sender) {
+			return errors.Errorf("receiver is same with sender, %q", fact.sender)
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact TransferDocumentsFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferDocumentsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferDocumentsFact) Items() []TransferDocumentsItem {
+	return fact.items
+}
+
+func (fact TransferDocumentsFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+	for i := range fact.items {
+		as = append(as, fact.items[i].Receiver())
+	}
+
+	return as, nil
+}
+
+func (fact TransferDocumentsFact) Rebuild() TransferDocumentsFact {
+	items := make([]TransferDocumentsItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type TransferDocuments struct {
+	currency.BaseOperation
+}
+
+func NewTransferDocuments(fact TransferDocumentsFact, fs []base.FactSign, memo string) (TransferDocuments, error) {
+	bo, err := currency.NewBaseOperationFromFact(TransferDocumentsHint, fact, fs, memo)
+	if err != nil {
+		return TransferDocuments{}, err
+	} else {
+
+		return TransferDocuments{BaseOperation: bo}, nil
+	}
+}
@@ -0,0 +1,76 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	TransferDocumentsItemImplType   = hint.Type("mitum-transfer-documents-item")
+	TransferDocumentsItemImplHint   = hint.NewHint(TransferDocumentsItemImplType, "v0.0.1")
+	TransferDocumentsItemImplHinter = TransferDocumentsItemImpl{BaseHinter: hint.NewBaseHinter(TransferDocumentsItemImplHint)}
+)
+
+type TransferDocumentsItemImpl struct {
+	hint.BaseHinter
+	id       string
+	receiver base.Address
+	cid      currency.CurrencyID
+}
+
+func NewTransferDocumentsItemImpl(
+	id string,
+	receiver base.Address,
+	cid currency.CurrencyID) TransferDocumentsItemImpl {
+
+	return TransferDocumentsItemImpl{
+		BaseHinter: hint.NewBaseHinter(TransferDocumentsItemImplHint),
+		id:         id,
+		receiver:   receiver,
+		cid:        cid,
+	}
+}
+
+func (it TransferDocumentsItemImpl) Bytes() []byte {
+	bs := make([][]byte, 3)
+	bs[0] = []byte(it.id)
+	bs[1] = it.receiver.Bytes()
+	bs[2] = it.cid.Bytes()
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it TransferDocumentsItemImpl) IsValid([]byte) error {
+	if _, _, err := ParseDocId(it.id); err != nil {
+		return isvalid.InvalidError.Errorf("invalid TransferDocumentsItem: %w", err)
+	}
+
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.receiver,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid TransferDocumentsItem: %w", err)
+	}
+	return nil
+}
+
+func (it TransferDocumentsItemImpl) DocumentId() string {
+	return it.id
+}
+
+func (it TransferDocumentsItemImpl) Receiver() base.Address {
+	return it.receiver
+}
+
+func (it TransferDocumentsItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it TransferDocumentsItemImpl) Rebuild() TransferDocumentsItem {
+	return it
+}
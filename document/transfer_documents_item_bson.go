@@ -0,0 +1,33 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it TransferDocumentsItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.id,
+				"receiver":   it.receiver,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type TransferDocumentsItemImplBSONUnpacker struct {
+	DI string              `bson:"documentid"`
+	RC base.AddressDecoder `bson:"receiver"`
+	CI string              `bson:"currency"`
+}
+
+func (it *TransferDocumentsItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var utd TransferDocumentsItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &utd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, utd.DI, utd.RC, utd.CI)
+}
@@ -0,0 +1,25 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *TransferDocumentsItemImpl) unpack(
+	enc encoder.Encoder,
+	di string,
+	rc base.AddressDecoder,
+	scid string,
+) error {
+	it.id = di
+
+	a, err := rc.Encode(enc)
+	if err != nil {
+		return err
+	}
+	it.receiver = a
+	it.cid = currency.CurrencyID(scid)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type TransferDocumentsItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	RC base.Address        `json:"receiver"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it TransferDocumentsItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransferDocumentsItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.id,
+		RC:         it.receiver,
+		CI:         it.cid,
+	})
+}
+
+type TransferDocumentsItemImplJSONUnpacker struct {
+	DI string              `json:"documentid"`
+	RC base.AddressDecoder `json:"receiver"`
+	CI string              `json:"currency"`
+}
+
+func (it *TransferDocumentsItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var utd TransferDocumentsItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &utd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, utd.DI, utd.RC, utd.CI)
+}
@@ -0,0 +1,356 @@
+package document
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var TransferDocumentsItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferDocumentsItemProcessor)
+	},
+}
+
+var TransferDocumentsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferDocumentsProcessor)
+	},
+}
+
+func (op TransferDocuments) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type TransferDocumentsItemProcessor struct {
+	cp     *currency.CurrencyPool
+	h      valuehash.Hash
+	sender base.Address
+	item   TransferDocumentsItem
+	dd     DocumentData // document data to be transferred
+	nds    state.State  // document data state (key = document id)
+}
+
+func (opp *TransferDocumentsItemProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) error {
+
+	if err := opp.item.IsValid(nil); err != nil {
+		return operation.NewBaseReasonError(err.Error())
+	}
+
+	if err := checkDocIdRegistered(opp.item.DocumentId(), getState); err != nil {
+		return err
+	}
+
+	// check existence of receiver account
+	if err := checkExistsState(currency.StateKeyAccount(opp.item.Receiver()), getState); err != nil {
+		return err
+	}
+
+	// check existence of document state with documentid
+	switch st, found, err := getState(StateKeyDocumentData(opp.item.DocumentId())); {
+	case err != nil:
+		return err
+	case !found:
+		return operation.NewBaseReasonError("document not registered with documentid, %q", opp.item.DocumentId())
+	default:
+		opp.nds = st
+	}
+
+	dd, err := StateDocumentDataValue(opp.nds)
+	if err != nil {
+		return err
+	}
+
+	if IsArchivedDocumentData(dd) {
+		return operation.NewBaseReasonError("document already removed, %q", opp.item.DocumentId())
+	}
+
+	if !dd.Owner().Equal(opp.sender) {
+		return operation.NewBaseReasonError("sender not matched with Owner in document, %v", opp.sender)
+	}
+
+	opp.dd = dd
+
+	return nil
+}
+
+func (opp *TransferDocumentsItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+
+	sts := make([]state.State, 1)
+
+	dd, err := DocumentDataWithOwner(opp.dd, opp.item.Receiver())
+	if err != nil {
+		return nil, err
+	}
+
+	// set document state with new owner
+	if dst, err := SetStateDocumentDataValue(opp.nds, dd); err != nil {
+		return nil, err
+	} else {
+		sts[0] = dst
+	}
+
+	return sts, nil
+}
+
+func (opp *TransferDocumentsItemProcessor) Close() error {
+	opp.cp = nil
+	opp.h = nil
+	opp.sender = nil
+	opp.item = nil
+	opp.dd = nil
+	opp.nds = nil
+
+	TransferDocumentsItemProcessorPool.Put(opp)
+
+	return nil
+}
+
+type TransferDocumentsProcessor struct {
+	cp *currency.CurrencyPool
+	TransferDocuments
+	dinv     DocumentInventory
+	ndinvs   state.State
+	rdinv    map[string]DocumentInventory                 // receiver document inventories
+	nrdinvs  map[string]state.State                       // receiver document inventory states
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	ns       []*TransferDocumentsItemProcessor            // ItemProcessor
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewTransferDocumentsProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(TransferDocuments)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not TransferDocuments, %T", op)
+		}
+
+		opp := TransferDocumentsProcessorPool.Get().(*TransferDocumentsProcessor)
+
+		opp.cp = cp
+		opp.TransferDocuments = i
+		opp.dinv = DocumentInventory{}
+		opp.ndinvs = nil
+		opp.rdinv = nil
+		opp.nrdinvs = nil
+		opp.sb = nil
+		opp.ns = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferDocumentsProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(TransferDocumentsFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// check existence of document inventory state with sender address
+	switch st, found, err := getState(StateKeyDocuments(fact.sender)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, operation.NewBaseReasonError("sender has no document inventory, %v", fact.sender)
+	default:
+		dinv, err := StateDocumentsValue(st)
+		if err != nil {
+			return nil, err
+		}
+		opp.dinv = dinv
+		opp.ndinvs = st
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	// prepare item processor for each items
+	ns := make([]*TransferDocumentsItemProcessor, len(fact.items))
+	for i := range fact.items {
+		if !opp.dinv.Exists(fact.items[i].DocumentId()) {
+			return nil, operation.NewBaseReasonError(
+				"document not found in sender's document inventory, %q", fact.items[i].DocumentId())
+		}
+
+		c := TransferDocumentsItemProcessorPool.Get().(*TransferDocumentsItemProcessor)
+		c.cp = opp.cp
+		c.h = opp.Hash()
+		c.sender = fact.sender
+		c.item = fact.items[i]
+
+		if err := c.PreProcess(getState, setState); err != nil {
+			return nil, err
+		}
+
+		ns[i] = c
+	}
+
+	opp.ns = ns
+
+	// NOTE the document inventories of sender and receivers are not changed by
+	// the other operations in the same proposal; see
+	// OperationProcessor.checkInventoryDuplication.
+	if err := opp.loadReceiverInventories(getState); err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *TransferDocumentsProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	// get fact
+	fact := opp.Fact().(TransferDocumentsFact)
+
+	var sts []state.State // nolint:prealloc
+
+	// append document data state and move doc info from sender to receiver document inventory
+	for i := range opp.ns {
+		if s, err := opp.ns[i].Process(getState, setState); err != nil {
+			return operation.NewBaseReasonError("failed to process transfer document item: %w", err)
+		} else {
+			sts = append(sts, s...)
+		}
+
+		info := opp.ns[i].dd.Info()
+		if err := opp.dinv.Remove(info); err != nil {
+			return err
+		}
+
+		receiver := opp.ns[i].item.Receiver().String()
+		rdinv := opp.rdinv[receiver]
+		if err := rdinv.Append(info); err != nil {
+			return err
+		}
+		opp.rdinv[receiver] = rdinv
+	}
+
+	opp.dinv.Sort(true)
+
+	// prepare document inventory states and append them
+	if dinvs, err := SetStateDocumentsValue(opp.ndinvs, opp.dinv); err != nil {
+		return err
+	} else {
+		sts = append(sts, dinvs)
+	}
+
+	receivers := make([]string, 0, len(opp.rdinv))
+	for k := range opp.rdinv {
+		receivers = append(receivers, k)
+	}
+	sort.Strings(receivers)
+
+	for i := range receivers {
+		k := receivers[i]
+		rdinv := opp.rdinv[k]
+		rdinv.Sort(true)
+
+		if dinvs, err := SetStateDocumentsValue(opp.nrdinvs[k], rdinv); err != nil {
+			return err
+		} else {
+			sts = append(sts, dinvs)
+		}
+	}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *TransferDocumentsProcessor) loadReceiverInventories(
+	getState func(key string) (state.State, bool, error),
+) error {
+	opp.rdinv = map[string]DocumentInventory{}
+	opp.nrdinvs = map[string]state.State{}
+
+	for i := range opp.ns {
+		receiver := opp.ns[i].item.Receiver()
+		if _, found := opp.nrdinvs[receiver.String()]; found {
+			continue
+		}
+
+		switch st, found, err := getState(StateKeyDocuments(receiver)); {
+		case err != nil:
+			return err
+		case !found:
+			opp.rdinv[receiver.String()] = NewDocumentInventory(nil)
+			opp.nrdinvs[receiver.String()] = st
+		default:
+			dinv, err := StateDocumentsValue(st)
+			if err != nil {
+				return err
+			}
+			opp.rdinv[receiver.String()] = dinv
+			opp.nrdinvs[receiver.String()] = st
+		}
+	}
+
+	return nil
+}
+
+func (opp *TransferDocumentsProcessor) Close() error {
+	for i := range opp.ns {
+		_ = opp.ns[i].Close()
+	}
+
+	opp.cp = nil
+	opp.TransferDocuments = TransferDocuments{}
+	opp.dinv = DocumentInventory{}
+	opp.ndinvs = nil
+	opp.rdinv = nil
+	opp.nrdinvs = nil
+	opp.sb = nil
+	opp.ns = nil
+	opp.required = nil
+
+	TransferDocumentsProcessorPool.Put(opp)
+
+	return nil
+}
+func (opp *TransferDocumentsProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(TransferDocumentsFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
@@ -0,0 +1,175 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransferDocumentsProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testTransferDocumentsProcessor) newTransferDocuments(
+	sender testAccount, receiver base.Address, documentids ...string,
+) TransferDocuments {
+	items := make([]TransferDocumentsItem, len(documentids))
+	for i := range documentids {
+		items[i] = NewTransferDocumentsItemImpl(documentids[i], receiver, t.cid)
+	}
+
+	fact := NewTransferDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewTransferDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testTransferDocumentsProcessor) newCreateDocuments(sender testAccount, docs ...DocumentData) CreateDocuments {
+	items := make([]CreateDocumentsItem, len(docs))
+	for i := range docs {
+		items[i] = NewCreateDocumentsItemImpl(docs[i], t.cid)
+	}
+
+	fact := NewCreateDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewCreateDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testTransferDocumentsProcessor) TestTransfer() {
+	sender, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+
+	doc := t.newBSDocData(sender.Address(), "1sdi")
+
+	pool, opr := t.statepool(sts0, sts1, t.newDocumentStates(sender.Address(), doc))
+
+	errs := t.process(opr, t.newTransferDocuments(sender, receiver.Address(), doc.DocumentId()))
+	t.NoError(errs[0])
+
+	t.True(t.updatedDocument(pool, doc.DocumentId()).Owner().Equal(receiver.Address()))
+	t.False(t.updatedInventory(pool, sender.Address()).Exists(doc.DocumentId()))
+	t.True(t.updatedInventory(pool, receiver.Address()).Exists(doc.DocumentId()))
+}
+
+func (t *testTransferDocumentsProcessor) TestSameReceiverInSameBlock() {
+	sender0, sts0 := t.newAccount()
+	sender1, sts1 := t.newAccount()
+	receiver, sts2 := t.newAccount()
+
+	doc0 := t.newBSDocData(sender0.Address(), "1sdi")
+	doc1 := t.newBSDocData(sender1.Address(), "2sdi")
+
+	pool, opr := t.statepool(
+		sts0, sts1, sts2,
+		t.newDocumentStates(sender0.Address(), doc0),
+		t.newDocumentStates(sender1.Address(), doc1),
+	)
+
+	errs := t.process(opr,
+		t.newTransferDocuments(sender0, receiver.Address(), doc0.DocumentId()),
+		t.newTransferDocuments(sender1, receiver.Address(), doc1.DocumentId()),
+	)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document inventory")
+
+	dinv := t.updatedInventory(pool, receiver.Address())
+	t.True(dinv.Exists(doc0.DocumentId()))
+	t.False(dinv.Exists(doc1.DocumentId()))
+
+	_, found := t.updated(pool, StateKeyDocumentData(doc1.DocumentId()))
+	t.False(found)
+}
+
+func (t *testTransferDocumentsProcessor) TestTransferAndCreateOfReceiverInSameBlock() {
+	sender, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+
+	doc := t.newBSDocData(sender.Address(), "1sdi")
+	created := t.newBSDocData(receiver.Address(), "2sdi")
+
+	pool, opr := t.statepool(sts0, sts1, t.newDocumentStates(sender.Address(), doc))
+
+	errs := t.process(opr,
+		t.newTransferDocuments(sender, receiver.Address(), doc.DocumentId()),
+		t.newCreateDocuments(receiver, created),
+	)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document inventory")
+
+	dinv := t.updatedInventory(pool, receiver.Address())
+	t.True(dinv.Exists(doc.DocumentId()))
+	t.False(dinv.Exists(created.DocumentId()))
+}
+
+func (t *testTransferDocumentsProcessor) TestSignTransferredDocument() {
+	sender, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+	signer, sts2 := t.newAccount()
+
+	doc := t.newBSDocData(sender.Address(), "1sdi", signer.Address())
+
+	pool, opr := t.statepool(sts0, sts1, sts2, t.newDocumentStates(sender.Address(), doc))
+
+	errs := t.process(opr, t.newTransferDocuments(sender, receiver.Address(), doc.DocumentId()))
+	t.NoError(errs[0])
+
+	newSignDocuments := func(owner base.Address) SignDocuments {
+		items := []SignDocumentItem{NewSignDocumentsItemSingleFile(doc.DocumentId(), owner, t.cid)}
+		fact := NewSignDocumentsFact(util.UUID().Bytes(), signer.Address(), items)
+
+		op, err := NewSignDocuments(fact, t.newFactSigns(fact, signer.Privs()...), "")
+		t.NoError(err)
+
+		return op
+	}
+
+	// signing by the previous owner is rejected
+	_, opr = t.nextStatepool(pool, sts0, sts1, sts2)
+	errs = t.process(opr, newSignDocuments(sender.Address()))
+	t.Error(errs[0])
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, sts2)
+	errs = t.process(opr, newSignDocuments(receiver.Address()))
+	t.NoError(errs[0])
+
+	signed := t.updatedDocument(pool, doc.DocumentId()).(BSDocData)
+	t.True(signed.Owner().Equal(receiver.Address()))
+	t.True(signed.Signers()[0].Signed())
+}
+
+func (t *testTransferDocumentsProcessor) TestTransferUnregisteredDocType() {
+	sender, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+
+	doc := NewGeneralDocData(
+		NewGeneralDocInfo("1abc", hint.Type("showme-abc")),
+		sender.Address(),
+		map[string]string{"title": "title"},
+	)
+
+	// the document id is valid without the registry, but the short type should
+	// be in the registry state
+	op := t.newTransferDocuments(sender, receiver.Address(), doc.DocumentId())
+
+	_, opr := t.statepool(sts0, sts1, t.newDocumentStates(sender.Address(), doc))
+
+	errs := t.process(opr, op)
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), `short type of document id not registered, "1abc"`)
+}
+
+func TestTransferDocumentsProcessor(t *testing.T) {
+	suite.Run(t, new(testTransferDocumentsProcessor))
+}
@@ -0,0 +1,162 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferGoldFactType   = hint.Type("mitum-transfer-gold-operation-fact")
+	TransferGoldFactHint   = hint.NewHint(TransferGoldFactType, "v0.0.1")
+	TransferGoldFactHinter = TransferGoldFact{BaseHinter: hint.NewBaseHinter(TransferGoldFactHint)}
+	TransferGoldType       = hint.Type("mitum-transfer-gold-operation")
+	TransferGoldHint       = hint.NewHint(TransferGoldType, "v0.0.1")
+	TransferGoldHinter     = TransferGold{BaseOperation: operationHinter(TransferGoldHint)}
+)
+
+var MaxTransferGoldItems uint = 10
+
+type TransferGoldItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Receiver() string
+	Amount() uint
+	Currency() currency.CurrencyID
+	Rebuild() TransferGoldItem
+}
+
+type TransferGoldFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []TransferGoldItem
+}
+
+func NewTransferGoldFact(token []byte, sender base.Address, items []TransferGoldItem) TransferGoldFact {
+	fact := TransferGoldFact{
+		BaseHinter: hint.NewBaseHinter(TransferGoldFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferGoldFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferGoldFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferGoldFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact TransferGoldFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for TransferGoldFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxTransferGoldItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxTransferGoldItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() && fact.items[j].Receiver() == it.Receiver() {
+				return errors.Errorf("duplicated transfer found, %q to %q", it.DocumentId(), it.Receiver())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact TransferGoldFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferGoldFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferGoldFact) Items() []TransferGoldItem {
+	return fact.items
+}
+
+func (fact TransferGoldFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact TransferGoldFact) Rebuild() TransferGoldFact {
+	items := make([]TransferGoldItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type TransferGold struct {
+	currency.BaseOperation
+}
+
+func NewTransferGold(fact TransferGoldFact, fs []base.FactSign, memo string) (TransferGold, error) {
+	bo, err := currency.NewBaseOperationFromFact(TransferGoldHint, fact, fs, memo)
+	if err != nil {
+		return TransferGold{}, err
+	} else {
+
+		return TransferGold{BaseOperation: bo}, nil
+	}
+}
@@ -0,0 +1,96 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	TransferGoldItemImplType   = hint.Type("mitum-transfer-gold-item")
+	TransferGoldItemImplHint   = hint.NewHint(TransferGoldItemImplType, "v0.0.1")
+	TransferGoldItemImplHinter = TransferGoldItemImpl{BaseHinter: hint.NewBaseHinter(TransferGoldItemImplHint)}
+)
+
+// TransferGoldItemImpl moves gold from the blockcity user document to the
+// other user document.
+type TransferGoldItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	receiver   string // document id of receiver user document
+	amount     uint
+	cid        currency.CurrencyID
+}
+
+func NewTransferGoldItemImpl(
+	documentid string,
+	receiver string,
+	amount uint,
+	cid currency.CurrencyID) TransferGoldItemImpl {
+
+	return TransferGoldItemImpl{
+		BaseHinter: hint.NewBaseHinter(TransferGoldItemImplHint),
+		documentid: documentid,
+		receiver:   receiver,
+		amount:     amount,
+		cid:        cid,
+	}
+}
+
+func (it TransferGoldItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		[]byte(it.receiver),
+		util.UintToBytes(it.amount),
+		it.cid.Bytes(),
+	)
+}
+
+func (it TransferGoldItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid TransferGoldItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid TransferGoldItem: empty documentid")
+	}
+
+	if len(it.receiver) < 1 {
+		return isvalid.InvalidError.Errorf("invalid TransferGoldItem: empty receiver")
+	}
+
+	if it.documentid == it.receiver {
+		return isvalid.InvalidError.Errorf("invalid TransferGoldItem: receiver same with documentid, %q", it.receiver)
+	}
+
+	if it.amount < 1 {
+		return isvalid.InvalidError.Errorf("invalid TransferGoldItem: amount should be over zero")
+	}
+
+	return nil
+}
+
+func (it TransferGoldItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it TransferGoldItemImpl) Receiver() string {
+	return it.receiver
+}
+
+func (it TransferGoldItemImpl) Amount() uint {
+	return it.amount
+}
+
+func (it TransferGoldItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it TransferGoldItemImpl) Rebuild() TransferGoldItem {
+	return it
+}
@@ -0,0 +1,34 @@
+package document // nolint:dupl
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it TransferGoldItemImpl) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"documentid": it.documentid,
+				"receiver":   it.receiver,
+				"amount":     it.amount,
+				"currency":   it.cid,
+			}),
+	)
+}
+
+type TransferGoldItemImplBSONUnpacker struct {
+	DI string `bson:"documentid"`
+	RC string `bson:"receiver"`
+	AM uint   `bson:"amount"`
+	CI string `bson:"currency"`
+}
+
+func (it *TransferGoldItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u TransferGoldItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.RC, u.AM, u.CI)
+}
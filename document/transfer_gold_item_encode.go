@@ -0,0 +1,21 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *TransferGoldItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	rc string,
+	am uint,
+	cid string,
+) error {
+	it.documentid = di
+	it.receiver = rc
+	it.amount = am
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
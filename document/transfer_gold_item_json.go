@@ -0,0 +1,40 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type TransferGoldItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	RC string              `json:"receiver"`
+	AM uint                `json:"amount"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it TransferGoldItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransferGoldItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		RC:         it.receiver,
+		AM:         it.amount,
+		CI:         it.cid,
+	})
+}
+
+type TransferGoldItemImplJSONUnpacker struct {
+	DI string `json:"documentid"`
+	RC string `json:"receiver"`
+	AM uint   `json:"amount"`
+	CI string `json:"currency"`
+}
+
+func (it *TransferGoldItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var u TransferGoldItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.RC, u.AM, u.CI)
+}
@@ -0,0 +1,159 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var TransferGoldProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferGoldProcessor)
+	},
+}
+
+func (op TransferGold) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type TransferGoldProcessor struct {
+	cp *currency.CurrencyPool
+	TransferGold
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewTransferGoldProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(TransferGold)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not TransferGold, %T", op)
+		}
+
+		opp := TransferGoldProcessorPool.Get().(*TransferGoldProcessor)
+
+		opp.cp = cp
+		opp.TransferGold = i
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferGoldProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(TransferGoldFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if _, err := opp.transferGold(getState); err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *TransferGoldProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(TransferGoldFact)
+
+	sts, err := opp.transferGold(getState)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process transfer gold: %w", err)
+	}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// transferGold returns the user document states, which gold is moved from the
+// documents of sender to the receiver documents; the total gold is kept.
+func (opp *TransferGoldProcessor) transferGold(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(TransferGoldFact)
+
+	gl := newGoldLedger()
+	for i := range fact.items {
+		it := fact.items[i]
+
+		doc, err := gl.load(fact.sender, it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		ndoc, err := doc.subGold(it.Amount())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+		gl.set(ndoc)
+
+		rdoc, err := gl.load(nil, it.Receiver(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		nrdoc, err := rdoc.addGold(it.Amount())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+		gl.set(nrdoc)
+	}
+
+	return gl.states()
+}
+
+func (opp *TransferGoldProcessor) Close() error {
+	opp.cp = nil
+	opp.TransferGold = TransferGold{}
+	opp.sb = nil
+	opp.required = nil
+
+	TransferGoldProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *TransferGoldProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(TransferGoldFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
@@ -0,0 +1,102 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransferGoldProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testTransferGoldProcessor) newTransferGold(
+	sender testAccount, documentid, receiver string, amount uint,
+) TransferGold {
+	items := []TransferGoldItem{NewTransferGoldItemImpl(documentid, receiver, amount, t.cid)}
+	fact := NewTransferGoldFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewTransferGold(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testTransferGoldProcessor) newDepositGold(sender testAccount, documentid string, amount uint) DepositGold {
+	items := []DepositGoldItem{NewDepositGoldItemImpl(documentid, amount, t.cid)}
+	fact := NewDepositGoldFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewDepositGold(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+
+	return op
+}
+
+func (t *testTransferGoldProcessor) TestTransfer() {
+	sender, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+
+	from := t.newBCUserData(sender.Address(), "1cui", 100, 0)
+	to := t.newBCUserData(receiver.Address(), "2cui", 50, 0)
+
+	pool, opr := t.statepool(sts0, sts1,
+		t.newDocumentStates(sender.Address(), from), t.newDocumentStates(receiver.Address(), to))
+
+	errs := t.process(opr, t.newTransferGold(sender, from.DocumentId(), to.DocumentId(), 40))
+	t.NoError(errs[0])
+
+	t.Equal(uint(60), t.updatedDocument(pool, from.DocumentId()).(BCUserData).Gold())
+	t.Equal(uint(90), t.updatedDocument(pool, to.DocumentId()).(BCUserData).Gold())
+}
+
+func (t *testTransferGoldProcessor) TestTransferOverGold() {
+	sender, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+
+	from := t.newBCUserData(sender.Address(), "1cui", 10, 0)
+	to := t.newBCUserData(receiver.Address(), "2cui", 0, 0)
+
+	_, opr := t.statepool(sts0, sts1,
+		t.newDocumentStates(sender.Address(), from), t.newDocumentStates(receiver.Address(), to))
+
+	errs := t.process(opr, t.newTransferGold(sender, from.DocumentId(), to.DocumentId(), 40))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "not enough gold")
+}
+
+func (t *testTransferGoldProcessor) TestReceiverChangedInSameBlock() {
+	sender, sts0 := t.newAccount()
+	receiver, sts1 := t.newAccount()
+
+	from := t.newBCUserData(sender.Address(), "1cui", 100, 0)
+	to := t.newBCUserData(receiver.Address(), "2cui", 50, 0)
+
+	dsts0 := t.newDocumentStates(sender.Address(), from)
+	dsts1 := t.newDocumentStates(receiver.Address(), to)
+
+	pool, opr := t.statepool(sts0, sts1, dsts0, dsts1)
+
+	// the deposit of receiver is rejected instead of overwriting the gold
+	// received
+	deposit := t.newDepositGold(receiver, to.DocumentId(), 50)
+
+	errs := t.process(opr, t.newTransferGold(sender, from.DocumentId(), to.DocumentId(), 40), deposit)
+	t.NoError(errs[0])
+	t.Error(errs[1])
+	t.Contains(errs[1].Error(), "duplicated document")
+
+	pool, opr = t.nextStatepool(pool, sts0, sts1, dsts0, dsts1)
+
+	errs = t.process(opr, deposit)
+	t.NoError(errs[0])
+
+	received := t.updatedDocument(pool, to.DocumentId()).(BCUserData)
+	t.Equal(uint(40), received.Gold())
+	t.Equal(uint(50), received.BankGold())
+}
+
+func TestTransferGoldProcessor(t *testing.T) {
+	suite.Run(t, new(testTransferGoldProcessor))
+}
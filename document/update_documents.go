@@ -28,8 +28,12 @@ type UpdateDocumentsItem interface {
 	Bytes() []byte
 	DocumentId() string
 	// DocType() hint.Type
-	Doc() DocumentData
+	// Apply returns the document data, which replaces the stored one.
+	Apply(DocumentData) (DocumentData, error)
 	Currency() currency.CurrencyID
+	ExpectedHash() valuehash.Hash
+	ExpectedHeight() base.Height
+	HasExpectedHeight() bool
 	Rebuild() UpdateDocumentsItem
 }
 
@@ -101,7 +105,7 @@ func (fact UpdateDocumentsFact) IsValid(b []byte) error {
 		}
 
 		it := fact.items[i]
-		k := it.Doc().DocumentId()
+		k := it.DocumentId()
 		if _, found := docIdMap[k]; found {
 			return errors.Errorf("duplicated document user Id, %s", k)
 		}
@@ -2,9 +2,11 @@ package document
 
 import (
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 var (
@@ -13,11 +15,15 @@ var (
 	UpdateDocumentsItemImplHinter = UpdateDocumentsItemImpl{BaseHinter: hint.NewBaseHinter(UpdateDocumentsItemImplHint)}
 )
 
+// UpdateDocumentsItemImpl replaces the document data. With the expected hash
+// or height, the item is rejected when the stored document state is changed.
 type UpdateDocumentsItemImpl struct {
 	hint.BaseHinter
 	// doctype hint.Type
-	doc DocumentData
-	cid currency.CurrencyID
+	doc            DocumentData
+	cid            currency.CurrencyID
+	expectedHash   valuehash.Hash // expected hash of current document data
+	expectedHeight base.Height    // expected height of current document state
 }
 
 func NewUpdateDocumentsItemImpl(
@@ -27,8 +33,9 @@ func NewUpdateDocumentsItemImpl(
 	return UpdateDocumentsItemImpl{
 		BaseHinter: hint.NewBaseHinter(UpdateDocumentsItemImplHint),
 		// doctype:    doc.Info().docType,
-		doc: doc,
-		cid: cid,
+		doc:            doc,
+		cid:            cid,
+		expectedHeight: base.NilHeight,
 	}
 }
 
@@ -38,6 +45,14 @@ func (it UpdateDocumentsItemImpl) Bytes() []byte {
 	bs[0] = it.doc.Bytes()
 	bs[1] = it.cid.Bytes()
 
+	if it.expectedHash != nil {
+		bs = append(bs, it.expectedHash.Bytes())
+	}
+
+	if it.HasExpectedHeight() {
+		bs = append(bs, it.expectedHeight.Bytes())
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -52,6 +67,17 @@ func (it UpdateDocumentsItemImpl) IsValid([]byte) error {
 	); err != nil {
 		return isvalid.InvalidError.Errorf("invalid UpdateDocumentsItem: %w", err)
 	}
+
+	if it.expectedHash != nil {
+		if err := it.expectedHash.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid UpdateDocumentsItem: invalid expected hash: %w", err)
+		}
+	}
+
+	if it.expectedHeight < base.NilHeight {
+		return isvalid.InvalidError.Errorf("invalid UpdateDocumentsItem: invalid expected height, %v", it.expectedHeight)
+	}
+
 	return nil
 }
 
@@ -69,10 +95,43 @@ func (it UpdateDocumentsItemImpl) Doc() DocumentData {
 	return it.doc
 }
 
+// Apply returns the document data of item regardless of the stored one.
+func (it UpdateDocumentsItemImpl) Apply(DocumentData) (DocumentData, error) {
+	return it.doc, nil
+}
+
 func (it UpdateDocumentsItemImpl) Currency() currency.CurrencyID {
 	return it.cid
 }
 
+// WithExpectedHash sets the hash of document data, which should be stored
+// when the item is processed.
+func (it UpdateDocumentsItemImpl) WithExpectedHash(h valuehash.Hash) UpdateDocumentsItemImpl {
+	it.expectedHash = h
+
+	return it
+}
+
+// WithExpectedHeight sets the height of document state, which should be
+// stored when the item is processed.
+func (it UpdateDocumentsItemImpl) WithExpectedHeight(height base.Height) UpdateDocumentsItemImpl {
+	it.expectedHeight = height
+
+	return it
+}
+
+func (it UpdateDocumentsItemImpl) ExpectedHash() valuehash.Hash {
+	return it.expectedHash
+}
+
+func (it UpdateDocumentsItemImpl) ExpectedHeight() base.Height {
+	return it.expectedHeight
+}
+
+func (it UpdateDocumentsItemImpl) HasExpectedHeight() bool {
+	return it.expectedHeight > base.NilHeight
+}
+
 func (it UpdateDocumentsItemImpl) Rebuild() UpdateDocumentsItem {
 	return it
 }
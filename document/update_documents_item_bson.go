@@ -1,25 +1,36 @@
 package document // nolint:dupl
 
 import (
+	"github.com/spikeekips/mitum/base"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
 func (it UpdateDocumentsItemImpl) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
-			bson.M{
-				// "doctype":  it.doctype,
-				"doc":      it.doc,
-				"currency": it.cid,
-			}),
-	)
+	m := bson.M{
+		// "doctype":  it.doctype,
+		"doc":      it.doc,
+		"currency": it.cid,
+	}
+
+	if it.expectedHash != nil {
+		m["expected_hash"] = it.expectedHash
+	}
+
+	if it.HasExpectedHeight() {
+		m["expected_height"] = it.expectedHeight
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()), m))
 }
 
 type UpdateDocumentsItemImplBSONUnpacker struct {
 	// DT string   `bson:"doctype"`
-	DD bson.Raw `bson:"doc"`
-	CI string   `bson:"currency"`
+	DD bson.Raw        `bson:"doc"`
+	CI string          `bson:"currency"`
+	EH valuehash.Bytes `bson:"expected_hash,omitempty"`
+	EL *base.Height    `bson:"expected_height,omitempty"`
 }
 
 func (it *UpdateDocumentsItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -33,5 +44,7 @@ func (it *UpdateDocumentsItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) er
 		// ucd.DT,
 		ucd.DD,
 		ucd.CI,
+		ucd.EH,
+		ucd.EL,
 	)
 }
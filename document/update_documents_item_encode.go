@@ -3,7 +3,9 @@ package document
 import (
 	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 func (it *UpdateDocumentsItemImpl) unpack(
@@ -11,6 +13,8 @@ func (it *UpdateDocumentsItemImpl) unpack(
 	// sdt string,
 	bdd []byte,
 	scid string,
+	eh valuehash.Bytes,
+	el *base.Height,
 ) error {
 
 	// it.doctype = hint.Type(sdt)
@@ -26,5 +30,16 @@ func (it *UpdateDocumentsItemImpl) unpack(
 
 	it.cid = currency.CurrencyID(scid)
 
+	// NOTE item without expected hash or height is not checked
+	if len(eh) > 0 {
+		it.expectedHash = eh
+	}
+
+	if el != nil {
+		it.expectedHeight = *el
+	} else {
+		it.expectedHeight = base.NilHeight
+	}
+
 	return nil
 }
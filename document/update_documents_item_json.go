@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 
 	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 type UpdateDocumentsItemImplJSONPacker struct {
@@ -12,14 +14,23 @@ type UpdateDocumentsItemImplJSONPacker struct {
 	// DT hint.Type           `json:"doctype"`
 	DD DocumentData        `json:"doc"`
 	CI currency.CurrencyID `json:"currency"`
+	EH valuehash.Hash      `json:"expected_hash,omitempty"`
+	EL *base.Height        `json:"expected_height,omitempty"`
 }
 
 func (it UpdateDocumentsItemImpl) MarshalJSON() ([]byte, error) {
+	var el *base.Height
+	if it.HasExpectedHeight() {
+		el = &it.expectedHeight
+	}
+
 	return jsonenc.Marshal(UpdateDocumentsItemImplJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(it.Hint()),
 		// DT:         it.doctype,
 		DD: it.doc,
 		CI: it.cid,
+		EH: it.expectedHash,
+		EL: el,
 	})
 }
 
@@ -27,6 +38,8 @@ type UpdateDocumentsItemImplJSONUnpacker struct {
 	// DT string          `json:"doctype"`
 	DD json.RawMessage `json:"doc"`
 	CI string          `json:"currency"`
+	EH valuehash.Bytes `json:"expected_hash,omitempty"`
+	EL *base.Height    `json:"expected_height,omitempty"`
 }
 
 func (it *UpdateDocumentsItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,5 +53,7 @@ func (it *UpdateDocumentsItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) er
 		// ucd.DT,
 		ucd.DD,
 		ucd.CI,
+		ucd.EH,
+		ucd.EL,
 	)
 }
@@ -0,0 +1,143 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	PatchDocumentsItemImplType   = hint.Type("mitum-update-documents-patch-item")
+	PatchDocumentsItemImplHint   = hint.NewHint(PatchDocumentsItemImplType, "v0.0.1")
+	PatchDocumentsItemImplHinter = PatchDocumentsItemImpl{BaseHinter: hint.NewBaseHinter(PatchDocumentsItemImplHint)}
+)
+
+var MaxDocumentPatches = 10
+
+// PatchDocumentsItemImpl updates the fields of the stored document data by the
+// patches instead of replacing the whole document data; the patched document
+// data is checked like the replaced one.
+type PatchDocumentsItemImpl struct {
+	hint.BaseHinter
+	documentid     string
+	patches        []DocumentPatch
+	cid            currency.CurrencyID
+	expectedHash   valuehash.Hash // expected hash of current document data
+	expectedHeight base.Height    // expected height of current document state
+}
+
+func NewPatchDocumentsItemImpl(
+	documentid string,
+	patches []DocumentPatch,
+	cid currency.CurrencyID,
+) PatchDocumentsItemImpl {
+	return PatchDocumentsItemImpl{
+		BaseHinter:     hint.NewBaseHinter(PatchDocumentsItemImplHint),
+		documentid:     documentid,
+		patches:        patches,
+		cid:            cid,
+		expectedHeight: base.NilHeight,
+	}
+}
+
+func (it PatchDocumentsItemImpl) Bytes() []byte {
+	bs := make([][]byte, len(it.patches)+2)
+	bs[0] = []byte(it.documentid)
+	bs[1] = it.cid.Bytes()
+
+	for i := range it.patches {
+		bs[i+2] = it.patches[i].Bytes()
+	}
+
+	if it.expectedHash != nil {
+		bs = append(bs, it.expectedHash.Bytes())
+	}
+
+	if it.HasExpectedHeight() {
+		bs = append(bs, it.expectedHeight.Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it PatchDocumentsItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, it.BaseHinter, it.cid); err != nil {
+		return isvalid.InvalidError.Errorf("invalid PatchDocumentsItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid PatchDocumentsItem: empty documentid")
+	}
+
+	if n := len(it.patches); n < 1 {
+		return isvalid.InvalidError.Errorf("invalid PatchDocumentsItem: empty patches")
+	} else if n > MaxDocumentPatches {
+		return isvalid.InvalidError.Errorf("invalid PatchDocumentsItem: patches, %d over max, %d", n, MaxDocumentPatches)
+	}
+
+	for i := range it.patches {
+		if err := it.patches[i].IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid PatchDocumentsItem: %w", err)
+		}
+	}
+
+	if it.expectedHash != nil {
+		if err := it.expectedHash.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid PatchDocumentsItem: invalid expected hash: %w", err)
+		}
+	}
+
+	if it.expectedHeight < base.NilHeight {
+		return isvalid.InvalidError.Errorf("invalid PatchDocumentsItem: invalid expected height, %v", it.expectedHeight)
+	}
+
+	return nil
+}
+
+func (it PatchDocumentsItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it PatchDocumentsItemImpl) Patches() []DocumentPatch {
+	return it.patches
+}
+
+// Apply returns the stored document data patched by the patches in order.
+func (it PatchDocumentsItemImpl) Apply(doc DocumentData) (DocumentData, error) {
+	return ApplyDocumentPatches(doc, it.patches)
+}
+
+func (it PatchDocumentsItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it PatchDocumentsItemImpl) WithExpectedHash(h valuehash.Hash) PatchDocumentsItemImpl {
+	it.expectedHash = h
+
+	return it
+}
+
+func (it PatchDocumentsItemImpl) WithExpectedHeight(height base.Height) PatchDocumentsItemImpl {
+	it.expectedHeight = height
+
+	return it
+}
+
+func (it PatchDocumentsItemImpl) ExpectedHash() valuehash.Hash {
+	return it.expectedHash
+}
+
+func (it PatchDocumentsItemImpl) ExpectedHeight() base.Height {
+	return it.expectedHeight
+}
+
+func (it PatchDocumentsItemImpl) HasExpectedHeight() bool {
+	return it.expectedHeight > base.NilHeight
+}
+
+func (it PatchDocumentsItemImpl) Rebuild() UpdateDocumentsItem {
+	return it
+}
@@ -0,0 +1,43 @@
+package document // nolint:dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it PatchDocumentsItemImpl) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"documentid": it.documentid,
+		"patches":    it.patches,
+		"currency":   it.cid,
+	}
+
+	if it.expectedHash != nil {
+		m["expected_hash"] = it.expectedHash
+	}
+
+	if it.HasExpectedHeight() {
+		m["expected_height"] = it.expectedHeight
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()), m))
+}
+
+type PatchDocumentsItemImplBSONUnpacker struct {
+	DI string          `bson:"documentid"`
+	PS bson.Raw        `bson:"patches"`
+	CI string          `bson:"currency"`
+	EH valuehash.Bytes `bson:"expected_hash,omitempty"`
+	EL *base.Height    `bson:"expected_height,omitempty"`
+}
+
+func (it *PatchDocumentsItemImpl) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upd PatchDocumentsItemImplBSONUnpacker
+	if err := bson.Unmarshal(b, &upd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, upd.DI, upd.PS, upd.CI, upd.EH, upd.EL)
+}
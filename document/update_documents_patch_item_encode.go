@@ -0,0 +1,50 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (it *PatchDocumentsItemImpl) unpack(
+	enc encoder.Encoder,
+	documentid string,
+	bps []byte,
+	scid string,
+	eh valuehash.Bytes,
+	el *base.Height,
+) error {
+	it.documentid = documentid
+
+	hps, err := enc.DecodeSlice(bps)
+	if err != nil {
+		return err
+	}
+
+	ps := make([]DocumentPatch, len(hps))
+	for i := range hps {
+		j, ok := hps[i].(DocumentPatch)
+		if !ok {
+			return util.WrongTypeError.Errorf("expected DocumentPatch, not %T", hps[i])
+		}
+
+		ps[i] = j
+	}
+	it.patches = ps
+
+	it.cid = currency.CurrencyID(scid)
+
+	if len(eh) > 0 {
+		it.expectedHash = eh
+	}
+
+	if el != nil {
+		it.expectedHeight = *el
+	} else {
+		it.expectedHeight = base.NilHeight
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type PatchDocumentsItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	PS []DocumentPatch     `json:"patches"`
+	CI currency.CurrencyID `json:"currency"`
+	EH valuehash.Hash      `json:"expected_hash,omitempty"`
+	EL *base.Height        `json:"expected_height,omitempty"`
+}
+
+func (it PatchDocumentsItemImpl) MarshalJSON() ([]byte, error) {
+	var el *base.Height
+	if it.HasExpectedHeight() {
+		el = &it.expectedHeight
+	}
+
+	return jsonenc.Marshal(PatchDocumentsItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		PS:         it.patches,
+		CI:         it.cid,
+		EH:         it.expectedHash,
+		EL:         el,
+	})
+}
+
+type PatchDocumentsItemImplJSONUnpacker struct {
+	DI string          `json:"documentid"`
+	PS json.RawMessage `json:"patches"`
+	CI string          `json:"currency"`
+	EH valuehash.Bytes `json:"expected_hash,omitempty"`
+	EL *base.Height    `json:"expected_height,omitempty"`
+}
+
+func (it *PatchDocumentsItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upd PatchDocumentsItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &upd); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, upd.DI, upd.PS, upd.CI, upd.EH, upd.EL)
+}
@@ -33,6 +33,7 @@ func (op UpdateDocuments) Process(
 type UpdateDocumentsItemProcessor struct {
 	cp     *currency.CurrencyPool
 	h      valuehash.Hash
+	height base.Height // height of block in processing
 	sender base.Address
 	item   UpdateDocumentsItem
 	nds    state.State       // new document data state (key = document nickname)
@@ -50,19 +51,34 @@ func (opp *UpdateDocumentsItemProcessor) PreProcess(
 		return operation.NewBaseReasonError(err.Error())
 	}
 
+	// check existence of document state with documentid
+	switch st, found, err := getState(StateKeyDocumentData(opp.item.DocumentId())); {
+	case err != nil:
+		return err
+	case !found:
+		return operation.NewBaseReasonError("document not registered with documentid, %q", opp.item.DocumentId())
+	default:
+		opp.nds = st
+	}
+
+	dd, err := StateDocumentDataValue(opp.nds)
+	if err != nil {
+		return err
+	}
+
 	// check existence of owner account
-	if _, found, err := getState(currency.StateKeyAccount(opp.item.Doc().Owner())); err != nil {
+	if _, found, err := getState(currency.StateKeyAccount(dd.Owner())); err != nil {
 		return err
 	} else if !found {
-		return operation.NewBaseReasonError("owner does not exist, %q", opp.item.Doc().Owner())
+		return operation.NewBaseReasonError("owner does not exist, %q", dd.Owner())
 	}
 
 	// check existence of document inventory state with owner address
-	switch st, found, err := getState(StateKeyDocuments(opp.item.Doc().Owner())); {
+	switch st, found, err := getState(StateKeyDocuments(dd.Owner())); {
 	case err != nil:
 		return err
 	case !found:
-		return operation.NewBaseReasonError("Owner has no document inventory, %v", opp.item.Doc().Owner())
+		return operation.NewBaseReasonError("Owner has no document inventory, %v", dd.Owner())
 
 		// get document inventory of owner
 	default:
@@ -74,55 +90,128 @@ func (opp *UpdateDocumentsItemProcessor) PreProcess(
 		opp.ndinvs = st
 	}
 
-	// check existence of document state with documentid
-	switch st, found, err := getState(StateKeyDocumentData(opp.item.DocumentId())); {
-	case err != nil:
+	nst, err := opp.updateDocument(opp.nds, getState)
+	if err != nil {
 		return err
-	case !found:
-		return operation.NewBaseReasonError("document not registered with documentid, %q", opp.item.DocumentId())
-	default:
-		opp.nds = st
 	}
 
-	dd, err := StateDocumentDataValue(opp.nds)
+	opp.nds = nst
+
+	return nil
+}
+
+// Process sets the document state updated in PreProcess; the other operations
+// on the same document are not allowed in the same proposal, see
+// OperationProcessor.checkDocumentDuplication.
+func (opp *UpdateDocumentsItemProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) ([]state.State, error) {
+	return []state.State{opp.nds}, nil
+}
+
+// updateDocument returns the document state updated by item after checking the
+// stored document data.
+func (opp *UpdateDocumentsItemProcessor) updateDocument(
+	st state.State,
+	getState func(key string) (state.State, bool, error),
+) (state.State, error) {
+	dd, err := StateDocumentDataValue(st)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if IsArchivedDocumentData(dd) {
+		return nil, operation.NewBaseReasonError("document already removed, %q", opp.item.DocumentId())
+	}
+
+	doc, err := opp.item.Apply(dd)
+	if err != nil {
+		return nil, operation.NewBaseReasonError("failed to update document, %q: %w", opp.item.DocumentId(), err)
+	}
+
+	if err := doc.IsValid(nil); err != nil {
+		return nil, operation.NewBaseReasonError("invalid updated document, %q: %w", opp.item.DocumentId(), err)
+	}
+
+	// the patched v0.0.1 document keeps its hint; the new document data should
+	// be of the new hint
+	if _, ok := opp.item.(UpdateDocumentsItemImpl); ok && IsLegacyDocumentData(doc) {
+		return nil, operation.NewBaseReasonError("v0.0.1 document data not accepted, %q", opp.item.DocumentId())
 	}
 
-	if !dd.Owner().Equal(opp.item.Doc().Owner()) {
-		return operation.NewBaseReasonError("item's Owner not matched with Owner in document, %v", opp.item.Doc().Owner())
+	if doc.DocumentId() != dd.DocumentId() {
+		return nil, operation.NewBaseReasonError("item's documentid not matched with document, %q", doc.DocumentId())
 	}
 
-	/*
-		if dd.DocumentType() != opp.item.DocType() {
-			return operation.NewBaseReasonError("item's Document type not matched with document type in document, %v", opp.item.DocType())
+	if !dd.Owner().Equal(doc.Owner()) {
+		return nil, operation.NewBaseReasonError("item's Owner not matched with Owner in document, %v", doc.Owner())
+	}
+
+	if err := checkDocumentDataPermission(opp.sender, doc, getState); err != nil {
+		return nil, err
+	}
+
+	// NOTE the other accounts than owner should be delegated by owner
+	if !opp.sender.Equal(dd.Owner()) {
+		if err := checkDelegation(dd.Owner(), opp.sender, dd, opp.height, getState); err != nil {
+			return nil, err
 		}
-	*/
+	}
 
-	// update document data state
-	st, err := SetStateDocumentDataValue(opp.nds, opp.item.Doc())
-	if err != nil {
-		return err
+	if h := opp.item.ExpectedHash(); h != nil && !h.Equal(dd.Hash()) {
+		return nil, operation.NewBaseReasonError(
+			"document hash not matched with expected, %q; expected=%v current=%v", opp.item.DocumentId(), h, dd.Hash())
 	}
-	opp.nds = st
 
-	return nil
-}
+	if opp.item.HasExpectedHeight() && opp.item.ExpectedHeight() != st.Height() {
+		return nil, operation.NewBaseReasonError(
+			"document height not matched with expected, %q; expected=%v current=%v",
+			opp.item.DocumentId(), opp.item.ExpectedHeight(), st.Height())
+	}
 
-func (opp *UpdateDocumentsItemProcessor) Process(
-	_ func(key string) (state.State, bool, error),
-	_ func(valuehash.Hash, ...state.State) error,
-) ([]state.State, error) {
+	if err := checkUpdateDocumentData(dd, doc, opp.height); err != nil {
+		return nil, err
+	}
 
-	sts := make([]state.State, 1)
-	sts[0] = opp.nds
+	// the statistics out of the stat policy are kept, unless they are changed
+	if t, ok := doc.(BCUserData); ok && !t.Statistics().Equal(dd.(BCUserData).Statistics()) {
+		if err := checkStatPolicy(t, getState); err != nil {
+			return nil, err
+		}
+	}
+
+	if t, ok := doc.(GeneralDocData); ok {
+		if err := checkGeneralDocData(t, getState); err != nil {
+			return nil, err
+		}
+	}
+
+	// the snapshot of weights is taken when the voting starts by the deadline
+	// and kept in the round
+	switch t := doc.(type) {
+	case BCVotingData:
+		snapshot := opp.height
+		if old, ok := dd.(BCVotingData); ok && old.HasDeadline() {
+			snapshot = old.Snapshot()
+		}
+		doc = t.withSnapshot(snapshot)
+	case BCLandData:
+		// the lease of land is changed only by the lease operations
+		if old, ok := dd.(BCLandData); ok {
+			t.leaseEnd = old.leaseEnd
+			doc = t
+		}
+	}
 
-	return sts, nil
+	// update document data state
+	return SetStateDocumentDataValue(st, doc)
 }
 
 func (opp *UpdateDocumentsItemProcessor) Close() error {
 	opp.cp = nil
 	opp.h = nil
+	opp.height = base.NilHeight
 	opp.sender = nil
 	opp.item = nil
 	opp.nds = nil
@@ -137,6 +226,7 @@ func (opp *UpdateDocumentsItemProcessor) Close() error {
 type UpdateDocumentsProcessor struct {
 	cp *currency.CurrencyPool
 	UpdateDocuments
+	height   base.Height                                  // height of block in processing
 	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
 	ns       []*UpdateDocumentsItemProcessor              // ItemProcessor
 	required map[currency.CurrencyID][2]currency.Big      // Fee
@@ -151,6 +241,7 @@ func NewUpdateDocumentsProcessor(cp *currency.CurrencyPool) currency.GetNewProce
 		return &UpdateDocumentsProcessor{
 			cp:              cp,
 			UpdateDocuments: i,
+			height:          base.NilHeight,
 			sb:              nil,
 			ns:              nil,
 			required:        nil,
@@ -158,6 +249,10 @@ func NewUpdateDocumentsProcessor(cp *currency.CurrencyPool) currency.GetNewProce
 	}
 }
 
+func (opp *UpdateDocumentsProcessor) setBlockHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *UpdateDocumentsProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	setState func(valuehash.Hash, ...state.State) error,
@@ -185,6 +280,7 @@ func (opp *UpdateDocumentsProcessor) PreProcess(
 		c := UpdateDocumentsItemProcessorPool.Get().(*UpdateDocumentsItemProcessor)
 		c.cp = opp.cp
 		c.h = opp.Hash()
+		c.height = opp.height
 		c.sender = fact.sender
 		c.item = fact.items[i]
 
@@ -258,37 +354,248 @@ func (opp *UpdateDocumentsProcessor) calculateItemsFee() (map[currency.CurrencyI
 }
 
 func CalculateUpdateDocumentItemsFee(cp *currency.CurrencyPool, items []UpdateDocumentsItem) (map[currency.CurrencyID][2]currency.Big, error) {
-	required := map[currency.CurrencyID][2]currency.Big{}
-
+	cis := make([]CurrencyItem, len(items))
 	for i := range items {
-		it := items[i]
+		cis[i] = items[i]
+	}
+
+	return CalculateCurrencyItemsFee(cp, cis)
+}
+
+// checkUpdateDocumentData checks that the new DocumentData does not change the
+// fields, which can not be updated in the type of DocumentData at the given
+// block height.
+func checkUpdateDocumentData(old, doc DocumentData, height base.Height) error {
+	if old.DocumentType() != doc.DocumentType() {
+		return operation.NewBaseReasonError(
+			"document type can not be changed, %q; %v != %v", old.DocumentId(), doc.DocumentType(), old.DocumentType())
+	}
+
+	switch t := old.(type) {
+	case BSDocData:
+		n, ok := doc.(BSDocData)
+		if !ok {
+			return operation.NewBaseReasonError("Document is not Blocksign Document, %T", doc)
+		}
+
+		return checkUpdateBSDocData(t, n)
+	case BCVotingData:
+		n, ok := doc.(BCVotingData)
+		if !ok {
+			return operation.NewBaseReasonError("Document is not Blockcity Voting Document, %T", doc)
+		}
+
+		return checkUpdateBCVotingData(t, n)
+	case BCLandData:
+		n, ok := doc.(BCLandData)
+		if !ok {
+			return operation.NewBaseReasonError("Document is not Blockcity Land Document, %T", doc)
+		}
+
+		return checkUpdateBCLandData(t, n, height)
+	case BCUserData:
+		n, ok := doc.(BCUserData)
+		if !ok {
+			return operation.NewBaseReasonError("Document is not Blockcity User Document, %T", doc)
+		}
 
-		rq := [2]currency.Big{currency.ZeroBig, currency.ZeroBig}
+		return checkUpdateBCUserData(t, n)
+	case BCHistoryData:
+		return nil
+	case GeneralDocData:
+		if _, ok := doc.(GeneralDocData); !ok {
+			return operation.NewBaseReasonError("Document is not general Document, %T", doc)
+		}
+
+		return nil
+	default:
+		return operation.NewBaseReasonError("unknown DocumentData type, %T", old)
+	}
+}
 
-		if k, found := required[it.Currency()]; found {
-			rq = k
+// checkUpdateBSDocData checks the update of blocksign document. The status of
+// signers can be changed only by SignDocuments; after any signer signed, file
+// hash, creator, signing policy, deadline, the order of signers and the signed
+// signers are frozen. The new signers can be added only after the existing
+// signers.
+func checkUpdateBSDocData(old, doc BSDocData) error {
+	olds := map[string]DocSign{}
+	for i := range old.Signers() {
+		olds[old.Signers()[i].Address().String()] = old.Signers()[i]
+	}
+
+	for i := range doc.Signers() {
+		ds := doc.Signers()[i]
+
+		o, found := olds[ds.Address().String()]
+		switch {
+		case !found && ds.Status() != DocSignStatusPending:
+			return operation.NewBaseReasonError("new signer should be pending, %v", ds.Address())
+		case found && ds.Status() != o.Status():
+			return operation.NewBaseReasonError("status of signer can not be updated, %v", ds.Address())
 		}
 
-		if cp == nil {
-			required[it.Currency()] = [2]currency.Big{rq[0], rq[1]}
+		delete(olds, ds.Address().String())
+	}
 
+	var signed bool
+	for i := range old.Signers() {
+		ds := old.Signers()[i]
+		if !ds.Signed() {
 			continue
 		}
+		signed = true
 
-		feeer, found := cp.Feeer(it.Currency())
-		if !found {
-			return nil, operation.NewBaseReasonError("unknown currency id found, %q", it.Currency())
+		if _, found := olds[ds.Address().String()]; found {
+			return operation.NewBaseReasonError("signed signer can not be removed, %v", ds.Address())
 		}
-		switch k, err := feeer.Fee(currency.ZeroBig); {
-		case err != nil:
-			return nil, err
-		case !k.OverZero():
-			required[it.Currency()] = [2]currency.Big{rq[0], rq[1]}
-		default:
-			required[it.Currency()] = [2]currency.Big{rq[0].Add(k), rq[1].Add(k)}
+	}
+
+	if !signed {
+		return nil
+	}
+
+	if old.FileHash() != doc.FileHash() {
+		return operation.NewBaseReasonError("file hash can not be updated after signed, %q", old.DocumentId())
+	}
+
+	if !old.Creator().Equal(doc.Creator()) {
+		return operation.NewBaseReasonError("creator can not be updated after signed, %q", old.DocumentId())
+	}
+
+	if old.SigningPolicy() != doc.SigningPolicy() {
+		return operation.NewBaseReasonError("signing policy can not be updated after signed, %q", old.DocumentId())
+	}
+
+	if old.Deadline() != doc.Deadline() {
+		return operation.NewBaseReasonError("deadline can not be updated after signed, %q", old.DocumentId())
+	}
+
+	if err := checkBSDocDataSignersOrder(old, doc); err != nil {
+		return err
+	}
+
+	for i := range doc.Signers() {
+		ds := doc.Signers()[i]
+		if !ds.Signed() {
+			continue
+		}
+
+		for j := range old.Signers() {
+			if old.Signers()[j].Address().Equal(ds.Address()) && !old.Signers()[j].Equal(ds) {
+				return operation.NewBaseReasonError("signed signer can not be updated, %v", ds.Address())
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkBSDocDataSignersOrder checks that the signers of old document keep
+// their order in the new document and the new signers follow them; in
+// sequential signing, the order decides who signs next.
+func checkBSDocDataSignersOrder(old, doc BSDocData) error {
+	olds := map[string]int{}
+	for i := range old.Signers() {
+		olds[old.Signers()[i].Address().String()] = i
+	}
+
+	last := -1
+	var added bool
+	for i := range doc.Signers() {
+		ds := doc.Signers()[i]
+
+		j, found := olds[ds.Address().String()]
+		switch {
+		case !found:
+			added = true
+
+			continue
+		case added:
+			return operation.NewBaseReasonError("new signer should be added after the existing signers, %q", old.DocumentId())
+		case j < last:
+			return operation.NewBaseReasonError("order of signers can not be updated after signed, %v", ds.Address())
+		}
+
+		last = j
+	}
+
+	return nil
+}
+
+// checkUpdateBCVotingData checks the update of blockcity voting document. The
+// counts of candidates can be changed only by CastVote and RevealVote; the
+// counts are kept and only the candidates without votes can be removed. The
+// round, the closed and the boss are changed only by CloseVoting, which closes
+// the round, elects the boss and opens the next round with the next deadline.
+// The deadlines and the voting weight can be set only before the voting
+// starts, that is, while the document has no deadline.
+func checkUpdateBCVotingData(old, doc BCVotingData) error {
+	if old.Round() != doc.Round() {
+		return operation.NewBaseReasonError("round can not be updated, %q", doc.DocumentId())
+	}
+
+	if old.Closed() != doc.Closed() {
+		return operation.NewBaseReasonError("closed of round can not be updated, %q", doc.DocumentId())
+	}
+
+	if old.Bossname() != doc.Bossname() || !old.Account().Equal(doc.Account()) {
+		return operation.NewBaseReasonError("boss can not be updated, %q", doc.DocumentId())
+	}
+
+	if old.HasDeadline() {
+		if old.Deadline() != doc.Deadline() || old.RevealDeadline() != doc.RevealDeadline() {
+			return operation.NewBaseReasonError("deadline can not be updated in the round, %q", doc.DocumentId())
 		}
 
+		if old.Weight() != doc.Weight() || old.WeightCurrency() != doc.WeightCurrency() {
+			return operation.NewBaseReasonError("voting weight can not be changed in the round, %q", doc.DocumentId())
+		}
 	}
 
-	return required, nil
+	for i := range doc.Candidates() {
+		vc := doc.Candidates()[i]
+
+		var count uint
+		if o, found := old.Candidate(vc.Address()); found {
+			count = o.Count()
+		}
+
+		if vc.Count() != count {
+			return operation.NewBaseReasonError("count of candidate can not be updated, %v", vc.Address())
+		}
+	}
+
+	for i := range old.Candidates() {
+		vc := old.Candidates()[i]
+		if _, found := doc.Candidate(vc.Address()); !found && vc.Count() > 0 {
+			return operation.NewBaseReasonError("voted candidate can not be removed, %v", vc.Address())
+		}
+	}
+
+	return nil
+}
+
+// checkUpdateBCUserData checks the update of blockcity user document. The gold
+// and the bank gold are moved only by the gold operations.
+func checkUpdateBCUserData(old, doc BCUserData) error {
+	if old.Gold() != doc.Gold() || old.BankGold() != doc.BankGold() {
+		return operation.NewBaseReasonError("gold of user document can not be updated, %q", doc.DocumentId())
+	}
+
+	return nil
+}
+
+// checkUpdateBCLandData checks the update of blockcity land document. While the
+// land is leased, the renter and the account of renter can not be updated.
+func checkUpdateBCLandData(old, doc BCLandData, height base.Height) error {
+	if !old.IsLeased(height) {
+		return nil
+	}
+
+	if old.Renter() != doc.Renter() || !old.Account().Equal(doc.Account()) {
+		return operation.NewBaseReasonError("renter of leased land can not be updated, %q", doc.DocumentId())
+	}
+
+	return nil
 }
@@ -0,0 +1,331 @@
+package document
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testUpdateDocumentsProcessor struct {
+	baseTestOperationProcessor
+}
+
+func (t *testUpdateDocumentsProcessor) newUpdateDocuments(sender testAccount, items ...UpdateDocumentsItem) UpdateDocuments {
+	fact := NewUpdateDocumentsFact(util.UUID().Bytes(), sender.Address(), items)
+
+	op, err := NewUpdateDocuments(fact, t.newFactSigns(fact, sender.Privs()...), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+// update processes UpdateDocuments of doc by sender over the given states and
+// returns the error of it.
+func (t *testUpdateDocumentsProcessor) update(sender testAccount, doc DocumentData, sts ...[]state.State) error {
+	_, opr := t.statepool(sts...)
+
+	return t.process(opr, t.newUpdateDocuments(sender, NewUpdateDocumentsItemImpl(doc, t.cid)))[0]
+}
+
+func (t *testUpdateDocumentsProcessor) newSignedBSDocData(owner base.Address, signers ...DocSign) BSDocData {
+	return MustNewBSDocData(
+		MustNewDocInfo("1sdi", BSDocDataType),
+		owner,
+		FileHash("filehash"),
+		NewDocSign(owner, "signcode", true),
+		"title",
+		currency.NewBig(10),
+		signers,
+	)
+}
+
+func (t *testUpdateDocumentsProcessor) TestFreezeBSDocDataAfterSigned() {
+	owner, sts0 := t.newAccount()
+	signer0, sts1 := t.newAccount()
+	signer1, sts2 := t.newAccount()
+	signer2, sts3 := t.newAccount()
+
+	signed := NewDocSign(signer0.Address(), "signcode", true)
+	pending := NewDocSign(signer1.Address(), "signcode", false)
+	added := NewDocSign(signer2.Address(), "signcode", false)
+
+	old := t.newSignedBSDocData(owner.Address(), signed, pending).WithSigningPolicy(SigningPolicySequential)
+	dsts := t.newDocumentStates(owner.Address(), old)
+
+	cases := []struct {
+		name string
+		doc  BSDocData
+		err  string
+	}{
+		{
+			name: "signing policy",
+			doc:  old.WithSigningPolicy(SigningPolicyParallel),
+			err:  "signing policy can not be updated after signed",
+		},
+		{
+			name: "deadline",
+			doc:  old.WithDeadline(base.Height(33)),
+			err:  "deadline can not be updated after signed",
+		},
+		{
+			name: "order of signers",
+			doc:  t.newSignedBSDocData(owner.Address(), pending, signed).WithSigningPolicy(SigningPolicySequential),
+			err:  "order of signers can not be updated after signed",
+		},
+		{
+			name: "insert new signer",
+			doc:  t.newSignedBSDocData(owner.Address(), signed, added, pending).WithSigningPolicy(SigningPolicySequential),
+			err:  "new signer should be added after the existing signers",
+		},
+		{
+			name: "append new signer",
+			doc:  t.newSignedBSDocData(owner.Address(), signed, pending, added).WithSigningPolicy(SigningPolicySequential),
+		},
+		{
+			name: "remove pending signer",
+			doc:  t.newSignedBSDocData(owner.Address(), signed).WithSigningPolicy(SigningPolicySequential),
+		},
+	}
+
+	for i, c := range cases {
+		err := t.update(owner, c.doc, sts0, sts1, sts2, sts3, dsts)
+		if len(c.err) < 1 {
+			t.NoError(err, "%d: %v", i, c.name)
+
+			continue
+		}
+
+		t.Error(err, "%d: %v", i, c.name)
+		t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+	}
+}
+
+func (t *testUpdateDocumentsProcessor) TestUpdateBSDocDataBeforeSigned() {
+	owner, sts0 := t.newAccount()
+	signer0, sts1 := t.newAccount()
+	signer1, sts2 := t.newAccount()
+
+	old := t.newBSDocData(owner.Address(), "1sdi", signer0.Address(), signer1.Address())
+
+	doc := t.newBSDocData(owner.Address(), "1sdi", signer1.Address(), signer0.Address()).
+		WithSigningPolicy(SigningPolicySequential).
+		WithDeadline(base.Height(33))
+
+	t.NoError(t.update(owner, doc, sts0, sts1, sts2, t.newDocumentStates(owner.Address(), old)))
+}
+
+func (t *testUpdateDocumentsProcessor) TestUpdateBCVotingData() {
+	owner, sts0 := t.newAdmin()
+	candidate, sts1 := t.newAccount()
+
+	old := t.newBCVotingData(owner.Address(), "1cvi", base.Height(33), candidate.Address())
+	dsts := t.newDocumentStates(owner.Address(), old)
+
+	withBoss := func(doc BCVotingData) BCVotingData {
+		doc.bossname = "boss"
+		doc.account = candidate.Address()
+
+		return doc
+	}
+
+	nextRound := old.openRound(base.Height(66), base.Height(34))
+
+	cases := []struct {
+		name string
+		doc  BCVotingData
+		err  string
+	}{
+		{name: "round", doc: nextRound, err: "round can not be updated"},
+		{name: "deadline", doc: old.WithDeadline(base.Height(66)), err: "deadline can not be updated in the round"},
+		{
+			name: "reveal deadline",
+			doc:  old.WithRevealDeadline(base.Height(66)),
+			err:  "deadline can not be updated in the round",
+		},
+		{name: "closed", doc: old.closeRound(), err: "closed of round can not be updated"},
+		{name: "boss", doc: withBoss(old), err: "boss can not be updated"},
+		{
+			name: "voting weight",
+			doc:  old.WithWeight(VotingWeightBalance, t.cid),
+			err:  "voting weight can not be changed in the round",
+		},
+		{name: "same", doc: old},
+	}
+
+	for i, c := range cases {
+		err := t.update(owner, c.doc, sts0, sts1, dsts)
+		if len(c.err) < 1 {
+			t.NoError(err, "%d: %v", i, c.name)
+
+			continue
+		}
+
+		t.Error(err, "%d: %v", i, c.name)
+		t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+	}
+}
+
+func (t *testUpdateDocumentsProcessor) TestStartBCVotingData() {
+	owner, sts0 := t.newAdmin()
+	candidate, sts1 := t.newAccount()
+
+	// without deadline, the voting is not started
+	old := t.newBCVotingData(owner.Address(), "1cvi", base.Height(0), candidate.Address())
+
+	doc := old.WithDeadline(base.Height(33)).WithRevealDeadline(base.Height(66))
+
+	t.NoError(t.update(owner, doc, sts0, sts1, t.newDocumentStates(owner.Address(), old)))
+}
+
+func (t *testUpdateDocumentsProcessor) TestPatchBCUserData() {
+	owner, sts0 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	pool, opr := t.statepool(sts0, dsts)
+
+	patch := func(delta int64) UpdateDocuments {
+		return t.newUpdateDocuments(owner, NewPatchDocumentsItemImpl(
+			doc.DocumentId(), []DocumentPatch{NewIncrementPatch("statistics.hp", delta)}, t.cid,
+		))
+	}
+
+	errs := t.process(opr, patch(2))
+	t.NoError(errs[0])
+	t.True(MustNewUserStatistics(3, 1, 1, 1, 1, 1, 1).Equal(
+		t.updatedDocument(pool, doc.DocumentId()).(BCUserData).Statistics()))
+
+	_, opr = t.nextStatepool(pool, sts0, dsts)
+
+	errs = t.process(opr, patch(-2))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), `failed to update document, "1cui"`)
+	t.Contains(errs[0].Error(), "field under zero, 1 - 2")
+}
+
+func (t *testUpdateDocumentsProcessor) TestUpdateBCLandDataGeometry() {
+	owner, sts0 := t.newAdmin()
+
+	old := MustNewBCLandData(
+		MustNewDocInfo("1cli", BCLandDataType),
+		owner.Address(),
+		"address",
+		"area",
+		"",
+		owner.Address(),
+		NewDocumentTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		30,
+	)
+	dsts := t.newDocumentStates(owner.Address(), old)
+
+	geometry := NewPointLandGeometry(126.97, 37.56)
+
+	pool, opr := t.statepool(sts0, dsts)
+
+	errs := t.process(opr, t.newUpdateDocuments(owner, NewUpdateDocumentsItemImpl(old.WithGeometry(geometry), t.cid)))
+	t.NoError(errs[0])
+
+	doc := t.updatedDocument(pool, old.DocumentId()).(BCLandData)
+	t.True(geometry.Equal(doc.Geometry()))
+
+	// the geometry is removed by the update without geometry
+	pool, opr = t.nextStatepool(pool, sts0, dsts)
+
+	errs = t.process(opr, t.newUpdateDocuments(owner, NewUpdateDocumentsItemImpl(old, t.cid)))
+	t.NoError(errs[0])
+	t.False(t.updatedDocument(pool, old.DocumentId()).(BCLandData).HasGeometry())
+}
+
+func (t *testUpdateDocumentsProcessor) TestExpectedHashAndHeight() {
+	owner, sts0 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc)
+	dsts[0] = dsts[0].SetHeight(base.Height(3))
+
+	patch := func() PatchDocumentsItemImpl {
+		return NewPatchDocumentsItemImpl(
+			doc.DocumentId(), []DocumentPatch{NewIncrementPatch("statistics.hp", 1)}, t.cid,
+		)
+	}
+
+	cases := []struct {
+		name string
+		item UpdateDocumentsItem
+		err  string
+	}{
+		{name: "hash", item: patch().WithExpectedHash(doc.Hash())},
+		{name: "height", item: patch().WithExpectedHeight(base.Height(3))},
+		{name: "hash and height", item: patch().WithExpectedHash(doc.Hash()).WithExpectedHeight(base.Height(3))},
+		{
+			name: "unknown hash",
+			item: patch().WithExpectedHash(valuehash.RandomSHA256()),
+			err:  "document hash not matched with expected",
+		},
+		{
+			name: "old height",
+			item: patch().WithExpectedHeight(base.Height(2)),
+			err:  "document height not matched with expected",
+		},
+	}
+
+	for i, c := range cases {
+		_, opr := t.statepoolAt(base.Height(4), sts0, dsts)
+
+		err := t.process(opr, t.newUpdateDocuments(owner, c.item))[0]
+		if len(c.err) < 1 {
+			t.NoError(err, "%d: %v", i, c.name)
+
+			continue
+		}
+
+		t.Error(err, "%d: %v", i, c.name)
+		t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+	}
+}
+
+func (t *testUpdateDocumentsProcessor) TestExpectedHashAfterUpdated() {
+	owner, sts0 := t.newAccount()
+
+	doc := t.newBCUserData(owner.Address(), "1cui", 0, 0)
+	dsts := t.newDocumentStates(owner.Address(), doc)
+
+	patch := func(h valuehash.Hash) UpdateDocuments {
+		return t.newUpdateDocuments(owner, NewPatchDocumentsItemImpl(
+			doc.DocumentId(), []DocumentPatch{NewIncrementPatch("statistics.hp", 1)}, t.cid,
+		).WithExpectedHash(h))
+	}
+
+	pool, opr := t.statepool(sts0, dsts)
+
+	errs := t.process(opr, patch(doc.Hash()))
+	t.NoError(errs[0])
+
+	updated := t.updatedDocument(pool, doc.DocumentId())
+
+	// the update over the old document is rejected instead of overwriting the
+	// update in the previous block
+	pool, opr = t.nextStatepool(pool, sts0, dsts)
+
+	errs = t.process(opr, patch(doc.Hash()))
+	t.Error(errs[0])
+	t.Contains(errs[0].Error(), "document hash not matched with expected")
+
+	errs = t.process(opr, patch(updated.Hash()))
+	t.NoError(errs[0])
+
+	t.True(MustNewUserStatistics(3, 1, 1, 1, 1, 1, 1).Equal(
+		t.updatedDocument(pool, doc.DocumentId()).(BCUserData).Statistics()))
+}
+
+func TestUpdateDocumentsProcessor(t *testing.T) {
+	suite.Run(t, new(testUpdateDocumentsProcessor))
+}
@@ -0,0 +1,124 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	UpdateGoldRateFactType   = hint.Type("mitum-update-gold-rate-operation-fact")
+	UpdateGoldRateFactHint   = hint.NewHint(UpdateGoldRateFactType, "v0.0.1")
+	UpdateGoldRateFactHinter = UpdateGoldRateFact{BaseHinter: hint.NewBaseHinter(UpdateGoldRateFactHint)}
+	UpdateGoldRateType       = hint.Type("mitum-update-gold-rate-operation")
+	UpdateGoldRateHint       = hint.NewHint(UpdateGoldRateType, "v0.0.1")
+	UpdateGoldRateHinter     = UpdateGoldRate{BaseOperation: operationHinter(UpdateGoldRateHint)}
+)
+
+// UpdateGoldRateFact sets the rate of swap between gold and currency; the
+// sender should have the blockcity admin permission.
+type UpdateGoldRateFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	rate   GoldRate
+	cid    currency.CurrencyID
+}
+
+func NewUpdateGoldRateFact(
+	token []byte,
+	sender base.Address,
+	rate GoldRate,
+	cid currency.CurrencyID,
+) UpdateGoldRateFact {
+	fact := UpdateGoldRateFact{
+		BaseHinter: hint.NewBaseHinter(UpdateGoldRateFactHint),
+		token:      token,
+		sender:     sender,
+		rate:       rate,
+		cid:        cid,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact UpdateGoldRateFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact UpdateGoldRateFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateGoldRateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.rate.Bytes(),
+		fact.cid.Bytes(),
+	)
+}
+
+func (fact UpdateGoldRateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for UpdateGoldRateFact")
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.rate, fact.cid); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact UpdateGoldRateFact) Token() []byte {
+	return fact.token
+}
+
+func (fact UpdateGoldRateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateGoldRateFact) Rate() GoldRate {
+	return fact.rate
+}
+
+func (fact UpdateGoldRateFact) Currency() currency.CurrencyID {
+	return fact.cid
+}
+
+func (fact UpdateGoldRateFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.rate.Treasury()}, nil
+}
+
+type UpdateGoldRate struct {
+	currency.BaseOperation
+}
+
+func NewUpdateGoldRate(fact UpdateGoldRateFact, fs []base.FactSign, memo string) (UpdateGoldRate, error) {
+	bo, err := currency.NewBaseOperationFromFact(UpdateGoldRateHint, fact, fs, memo)
+	if err != nil {
+		return UpdateGoldRate{}, err
+	}
+
+	return UpdateGoldRate{BaseOperation: bo}, nil
+}
@@ -0,0 +1,41 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *UpdateGoldRateFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	tk []byte,
+	bSender base.AddressDecoder,
+	bRate []byte,
+	cid string,
+) error {
+	sender, err := bSender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	hinter, err := enc.Decode(bRate)
+	if err != nil {
+		return err
+	}
+
+	rate, ok := hinter.(GoldRate)
+	if !ok {
+		return util.WrongTypeError.Errorf("expected GoldRate, not %T", hinter)
+	}
+
+	fact.h = h
+	fact.token = tk
+	fact.sender = sender
+	fact.rate = rate
+	fact.cid = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type UpdateGoldRateFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash      `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.Address        `json:"sender"`
+	RT GoldRate            `json:"rate"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateGoldRateFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(UpdateGoldRateFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		RT:         fact.rate,
+		CI:         fact.cid,
+	})
+}
+
+type UpdateGoldRateFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	RT json.RawMessage     `json:"rate"`
+	CI string              `json:"currency"`
+}
+
+func (fact *UpdateGoldRateFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf UpdateGoldRateFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, uf.H, uf.TK, uf.SD, uf.RT, uf.CI)
+}
+
+func (op *UpdateGoldRate) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var UpdateGoldRateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateGoldRateProcessor)
+	},
+}
+
+func (op UpdateGoldRate) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type UpdateGoldRateProcessor struct {
+	cp *currency.CurrencyPool
+	UpdateGoldRate
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewUpdateGoldRateProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(UpdateGoldRate)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not UpdateGoldRate, %T", op)
+		}
+
+		opp := UpdateGoldRateProcessorPool.Get().(*UpdateGoldRateProcessor)
+
+		opp.cp = cp
+		opp.UpdateGoldRate = i
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateGoldRateProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(UpdateGoldRateFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkAccountPermission(fact.sender, extension.BlockcityAdmin, getState); err != nil {
+		return nil, err
+	}
+
+	// the treasury should be able to receive and pay the currency of swap
+	if err := checkExistsState(currency.StateKeyAccount(fact.rate.Treasury()), getState); err != nil {
+		return nil, err
+	}
+
+	if opp.cp != nil {
+		if _, found := opp.cp.Feeer(fact.rate.Currency()); !found {
+			return nil, operation.NewBaseReasonError("unknown currency id of gold rate, %q", fact.rate.Currency())
+		}
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+// Process replaces the gold rate state with the new rate.
+func (opp *UpdateGoldRateProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(UpdateGoldRateFact)
+
+	st, _, err := getState(StateKeyGoldRate)
+	if err != nil {
+		return err
+	}
+
+	nst, err := SetStateGoldRateValue(st, fact.rate)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process update gold rate: %w", err)
+	}
+
+	sts := []state.State{nst}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *UpdateGoldRateProcessor) Close() error {
+	opp.cp = nil
+	opp.UpdateGoldRate = UpdateGoldRate{}
+	opp.sb = nil
+	opp.required = nil
+
+	UpdateGoldRateProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *UpdateGoldRateProcessor) calculateFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(UpdateGoldRateFact)
+
+	required := map[currency.CurrencyID][2]currency.Big{
+		fact.cid: {currency.ZeroBig, currency.ZeroBig},
+	}
+
+	if opp.cp == nil {
+		return required, nil
+	}
+
+	feeer, found := opp.cp.Feeer(fact.cid)
+	if !found {
+		return nil, operation.NewBaseReasonError("unknown currency id found, %q", fact.cid)
+	}
+
+	switch k, err := feeer.Fee(currency.ZeroBig); {
+	case err != nil:
+		return nil, err
+	case k.OverZero():
+		required[fact.cid] = [2]currency.Big{k, k}
+	}
+
+	return required, nil
+}
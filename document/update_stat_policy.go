@@ -0,0 +1,125 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	UpdateStatPolicyFactType   = hint.Type("mitum-update-stat-policy-operation-fact")
+	UpdateStatPolicyFactHint   = hint.NewHint(UpdateStatPolicyFactType, "v0.0.1")
+	UpdateStatPolicyFactHinter = UpdateStatPolicyFact{BaseHinter: hint.NewBaseHinter(UpdateStatPolicyFactHint)}
+	UpdateStatPolicyType       = hint.Type("mitum-update-stat-policy-operation")
+	UpdateStatPolicyHint       = hint.NewHint(UpdateStatPolicyType, "v0.0.1")
+	UpdateStatPolicyHinter     = UpdateStatPolicy{BaseOperation: operationHinter(UpdateStatPolicyHint)}
+)
+
+// UpdateStatPolicyFact sets the policy, which bounds the statistics of
+// blockcity user documents; the sender should have the blockcity admin
+// permission.
+type UpdateStatPolicyFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	policy StatPolicy
+	cid    currency.CurrencyID
+}
+
+func NewUpdateStatPolicyFact(
+	token []byte,
+	sender base.Address,
+	policy StatPolicy,
+	cid currency.CurrencyID,
+) UpdateStatPolicyFact {
+	fact := UpdateStatPolicyFact{
+		BaseHinter: hint.NewBaseHinter(UpdateStatPolicyFactHint),
+		token:      token,
+		sender:     sender,
+		policy:     policy,
+		cid:        cid,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact UpdateStatPolicyFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact UpdateStatPolicyFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateStatPolicyFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.policy.Bytes(),
+		fact.cid.Bytes(),
+	)
+}
+
+func (fact UpdateStatPolicyFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for UpdateStatPolicyFact")
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.policy, fact.cid); err != nil {
+		return err
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact UpdateStatPolicyFact) Token() []byte {
+	return fact.token
+}
+
+func (fact UpdateStatPolicyFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateStatPolicyFact) Policy() StatPolicy {
+	return fact.policy
+}
+
+func (fact UpdateStatPolicyFact) Currency() currency.CurrencyID {
+	return fact.cid
+}
+
+func (fact UpdateStatPolicyFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type UpdateStatPolicy struct {
+	currency.BaseOperation
+}
+
+func NewUpdateStatPolicy(fact UpdateStatPolicyFact, fs []base.FactSign, memo string) (UpdateStatPolicy, error) {
+	bo, err := currency.NewBaseOperationFromFact(UpdateStatPolicyHint, fact, fs, memo)
+	if err != nil {
+		return UpdateStatPolicy{}, err
+	}
+
+	return UpdateStatPolicy{BaseOperation: bo}, nil
+}
@@ -0,0 +1,41 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *UpdateStatPolicyFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	tk []byte,
+	bSender base.AddressDecoder,
+	bPolicy []byte,
+	cid string,
+) error {
+	sender, err := bSender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	hinter, err := enc.Decode(bPolicy)
+	if err != nil {
+		return err
+	}
+
+	policy, ok := hinter.(StatPolicy)
+	if !ok {
+		return util.WrongTypeError.Errorf("expected StatPolicy, not %T", hinter)
+	}
+
+	fact.h = h
+	fact.token = tk
+	fact.sender = sender
+	fact.policy = policy
+	fact.cid = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type UpdateStatPolicyFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash      `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.Address        `json:"sender"`
+	PL StatPolicy          `json:"policy"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateStatPolicyFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(UpdateStatPolicyFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		PL:         fact.policy,
+		CI:         fact.cid,
+	})
+}
+
+type UpdateStatPolicyFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	PL json.RawMessage     `json:"policy"`
+	CI string              `json:"currency"`
+}
+
+func (fact *UpdateStatPolicyFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf UpdateStatPolicyFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, uf.H, uf.TK, uf.SD, uf.PL, uf.CI)
+}
+
+func (op *UpdateStatPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo currency.BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/protoconNet/mitum-document/extension"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var UpdateStatPolicyProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateStatPolicyProcessor)
+	},
+}
+
+func (op UpdateStatPolicy) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type UpdateStatPolicyProcessor struct {
+	cp *currency.CurrencyPool
+	UpdateStatPolicy
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewUpdateStatPolicyProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(UpdateStatPolicy)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not UpdateStatPolicy, %T", op)
+		}
+
+		opp := UpdateStatPolicyProcessorPool.Get().(*UpdateStatPolicyProcessor)
+
+		opp.cp = cp
+		opp.UpdateStatPolicy = i
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateStatPolicyProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(UpdateStatPolicyFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkAccountPermission(fact.sender, extension.BlockcityAdmin, getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+// Process replaces the stat policy state with the new policy; the statistics
+// of the existing user documents are checked by the new policy, when they are
+// changed.
+func (opp *UpdateStatPolicyProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(UpdateStatPolicyFact)
+
+	st, _, err := getState(StateKeyStatPolicy)
+	if err != nil {
+		return err
+	}
+
+	nst, err := SetStateStatPolicyValue(st, fact.policy)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process update stat policy: %w", err)
+	}
+
+	sts := []state.State{nst}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *UpdateStatPolicyProcessor) Close() error {
+	opp.cp = nil
+	opp.UpdateStatPolicy = UpdateStatPolicy{}
+	opp.sb = nil
+	opp.required = nil
+
+	UpdateStatPolicyProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *UpdateStatPolicyProcessor) calculateFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(UpdateStatPolicyFact)
+
+	required := map[currency.CurrencyID][2]currency.Big{
+		fact.cid: {currency.ZeroBig, currency.ZeroBig},
+	}
+
+	if opp.cp == nil {
+		return required, nil
+	}
+
+	feeer, found := opp.cp.Feeer(fact.cid)
+	if !found {
+		return nil, operation.NewBaseReasonError("unknown currency id found, %q", fact.cid)
+	}
+
+	switch k, err := feeer.Fee(currency.ZeroBig); {
+	case err != nil:
+		return nil, err
+	case k.OverZero():
+		required[fact.cid] = [2]currency.Big{k, k}
+	}
+
+	return required, nil
+}
@@ -0,0 +1,207 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	VoteCommitType   = hint.Type("mitum-blockcity-vote-commit")
+	VoteCommitHint   = hint.NewHint(VoteCommitType, "v0.0.1")
+	VoteCommitHinter = VoteCommit{BaseHinter: hint.NewBaseHinter(VoteCommitHint)}
+)
+
+// VoteCommit is the committed vote of secret ballot.
+type VoteCommit struct {
+	hint.BaseHinter
+	voter      base.Address
+	commitment valuehash.Hash
+	weight     uint // weight of vote at commit
+}
+
+func NewVoteCommit(voter base.Address, commitment valuehash.Hash, weight uint) VoteCommit {
+	return VoteCommit{
+		BaseHinter: hint.NewBaseHinter(VoteCommitHint),
+		voter:      voter,
+		commitment: commitment,
+		weight:     weight,
+	}
+}
+
+// NewVoteCommitment returns the commitment of the vote for the candidate. The
+// commitment is bound to the voter, so the commitment of the other voter can
+// not be copied.
+func NewVoteCommitment(documentid string, voter, candidate base.Address, salt string) valuehash.Hash {
+	return valuehash.NewSHA256(util.ConcatBytesSlice(
+		[]byte(documentid),
+		voter.Bytes(),
+		candidate.Bytes(),
+		[]byte(salt),
+	))
+}
+
+func (vc VoteCommit) Bytes() []byte {
+	return util.ConcatBytesSlice(vc.voter.Bytes(), vc.commitment.Bytes(), util.UintToBytes(vc.weight))
+}
+
+func (vc VoteCommit) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, vc.BaseHinter, vc.voter, vc.commitment); err != nil {
+		return err
+	}
+
+	if vc.weight < 1 {
+		return isvalid.InvalidError.Errorf("weight of vote commit should be over zero")
+	}
+
+	return nil
+}
+
+func (vc VoteCommit) Voter() base.Address {
+	return vc.voter
+}
+
+func (vc VoteCommit) Commitment() valuehash.Hash {
+	return vc.commitment
+}
+
+func (vc VoteCommit) Weight() uint {
+	return vc.weight
+}
+
+var (
+	VotingBoxType   = hint.Type("mitum-blockcity-voting-box")
+	VotingBoxHint   = hint.NewHint(VotingBoxType, "v0.0.1")
+	VotingBoxHinter = VotingBox{BaseHinter: hint.NewBaseHinter(VotingBoxHint)}
+)
+
+// VotingBox is the state value of the voters of voting document in the round.
+// In secret ballot, the voters are who revealed their committed votes. The
+// voters and commits of the previous rounds are dropped when the round of
+// document is changed.
+type VotingBox struct {
+	hint.BaseHinter
+	round   uint
+	voters  []base.Address
+	commits []VoteCommit
+}
+
+func NewVotingBox(round uint, voters []base.Address) VotingBox {
+	if voters == nil {
+		voters = []base.Address{}
+	}
+
+	return VotingBox{
+		BaseHinter: hint.NewBaseHinter(VotingBoxHint),
+		round:      round,
+		voters:     voters,
+		commits:    []VoteCommit{},
+	}
+}
+
+func (vb VotingBox) Bytes() []byte {
+	bs := make([][]byte, len(vb.voters)+len(vb.commits)+1)
+	bs[0] = util.UintToBytes(vb.round)
+	for i := range vb.voters {
+		bs[i+1] = vb.voters[i].Bytes()
+	}
+
+	for i := range vb.commits {
+		bs[len(vb.voters)+i+1] = vb.commits[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (vb VotingBox) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(vb.Bytes())
+}
+
+func (vb VotingBox) IsValid([]byte) error {
+	if err := vb.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for i := range vb.voters {
+		if err := vb.voters[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[vb.voters[i].String()]; found {
+			return isvalid.InvalidError.Errorf("duplicated voter, %q", vb.voters[i])
+		}
+		founds[vb.voters[i].String()] = struct{}{}
+	}
+
+	founds = map[string]struct{}{}
+	for i := range vb.commits {
+		if err := vb.commits[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[vb.commits[i].voter.String()]; found {
+			return isvalid.InvalidError.Errorf("duplicated commit, %q", vb.commits[i].voter)
+		}
+		founds[vb.commits[i].voter.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (vb VotingBox) Round() uint {
+	return vb.round
+}
+
+func (vb VotingBox) Voters() []base.Address {
+	return vb.voters
+}
+
+func (vb VotingBox) Commits() []VoteCommit {
+	return vb.commits
+}
+
+func (vb VotingBox) HasVoted(a base.Address) bool {
+	for i := range vb.voters {
+		if vb.voters[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Commit returns the committed vote of the voter.
+func (vb VotingBox) Commit(a base.Address) (VoteCommit, bool) {
+	for i := range vb.commits {
+		if vb.commits[i].voter.Equal(a) {
+			return vb.commits[i], true
+		}
+	}
+
+	return VoteCommit{}, false
+}
+
+// Vote returns new VotingBox with the voter.
+func (vb VotingBox) Vote(a base.Address) VotingBox {
+	voters := make([]base.Address, len(vb.voters)+1)
+	copy(voters, vb.voters)
+	voters[len(vb.voters)] = a
+
+	vb.voters = voters
+
+	return vb
+}
+
+// AddCommit returns new VotingBox with the committed vote.
+func (vb VotingBox) AddCommit(vc VoteCommit) VotingBox {
+	commits := make([]VoteCommit, len(vb.commits)+1)
+	copy(commits, vb.commits)
+	commits[len(vb.commits)] = vc
+
+	vb.commits = commits
+
+	return vb
+}
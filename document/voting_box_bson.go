@@ -0,0 +1,62 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (vc VoteCommit) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(vc.Hint()),
+		bson.M{
+			"voter":      vc.voter,
+			"commitment": vc.commitment,
+			"weight":     vc.weight,
+		}),
+	)
+}
+
+type VoteCommitBSONUnpacker struct {
+	VT base.AddressDecoder `bson:"voter"`
+	CM valuehash.Bytes     `bson:"commitment"`
+	WG uint                `bson:"weight"`
+}
+
+func (vc *VoteCommit) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uvc VoteCommitBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &uvc); err != nil {
+		return err
+	}
+
+	return vc.unpack(enc, uvc.VT, uvc.CM, uvc.WG)
+}
+
+func (vb VotingBox) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"round":  vb.round,
+		"voters": vb.voters,
+	}
+
+	if len(vb.commits) > 0 {
+		m["commits"] = vb.commits
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(vb.Hint()), m))
+}
+
+type VotingBoxBSONUnpacker struct {
+	RD uint                  `bson:"round"`
+	VT []base.AddressDecoder `bson:"voters"`
+	CM bson.Raw              `bson:"commits,omitempty"`
+}
+
+func (vb *VotingBox) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uvb VotingBoxBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &uvb); err != nil {
+		return err
+	}
+
+	return vb.unpack(enc, uvb.RD, uvb.VT, uvb.CM)
+}
@@ -0,0 +1,67 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (vc *VoteCommit) unpack(
+	enc encoder.Encoder,
+	bvt base.AddressDecoder,
+	cm valuehash.Bytes,
+	wg uint,
+) error {
+	a, err := bvt.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	vc.voter = a
+	vc.commitment = cm
+	vc.weight = wg
+
+	return nil
+}
+
+func (vb *VotingBox) unpack(
+	enc encoder.Encoder,
+	rd uint,
+	bvt []base.AddressDecoder,
+	bcm []byte,
+) error {
+	voters := make([]base.Address, len(bvt))
+	for i := range bvt {
+		a, err := bvt[i].Encode(enc)
+		if err != nil {
+			return err
+		}
+
+		voters[i] = a
+	}
+
+	commits := []VoteCommit{}
+	if len(bcm) > 0 {
+		hits, err := enc.DecodeSlice(bcm)
+		if err != nil {
+			return err
+		}
+
+		commits = make([]VoteCommit, len(hits))
+		for i := range hits {
+			j, ok := hits[i].(VoteCommit)
+			if !ok {
+				return errors.Errorf("not VoteCommit: %T", hits[i])
+			}
+
+			commits[i] = j
+		}
+	}
+
+	vb.round = rd
+	vb.voters = voters
+	vb.commits = commits
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type VoteCommitJSONPacker struct {
+	jsonenc.HintedHead
+	VT base.Address   `json:"voter"`
+	CM valuehash.Hash `json:"commitment"`
+	WG uint           `json:"weight"`
+}
+
+func (vc VoteCommit) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(VoteCommitJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(vc.Hint()),
+		VT:         vc.voter,
+		CM:         vc.commitment,
+		WG:         vc.weight,
+	})
+}
+
+type VoteCommitJSONUnpacker struct {
+	VT base.AddressDecoder `json:"voter"`
+	CM valuehash.Bytes     `json:"commitment"`
+	WG uint                `json:"weight"`
+}
+
+func (vc *VoteCommit) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uvc VoteCommitJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uvc); err != nil {
+		return err
+	}
+
+	return vc.unpack(enc, uvc.VT, uvc.CM, uvc.WG)
+}
+
+type VotingBoxJSONPacker struct {
+	jsonenc.HintedHead
+	RD uint           `json:"round"`
+	VT []base.Address `json:"voters"`
+	CM []VoteCommit   `json:"commits,omitempty"`
+}
+
+func (vb VotingBox) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(VotingBoxJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(vb.Hint()),
+		RD:         vb.round,
+		VT:         vb.voters,
+		CM:         vb.commits,
+	})
+}
+
+type VotingBoxJSONUnpacker struct {
+	RD uint                  `json:"round"`
+	VT []base.AddressDecoder `json:"voters"`
+	CM json.RawMessage       `json:"commits,omitempty"`
+}
+
+func (vb *VotingBox) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uvb VotingBoxJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uvb); err != nil {
+		return err
+	}
+
+	return vb.unpack(enc, uvb.RD, uvb.VT, uvb.CM)
+}
@@ -0,0 +1,99 @@
+package document
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	VotingResultType   = hint.Type("mitum-blockcity-voting-result")
+	VotingResultHint   = hint.NewHint(VotingResultType, "v0.0.1")
+	VotingResultHinter = VotingResult{BaseHinter: hint.NewBaseHinter(VotingResultHint)}
+)
+
+// VotingResult is the state value of the tallies of the closed round of voting
+// document.
+type VotingResult struct {
+	hint.BaseHinter
+	documentid string
+	round      uint
+	candidates []VotingCandidate
+	closedAt   base.Height
+}
+
+func NewVotingResult(documentid string, round uint, candidates []VotingCandidate, closedAt base.Height) VotingResult {
+	return VotingResult{
+		BaseHinter: hint.NewBaseHinter(VotingResultHint),
+		documentid: documentid,
+		round:      round,
+		candidates: candidates,
+		closedAt:   closedAt,
+	}
+}
+
+func (vr VotingResult) Bytes() []byte {
+	sort.Slice(vr.candidates, func(i, j int) bool {
+		return bytes.Compare(vr.candidates[i].Bytes(), vr.candidates[j].Bytes()) < 0
+	})
+
+	bs := make([][]byte, len(vr.candidates)+3)
+	bs[0] = []byte(vr.documentid)
+	bs[1] = util.UintToBytes(vr.round)
+	bs[2] = vr.closedAt.Bytes()
+	for i := range vr.candidates {
+		bs[i+3] = vr.candidates[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (vr VotingResult) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(vr.Bytes())
+}
+
+func (vr VotingResult) IsValid([]byte) error {
+	if err := vr.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(vr.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("empty documentid")
+	}
+
+	for i := range vr.candidates {
+		if err := vr.candidates[i].IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (vr VotingResult) DocumentId() string {
+	return vr.documentid
+}
+
+func (vr VotingResult) Round() uint {
+	return vr.round
+}
+
+func (vr VotingResult) Candidates() []VotingCandidate {
+	return vr.candidates
+}
+
+// ClosedAt returns the block height, in which the round was closed.
+func (vr VotingResult) ClosedAt() base.Height {
+	return vr.closedAt
+}
+
+// Winner returns the elected candidate of the round by the same rule of
+// BCVotingData.Winner.
+func (vr VotingResult) Winner() (VotingCandidate, bool) {
+	return electCandidate(vr.candidates)
+}
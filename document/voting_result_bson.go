@@ -0,0 +1,35 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (vr VotingResult) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(vr.Hint()),
+		bson.M{
+			"documentid": vr.documentid,
+			"round":      vr.round,
+			"candidates": vr.candidates,
+			"closed_at":  vr.closedAt,
+		}),
+	)
+}
+
+type VotingResultBSONUnpacker struct {
+	DI string      `bson:"documentid"`
+	RD uint        `bson:"round"`
+	CD bson.Raw    `bson:"candidates"`
+	CA base.Height `bson:"closed_at"`
+}
+
+func (vr *VotingResult) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uvr VotingResultBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &uvr); err != nil {
+		return err
+	}
+
+	return vr.unpack(enc, uvr.DI, uvr.RD, uvr.CD, uvr.CA)
+}
@@ -0,0 +1,37 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (vr *VotingResult) unpack(
+	enc encoder.Encoder,
+	di string,
+	rd uint,
+	bcd []byte,
+	ca base.Height,
+) error {
+	hits, err := enc.DecodeSlice(bcd)
+	if err != nil {
+		return err
+	}
+
+	candidates := make([]VotingCandidate, len(hits))
+	for i := range hits {
+		j, ok := hits[i].(VotingCandidate)
+		if !ok {
+			return errors.Errorf("not VotingCandidate: %T", hits[i])
+		}
+
+		candidates[i] = j
+	}
+
+	vr.documentid = di
+	vr.round = rd
+	vr.candidates = candidates
+	vr.closedAt = ca
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package document
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type VotingResultJSONPacker struct {
+	jsonenc.HintedHead
+	DI string            `json:"documentid"`
+	RD uint              `json:"round"`
+	CD []VotingCandidate `json:"candidates"`
+	CA base.Height       `json:"closed_at"`
+}
+
+func (vr VotingResult) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(VotingResultJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(vr.Hint()),
+		DI:         vr.documentid,
+		RD:         vr.round,
+		CD:         vr.candidates,
+		CA:         vr.closedAt,
+	})
+}
+
+type VotingResultJSONUnpacker struct {
+	DI string          `json:"documentid"`
+	RD uint            `json:"round"`
+	CD json.RawMessage `json:"candidates"`
+	CA base.Height     `json:"closed_at"`
+}
+
+func (vr *VotingResult) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uvr VotingResultJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uvr); err != nil {
+		return err
+	}
+
+	return vr.unpack(enc, uvr.DI, uvr.RD, uvr.CD, uvr.CA)
+}
@@ -0,0 +1,130 @@
+package document
+
+import (
+	"sort"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	VotingSnapshotsType   = hint.Type("mitum-blockcity-voting-snapshots")
+	VotingSnapshotsHint   = hint.NewHint(VotingSnapshotsType, "v0.0.1")
+	VotingSnapshotsHinter = VotingSnapshots{BaseHinter: hint.NewBaseHinter(VotingSnapshotsHint)}
+)
+
+// VotingSnapshots is the state value of the snapshot heights of the weighted
+// rounds. While the round takes the votes, the states of voting weights
+// changed after the snapshot are kept as the checkpoints, see
+// StateKeyVotingCheckpoint.
+type VotingSnapshots struct {
+	hint.BaseHinter
+	heights []base.Height // snapshot heights, sorted
+	untils  []base.Height // heights, until which the checkpoints of snapshots are needed
+}
+
+func NewVotingSnapshots(heights, untils []base.Height) VotingSnapshots {
+	return VotingSnapshots{
+		BaseHinter: hint.NewBaseHinter(VotingSnapshotsHint),
+		heights:    heights,
+		untils:     untils,
+	}
+}
+
+func (vs VotingSnapshots) Bytes() []byte {
+	bs := make([][]byte, len(vs.heights)*2)
+	for i := range vs.heights {
+		bs[i*2] = vs.heights[i].Bytes()
+		bs[i*2+1] = vs.untils[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (vs VotingSnapshots) Hash() valuehash.Hash {
+	return valuehash.NewSHA256(vs.Bytes())
+}
+
+func (vs VotingSnapshots) IsValid([]byte) error {
+	if err := vs.BaseHinter.IsValid(nil); err != nil {
+		return isvalid.InvalidError.Errorf("invalid VotingSnapshots: %w", err)
+	}
+
+	if len(vs.heights) != len(vs.untils) {
+		return isvalid.InvalidError.Errorf(
+			"invalid VotingSnapshots: length of heights and untils not matched, %d != %d", len(vs.heights), len(vs.untils))
+	}
+
+	for i := range vs.heights {
+		if err := vs.heights[i].IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid VotingSnapshots: %w", err)
+		}
+
+		if i > 0 && vs.heights[i] <= vs.heights[i-1] {
+			return isvalid.InvalidError.Errorf("invalid VotingSnapshots: heights not sorted, %v", vs.heights[i])
+		}
+
+		if vs.untils[i] < vs.heights[i] {
+			return isvalid.InvalidError.Errorf(
+				"invalid VotingSnapshots: until under height, %v < %v", vs.untils[i], vs.heights[i])
+		}
+	}
+
+	return nil
+}
+
+func (vs VotingSnapshots) Heights() []base.Height {
+	return vs.heights
+}
+
+func (vs VotingSnapshots) Untils() []base.Height {
+	return vs.untils
+}
+
+// Open returns the snapshot heights, which are still needed at the given
+// height.
+func (vs VotingSnapshots) Open(height base.Height) []base.Height {
+	var heights []base.Height
+	for i := range vs.heights {
+		if vs.heights[i] <= height && vs.untils[i] >= height {
+			heights = append(heights, vs.heights[i])
+		}
+	}
+
+	return heights
+}
+
+// add returns new VotingSnapshots with the snapshot, which is needed until
+// the given height; the snapshots not needed at the snapshot height are
+// removed.
+func (vs VotingSnapshots) add(height, until base.Height) VotingSnapshots {
+	m := map[base.Height]base.Height{height: until}
+	for i := range vs.heights {
+		if vs.untils[i] < height {
+			continue
+		}
+
+		if u, found := m[vs.heights[i]]; !found || vs.untils[i] > u {
+			m[vs.heights[i]] = vs.untils[i]
+		}
+	}
+
+	heights := make([]base.Height, 0, len(m))
+	for h := range m {
+		heights = append(heights, h)
+	}
+
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
+	})
+
+	untils := make([]base.Height, len(heights))
+	for i := range heights {
+		untils[i] = m[heights[i]]
+	}
+
+	return NewVotingSnapshots(heights, untils)
+}
@@ -0,0 +1,31 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (vs VotingSnapshots) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(vs.Hint()),
+		bson.M{
+			"heights": vs.heights,
+			"untils":  vs.untils,
+		}),
+	)
+}
+
+type VotingSnapshotsBSONUnpacker struct {
+	HS []base.Height `bson:"heights"`
+	US []base.Height `bson:"untils"`
+}
+
+func (vs *VotingSnapshots) UnpackBSON(b []byte, _ *bsonenc.Encoder) error {
+	var uvs VotingSnapshotsBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &uvs); err != nil {
+		return err
+	}
+
+	return vs.unpack(uvs.HS, uvs.US)
+}
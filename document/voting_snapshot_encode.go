@@ -0,0 +1,12 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+)
+
+func (vs *VotingSnapshots) unpack(heights, untils []base.Height) error {
+	vs.heights = heights
+	vs.untils = untils
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type VotingSnapshotsJSONPacker struct {
+	jsonenc.HintedHead
+	HS []base.Height `json:"heights"`
+	US []base.Height `json:"untils"`
+}
+
+func (vs VotingSnapshots) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(VotingSnapshotsJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(vs.Hint()),
+		HS:         vs.heights,
+		US:         vs.untils,
+	})
+}
+
+type VotingSnapshotsJSONUnpacker struct {
+	HS []base.Height `json:"heights"`
+	US []base.Height `json:"untils"`
+}
+
+func (vs *VotingSnapshots) UnpackJSON(b []byte, _ *jsonenc.Encoder) error {
+	var uvs VotingSnapshotsJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uvs); err != nil {
+		return err
+	}
+
+	return vs.unpack(uvs.HS, uvs.US)
+}
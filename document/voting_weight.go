@@ -0,0 +1,174 @@
+package document
+
+import (
+	"strconv"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+// VotingWeight is the mode of the weight of votes in voting document.
+type VotingWeight string
+
+const (
+	VotingWeightNone    VotingWeight = ""        // one vote per account
+	VotingWeightBalance VotingWeight = "balance" // balance of the weight currency
+	VotingWeightGold    VotingWeight = "gold"    // gold of blockcity user documents
+)
+
+func (vw VotingWeight) Bytes() []byte {
+	return []byte(vw)
+}
+
+func (vw VotingWeight) String() string {
+	return string(vw)
+}
+
+func (vw VotingWeight) IsValid([]byte) error {
+	switch vw {
+	case VotingWeightNone, VotingWeightBalance, VotingWeightGold:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown voting weight, %q", vw)
+	}
+}
+
+// votingWeight returns the weight of the vote of voter in the current round of
+// voting document. The weights are snapshotted before the block, in which the
+// round is opened; the changes after that, like the transfer to voter or the
+// fee paid by voter, do not change the weight, see stateAtSnapshot.
+func votingWeight(
+	doc BCVotingData,
+	voter base.Address,
+	getState func(key string) (state.State, bool, error),
+) (uint, error) {
+	var weight uint
+	switch doc.Weight() {
+	case VotingWeightNone:
+		return 1, nil
+	case VotingWeightBalance:
+		i, err := balanceWeight(doc, voter, getState)
+		if err != nil {
+			return 0, err
+		}
+		weight = i
+	case VotingWeightGold:
+		i, err := goldWeight(doc, voter, getState)
+		if err != nil {
+			return 0, err
+		}
+		weight = i
+	default:
+		return 0, operation.NewBaseReasonError("unknown voting weight, %q", doc.Weight())
+	}
+
+	if weight < 1 {
+		return 0, operation.NewBaseReasonError("voter has no voting weight, %v; %q", voter, doc.DocumentId())
+	}
+
+	return weight, nil
+}
+
+func balanceWeight(
+	doc BCVotingData,
+	voter base.Address,
+	getState func(key string) (state.State, bool, error),
+) (uint, error) {
+	st, found, err := stateAtSnapshot(doc, currency.StateKeyBalance(voter, doc.WeightCurrency()), getState)
+	switch {
+	case err != nil:
+		return 0, err
+	case !found:
+		return 0, nil
+	}
+
+	am, err := currency.StateBalanceValue(st)
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := strconv.ParseUint(am.Big().String(), 10, strconv.IntSize)
+	if err != nil {
+		return 0, operation.NewBaseReasonError("balance too big for voting weight, %v", voter)
+	}
+
+	return uint(i), nil
+}
+
+// goldWeight sums the gold of the blockcity user documents, which the voter
+// owned at the snapshot.
+func goldWeight(
+	doc BCVotingData,
+	voter base.Address,
+	getState func(key string) (state.State, bool, error),
+) (uint, error) {
+	st, found, err := stateAtSnapshot(doc, StateKeyDocuments(voter), getState)
+	switch {
+	case err != nil:
+		return 0, err
+	case !found:
+		return 0, nil
+	}
+
+	dinv, err := StateDocumentsValue(st)
+	if err != nil {
+		return 0, err
+	}
+
+	var weight uint
+	for i := range dinv.Documents() {
+		info := dinv.Documents()[i]
+		if info.DocType() != BCUserDataType {
+			continue
+		}
+
+		dst, found, err := stateAtSnapshot(doc, StateKeyDocumentData(info.DocumentId()), getState)
+		switch {
+		case err != nil:
+			return 0, err
+		case !found:
+			continue
+		}
+
+		dd, err := StateDocumentDataValue(dst)
+		if err != nil {
+			return 0, err
+		}
+
+		ud, ok := dd.(BCUserData)
+		if !ok || !ud.Owner().Equal(voter) {
+			continue
+		}
+
+		if weight+ud.Gold() < weight {
+			return 0, operation.NewBaseReasonError("gold too big for voting weight, %v", voter)
+		}
+		weight += ud.Gold()
+	}
+
+	return weight, nil
+}
+
+// stateAtSnapshot returns the state of key at the snapshot of voting document.
+// The state changed after the snapshot is kept as the checkpoint at the first
+// change, see OperationProcessor.checkpointWeights; without the checkpoint, the
+// state did not exist at the snapshot.
+func stateAtSnapshot(
+	doc BCVotingData,
+	key string,
+	getState func(key string) (state.State, bool, error),
+) (state.State, bool, error) {
+	switch st, found, err := getState(key); {
+	case err != nil:
+		return nil, false, err
+	case found && st.Height() < doc.Snapshot():
+		return st, true, nil
+	case !found:
+		return nil, false, nil
+	}
+
+	return getState(StateKeyVotingCheckpoint(key, doc.Snapshot()))
+}
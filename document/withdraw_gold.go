@@ -0,0 +1,161 @@
+package document
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	WithdrawGoldFactType   = hint.Type("mitum-withdraw-gold-operation-fact")
+	WithdrawGoldFactHint   = hint.NewHint(WithdrawGoldFactType, "v0.0.1")
+	WithdrawGoldFactHinter = WithdrawGoldFact{BaseHinter: hint.NewBaseHinter(WithdrawGoldFactHint)}
+	WithdrawGoldType       = hint.Type("mitum-withdraw-gold-operation")
+	WithdrawGoldHint       = hint.NewHint(WithdrawGoldType, "v0.0.1")
+	WithdrawGoldHinter     = WithdrawGold{BaseOperation: operationHinter(WithdrawGoldHint)}
+)
+
+var MaxWithdrawGoldItems uint = 10
+
+type WithdrawGoldItem interface {
+	hint.Hinter
+	isvalid.IsValider
+	Bytes() []byte
+	DocumentId() string
+	Amount() uint
+	Currency() currency.CurrencyID
+	Rebuild() WithdrawGoldItem
+}
+
+type WithdrawGoldFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	items  []WithdrawGoldItem
+}
+
+func NewWithdrawGoldFact(token []byte, sender base.Address, items []WithdrawGoldItem) WithdrawGoldFact {
+	fact := WithdrawGoldFact{
+		BaseHinter: hint.NewBaseHinter(WithdrawGoldFactHint),
+		token:      token,
+		sender:     sender,
+		items:      items,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact WithdrawGoldFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact WithdrawGoldFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact WithdrawGoldFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact WithdrawGoldFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+	if len(fact.token) < 1 {
+		return errors.Errorf("empty token for WithdrawGoldFact")
+	} else if n := len(fact.items); n < 1 {
+		return errors.Errorf("empty items")
+	} else if n > int(MaxWithdrawGoldItems) {
+		return errors.Errorf("items, %d over max, %d", n, MaxWithdrawGoldItems)
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	for i := range fact.items {
+		if err := isvalid.Check(nil, false, fact.items[i]); err != nil {
+			return err
+		}
+
+		it := fact.items[i]
+		for j := range fact.items[:i] {
+			if fact.items[j].DocumentId() == it.DocumentId() {
+				return errors.Errorf("duplicated document found, %q", it.DocumentId())
+			}
+		}
+	}
+
+	if !fact.h.Equal(fact.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong Fact hash")
+	}
+
+	return nil
+}
+
+func (fact WithdrawGoldFact) Token() []byte {
+	return fact.token
+}
+
+func (fact WithdrawGoldFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact WithdrawGoldFact) Items() []WithdrawGoldItem {
+	return fact.items
+}
+
+func (fact WithdrawGoldFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+func (fact WithdrawGoldFact) Rebuild() WithdrawGoldFact {
+	items := make([]WithdrawGoldItem, len(fact.items))
+	for i := range fact.items {
+		it := fact.items[i]
+		items[i] = it.Rebuild()
+	}
+
+	fact.items = items
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+type WithdrawGold struct {
+	currency.BaseOperation
+}
+
+func NewWithdrawGold(fact WithdrawGoldFact, fs []base.FactSign, memo string) (WithdrawGold, error) {
+	bo, err := currency.NewBaseOperationFromFact(WithdrawGoldHint, fact, fs, memo)
+	if err != nil {
+		return WithdrawGold{}, err
+	} else {
+
+		return WithdrawGold{BaseOperation: bo}, nil
+	}
+}
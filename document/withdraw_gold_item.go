@@ -0,0 +1,79 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	WithdrawGoldItemImplType   = hint.Type("mitum-withdraw-gold-item")
+	WithdrawGoldItemImplHint   = hint.NewHint(WithdrawGoldItemImplType, "v0.0.1")
+	WithdrawGoldItemImplHinter = WithdrawGoldItemImpl{BaseHinter: hint.NewBaseHinter(WithdrawGoldItemImplHint)}
+)
+
+// WithdrawGoldItemImpl moves gold of the blockcity user document out of its bank.
+type WithdrawGoldItemImpl struct {
+	hint.BaseHinter
+	documentid string
+	amount     uint
+	cid        currency.CurrencyID
+}
+
+func NewWithdrawGoldItemImpl(
+	documentid string,
+	amount uint,
+	cid currency.CurrencyID) WithdrawGoldItemImpl {
+
+	return WithdrawGoldItemImpl{
+		BaseHinter: hint.NewBaseHinter(WithdrawGoldItemImplHint),
+		documentid: documentid,
+		amount:     amount,
+		cid:        cid,
+	}
+}
+
+func (it WithdrawGoldItemImpl) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(it.documentid),
+		util.UintToBytes(it.amount),
+		it.cid.Bytes(),
+	)
+}
+
+func (it WithdrawGoldItemImpl) IsValid([]byte) error {
+	if err := isvalid.Check(
+		nil, false,
+		it.BaseHinter,
+		it.cid,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid WithdrawGoldItem: %w", err)
+	}
+
+	if len(it.documentid) < 1 {
+		return isvalid.InvalidError.Errorf("invalid WithdrawGoldItem: empty documentid")
+	}
+
+	if it.amount < 1 {
+		return isvalid.InvalidError.Errorf("invalid WithdrawGoldItem: amount should be over zero")
+	}
+
+	return nil
+}
+
+func (it WithdrawGoldItemImpl) DocumentId() string {
+	return it.documentid
+}
+
+func (it WithdrawGoldItemImpl) Amount() uint {
+	return it.amount
+}
+
+func (it WithdrawGoldItemImpl) Currency() currency.CurrencyID {
+	return it.cid
+}
+
+func (it WithdrawGoldItemImpl) Rebuild() WithdrawGoldItem {
+	return it
+}
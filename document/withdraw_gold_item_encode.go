@@ -0,0 +1,19 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (it *WithdrawGoldItemImpl) unpack(
+	_ encoder.Encoder,
+	di string,
+	am uint,
+	cid string,
+) error {
+	it.documentid = di
+	it.amount = am
+	it.cid = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package document
+
+import (
+	"github.com/spikeekips/mitum-currency/currency"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type WithdrawGoldItemImplJSONPacker struct {
+	jsonenc.HintedHead
+	DI string              `json:"documentid"`
+	AM uint                `json:"amount"`
+	CI currency.CurrencyID `json:"currency"`
+}
+
+func (it WithdrawGoldItemImpl) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(WithdrawGoldItemImplJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(it.Hint()),
+		DI:         it.documentid,
+		AM:         it.amount,
+		CI:         it.cid,
+	})
+}
+
+type WithdrawGoldItemImplJSONUnpacker struct {
+	DI string `json:"documentid"`
+	AM uint   `json:"amount"`
+	CI string `json:"currency"`
+}
+
+func (it *WithdrawGoldItemImpl) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var u WithdrawGoldItemImplJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	return it.unpack(enc, u.DI, u.AM, u.CI)
+}
@@ -0,0 +1,148 @@
+package document
+
+import (
+	"sync"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var WithdrawGoldProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(WithdrawGoldProcessor)
+	},
+}
+
+func (op WithdrawGold) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type WithdrawGoldProcessor struct {
+	cp *currency.CurrencyPool
+	WithdrawGold
+	sb       map[currency.CurrencyID]currency.AmountState // sender StateBalance
+	required map[currency.CurrencyID][2]currency.Big      // Fee
+}
+
+func NewWithdrawGoldProcessor(cp *currency.CurrencyPool) currency.GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(WithdrawGold)
+		if !ok {
+			return nil, operation.NewBaseReasonError("not WithdrawGold, %T", op)
+		}
+
+		opp := WithdrawGoldProcessorPool.Get().(*WithdrawGoldProcessor)
+
+		opp.cp = cp
+		opp.WithdrawGold = i
+		opp.sb = nil
+		opp.required = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *WithdrawGoldProcessor) PreProcess(
+	getState func(key string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(WithdrawGoldFact)
+
+	// check sender account state existence
+	if err := checkExistsState(currency.StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	// prepare sender balance state
+	if required, err := opp.calculateItemsFee(); err != nil {
+		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
+	} else if sb, err := CheckDocumentOwnerEnoughBalance(fact.sender, required, getState); err != nil {
+		return nil, err
+	} else {
+		opp.required = required
+		opp.sb = sb
+	}
+
+	if _, err := opp.withdrawGold(getState); err != nil {
+		return nil, err
+	}
+
+	// check fact sign
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, operation.NewBaseReasonError("invalid signing: %w", err)
+	}
+
+	return opp, nil
+}
+
+func (opp *WithdrawGoldProcessor) Process( // nolint:dupl
+	getState func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(WithdrawGoldFact)
+
+	sts, err := opp.withdrawGold(getState)
+	if err != nil {
+		return operation.NewBaseReasonError("failed to process withdraw gold: %w", err)
+	}
+
+	// append sender balance state
+	for k := range opp.required {
+		rq := opp.required[k]
+		sts = append(sts, opp.sb[k].Sub(rq[0]).AddFee(rq[1]))
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+// withdrawGold returns the user document states, which gold is moved out of
+// the bank.
+func (opp *WithdrawGoldProcessor) withdrawGold(
+	getState func(key string) (state.State, bool, error),
+) ([]state.State, error) {
+	fact := opp.Fact().(WithdrawGoldFact)
+
+	gl := newGoldLedger()
+	for i := range fact.items {
+		it := fact.items[i]
+
+		doc, err := gl.load(fact.sender, it.DocumentId(), getState)
+		if err != nil {
+			return nil, err
+		}
+
+		ndoc, err := doc.withdrawGold(it.Amount())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+		gl.set(ndoc)
+	}
+
+	return gl.states()
+}
+
+func (opp *WithdrawGoldProcessor) Close() error {
+	opp.cp = nil
+	opp.WithdrawGold = WithdrawGold{}
+	opp.sb = nil
+	opp.required = nil
+
+	WithdrawGoldProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *WithdrawGoldProcessor) calculateItemsFee() (map[currency.CurrencyID][2]currency.Big, error) {
+	fact := opp.Fact().(WithdrawGoldFact)
+	items := make([]CurrencyItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCurrencyItemsFee(opp.cp, items)
+}
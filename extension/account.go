@@ -1,6 +1,9 @@
 package extension
 
 import (
+	"sort"
+
+	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
@@ -18,8 +21,8 @@ var (
 type Account struct {
 	hint.BaseHinter
 	currency.Account
-	h          valuehash.Hash
-	permission AccountPermission
+	h           valuehash.Hash
+	permissions []AccountPermission // sorted
 }
 
 func NewAccount(address base.Address, keys currency.AccountKeys) (Account, error) {
@@ -53,14 +56,16 @@ func NewAccountFromKeys(keys currency.AccountKeys) (Account, error) {
 }
 
 func (ac Account) Bytes() []byte {
-	bs := make([][]byte, 3)
+	bs := make([][]byte, len(ac.permissions)+2)
 	bs[0] = ac.Account.Address().Bytes()
 
 	if ac.Keys() != nil {
 		bs[1] = ac.Keys().Bytes()
 	}
 
-	bs[2] = ac.permission.Bytes()
+	for i := range ac.permissions {
+		bs[i+2] = ac.permissions[i].Bytes()
+	}
 
 	return util.ConcatBytesSlice(bs...)
 }
@@ -84,21 +89,96 @@ func (ac Account) SetKeys(keys currency.AccountKeys) (Account, error) {
 	}
 
 	ac.Account = cac
+	ac.h = ac.GenerateHash()
 
 	return ac, nil
 }
 
-func (ac Account) SetPermission(ap AccountPermission) (Account, error) {
+// AddPermission returns the copy of account with the new permission.
+func (ac Account) AddPermission(ap AccountPermission) (Account, error) {
 	if err := ap.IsValid(nil); err != nil {
 		return Account{}, err
 	}
 
-	ac.permission = ap
+	if ac.HasPermission(ap) {
+		return Account{}, errors.Errorf("permission already granted, %q", ap)
+	}
+
+	aps := make([]AccountPermission, len(ac.permissions)+1)
+	copy(aps, ac.permissions)
+	aps[len(ac.permissions)] = ap
+
+	sort.Slice(aps, func(i, j int) bool {
+		return aps[i] < aps[j]
+	})
+
+	ac.permissions = aps
 	ac.h = ac.GenerateHash()
 
 	return ac, nil
 }
 
+// RemovePermission returns the copy of account without the permission.
+func (ac Account) RemovePermission(ap AccountPermission) (Account, error) {
+	if !ac.HasPermission(ap) {
+		return Account{}, errors.Errorf("permission not granted, %q", ap)
+	}
+
+	aps := make([]AccountPermission, 0, len(ac.permissions)-1)
+	for i := range ac.permissions {
+		if ac.permissions[i] != ap {
+			aps = append(aps, ac.permissions[i])
+		}
+	}
+
+	ac.permissions = aps
+	ac.h = ac.GenerateHash()
+
+	return ac, nil
+}
+
+func (ac Account) Permissions() []AccountPermission {
+	return ac.permissions
+}
+
+func (ac Account) HasPermission(ap AccountPermission) bool {
+	for i := range ac.permissions {
+		if ac.permissions[i] == ap {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ac Account) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, ac.BaseHinter, ac.Address()); err != nil {
+		return isvalid.InvalidError.Errorf("invalid extended account: %w", err)
+	}
+
+	for i := range ac.permissions {
+		if err := ac.permissions[i].IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid extended account: %w", err)
+		}
+
+		if i > 0 && ac.permissions[i-1] >= ac.permissions[i] {
+			return isvalid.InvalidError.Errorf("invalid extended account: permissions not sorted or duplicated")
+		}
+	}
+
+	if ac.Keys() != nil {
+		if err := ac.Keys().IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid extended account: %w", err)
+		}
+	}
+
+	if ac.h == nil || !ac.h.Equal(ac.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("invalid extended account: hash not matched")
+	}
+
+	return nil
+}
+
 func (ac Account) IsEmpty() bool {
 	return ac.Account.IsEmpty() || ac.h.IsEmpty()
 }
@@ -106,13 +186,13 @@ func (ac Account) IsEmpty() bool {
 type AccountPermission uint
 
 const (
-	blockcityAdmin AccountPermission = 1 + iota
-	blocksignAdmin
+	BlockcityAdmin AccountPermission = 1 + iota
+	BlocksignAdmin
 )
 
 var accountPermissions = map[AccountPermission]string{
-	blockcityAdmin: "blockcityAdmin",
-	blocksignAdmin: "blocksignAdmin",
+	BlockcityAdmin: "blockcityAdmin",
+	BlocksignAdmin: "blocksignAdmin",
 }
 
 func (ap AccountPermission) String() string {
@@ -124,8 +204,19 @@ func (ap AccountPermission) Bytes() []byte {
 }
 
 func (ap AccountPermission) IsValid([]byte) error {
-	if uint(ap) > uint(len(accountPermissions)) {
-		return isvalid.InvalidError.Errorf("invalid AccountPermission")
+	if _, found := accountPermissions[ap]; !found {
+		return isvalid.InvalidError.Errorf("invalid AccountPermission, %d", ap)
 	}
 	return nil
 }
+
+// ParseAccountPermission returns AccountPermission by its name.
+func ParseAccountPermission(s string) (AccountPermission, error) {
+	for ap := range accountPermissions {
+		if accountPermissions[ap] == s {
+			return ap, nil
+		}
+	}
+
+	return 0, errors.Errorf("unknown AccountPermission, %q", s)
+}
@@ -0,0 +1,36 @@
+package extension
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (ac Account) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(ac.Hint()),
+		bson.M{
+			"hash":        ac.h,
+			"address":     ac.Address(),
+			"keys":        ac.Keys(),
+			"permissions": ac.permissions,
+		}),
+	)
+}
+
+type AccountBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	AD base.AddressDecoder `bson:"address"`
+	KS bson.Raw            `bson:"keys"`
+	PM []uint              `bson:"permissions"`
+}
+
+func (ac *Account) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uac AccountBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &uac); err != nil {
+		return err
+	}
+
+	return ac.unpack(enc, uac.H, uac.AD, uac.KS, uac.PM)
+}
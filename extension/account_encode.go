@@ -0,0 +1,47 @@
+package extension
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (ac *Account) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	bad base.AddressDecoder,
+	bks []byte,
+	pm []uint,
+) error {
+	a, err := bad.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	var keys currency.AccountKeys
+	if hinter, err := enc.Decode(bks); err != nil {
+		return err
+	} else if hinter != nil {
+		k, ok := hinter.(currency.AccountKeys)
+		if !ok {
+			return errors.Errorf("not AccountKeys: %T", hinter)
+		}
+		keys = k
+	}
+
+	cac, err := currency.NewAccount(a, keys)
+	if err != nil {
+		return err
+	}
+
+	ac.Account = cac
+	ac.h = h
+	ac.permissions = make([]AccountPermission, len(pm))
+	for i := range pm {
+		ac.permissions[i] = AccountPermission(pm[i])
+	}
+
+	return nil
+}
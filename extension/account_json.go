@@ -0,0 +1,44 @@
+package extension
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash       `json:"hash"`
+	AD base.Address         `json:"address"`
+	KS currency.AccountKeys `json:"keys"`
+	PM []AccountPermission  `json:"permissions"`
+}
+
+func (ac Account) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(ac.Hint()),
+		H:          ac.h,
+		AD:         ac.Address(),
+		KS:         ac.Keys(),
+		PM:         ac.permissions,
+	})
+}
+
+type AccountJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	AD base.AddressDecoder `json:"address"`
+	KS json.RawMessage     `json:"keys"`
+	PM []uint              `json:"permissions"`
+}
+
+func (ac *Account) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uac AccountJSONUnpacker
+	if err := enc.Unmarshal(b, &uac); err != nil {
+		return err
+	}
+
+	return ac.unpack(enc, uac.H, uac.AD, uac.KS, uac.PM)
+}
@@ -0,0 +1,131 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/stretchr/testify/suite"
+)
+
+type testAccount struct {
+	suite.Suite
+}
+
+func (t *testAccount) newAccount() Account {
+	k, err := currency.NewBaseAccountKey(key.NewBasePrivatekey().Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := currency.NewBaseAccountKeys([]currency.AccountKey{k}, 100)
+	t.NoError(err)
+
+	ac, err := NewAccountFromKeys(keys)
+	t.NoError(err)
+
+	return ac
+}
+
+func (t *testAccount) TestAddPermission() {
+	ac := t.newAccount()
+
+	nac, err := ac.AddPermission(BlocksignAdmin)
+	t.NoError(err)
+
+	nac, err = nac.AddPermission(BlockcityAdmin)
+	t.NoError(err)
+	t.NoError(nac.IsValid(nil))
+
+	// the permissions are sorted and the original account is not changed
+	t.Equal([]AccountPermission{BlockcityAdmin, BlocksignAdmin}, nac.Permissions())
+	t.Empty(ac.Permissions())
+	t.False(ac.Hash().Equal(nac.Hash()))
+}
+
+func (t *testAccount) TestAddPermissionTwice() {
+	ac, err := t.newAccount().AddPermission(BlockcityAdmin)
+	t.NoError(err)
+
+	_, err = ac.AddPermission(BlockcityAdmin)
+	t.Error(err)
+	t.Contains(err.Error(), `permission already granted, "blockcityAdmin"`)
+
+	t.Equal([]AccountPermission{BlockcityAdmin}, ac.Permissions())
+}
+
+func (t *testAccount) TestAddUnknownPermission() {
+	_, err := t.newAccount().AddPermission(AccountPermission(100))
+	t.Error(err)
+	t.Contains(err.Error(), "invalid AccountPermission, 100")
+}
+
+func (t *testAccount) TestRemovePermission() {
+	ac, err := t.newAccount().AddPermission(BlockcityAdmin)
+	t.NoError(err)
+
+	ac, err = ac.AddPermission(BlocksignAdmin)
+	t.NoError(err)
+
+	nac, err := ac.RemovePermission(BlockcityAdmin)
+	t.NoError(err)
+	t.NoError(nac.IsValid(nil))
+
+	t.Equal([]AccountPermission{BlocksignAdmin}, nac.Permissions())
+	t.Equal([]AccountPermission{BlockcityAdmin, BlocksignAdmin}, ac.Permissions())
+}
+
+func (t *testAccount) TestRemovePermissionNotGranted() {
+	ac, err := t.newAccount().AddPermission(BlocksignAdmin)
+	t.NoError(err)
+
+	_, err = ac.RemovePermission(BlockcityAdmin)
+	t.Error(err)
+	t.Contains(err.Error(), `permission not granted, "blockcityAdmin"`)
+
+	_, err = t.newAccount().RemovePermission(BlockcityAdmin)
+	t.Error(err)
+	t.Contains(err.Error(), `permission not granted, "blockcityAdmin"`)
+}
+
+func (t *testAccount) TestState() {
+	ac, err := t.newAccount().AddPermission(BlocksignAdmin)
+	t.NoError(err)
+
+	ac, err = ac.AddPermission(BlockcityAdmin)
+	t.NoError(err)
+
+	k := StateKeyAccount(ac.Address())
+	t.Equal(ac.Address().String()+":ExtendedAccount", k)
+	t.True(IsStateAccountKey(k))
+	t.False(IsStateAccountKey(currency.StateKeyAccount(ac.Address())))
+
+	st, err := state.NewStateV0(k, nil, base.NilHeight)
+	t.NoError(err)
+
+	_, err = StateAccountValue(st)
+	t.Error(err)
+
+	nst, err := SetStateAccountValue(st, ac)
+	t.NoError(err)
+
+	uac, err := StateAccountValue(nst)
+	t.NoError(err)
+	t.True(ac.Hash().Equal(uac.Hash()))
+	t.Equal([]AccountPermission{BlockcityAdmin, BlocksignAdmin}, uac.Permissions())
+}
+
+func (t *testAccount) TestParseAccountPermission() {
+	for _, ap := range []AccountPermission{BlockcityAdmin, BlocksignAdmin} {
+		pap, err := ParseAccountPermission(ap.String())
+		t.NoError(err)
+		t.Equal(ap, pap)
+	}
+
+	_, err := ParseAccountPermission("admin")
+	t.Error(err)
+}
+
+func TestAccount(t *testing.T) {
+	suite.Run(t, new(testAccount))
+}
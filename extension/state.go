@@ -0,0 +1,44 @@
+package extension
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+)
+
+// StateKeyAccountSuffix is the suffix of extended account state; it is stored
+// apart from the account state of mitum-currency.
+var StateKeyAccountSuffix = ":ExtendedAccount"
+
+func StateKeyAccount(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyAccountSuffix)
+}
+
+func IsStateAccountKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountSuffix)
+}
+
+func StateAccountValue(st state.State) (Account, error) {
+	v := st.Value()
+	if v == nil {
+		return Account{}, util.NotFoundError.Errorf("extended account not found in State")
+	}
+
+	if s, ok := v.Interface().(Account); !ok {
+		return Account{}, errors.Errorf("invalid extended account value found, %T", v.Interface())
+	} else {
+		return s, nil
+	}
+}
+
+func SetStateAccountValue(st state.State, v Account) (state.State, error) {
+	if uv, err := state.NewHintedValue(v); err != nil {
+		return nil, err
+	} else {
+		return st.SetValue(uv)
+	}
+}